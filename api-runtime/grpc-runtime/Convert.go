@@ -0,0 +1,361 @@
+// gRPC API Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the conversion between spiderpb messages and irs types.
+// pb -> irs conversions accept nil messages(unset fields).
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+package grpcruntime
+
+import (
+	pb "github.com/cloud-barista/poc-cb-spider/api-runtime/grpc-runtime/spiderpb"
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"time"
+)
+
+func toTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func toListReqInfo(m *pb.ListReqInfo) irs.ListReqInfo {
+	return irs.ListReqInfo{
+		PageSize:   int(m.GetPageSize()),
+		NextToken:  m.GetNextToken(),
+		NameFilter: m.GetNameFilter(),
+		TagFilter:  m.GetTagFilter(),
+	}
+}
+
+//================ Image
+
+func toImageReqInfo(m *pb.ImageReqInfo) irs.ImageReqInfo {
+	return irs.ImageReqInfo{Name: m.GetName(), Id: m.GetId()}
+}
+
+func toImageInfo(m *pb.ImageInfo) irs.ImageInfo {
+	imageInfo := irs.ImageInfo{
+		Name:         m.GetName(),
+		Id:           m.GetId(),
+		GuestOS:      m.GetGuestOs(),
+		Architecture: m.GetArchitecture(),
+		SizeGB:       m.GetSizeGb(),
+		Visibility:   irs.ImageVisibility(m.GetVisibility()),
+	}
+	if m.GetCreationTime() != nil {
+		imageInfo.CreationTime = m.GetCreationTime().AsTime()
+	}
+	return imageInfo
+}
+
+func fromImageInfo(imageInfo irs.ImageInfo) *pb.ImageInfo {
+	return &pb.ImageInfo{
+		Name:         imageInfo.Name,
+		Id:           imageInfo.Id,
+		GuestOs:      imageInfo.GuestOS,
+		Architecture: imageInfo.Architecture,
+		SizeGb:       imageInfo.SizeGB,
+		CreationTime: toTimestamp(imageInfo.CreationTime),
+		Visibility:   string(imageInfo.Visibility),
+	}
+}
+
+func toImageFilterInfo(m *pb.ImageFilterInfo) irs.ImageFilterInfo {
+	return irs.ImageFilterInfo{
+		Owner:        m.GetOwner(),
+		GuestOS:      m.GetGuestOs(),
+		Architecture: m.GetArchitecture(),
+		NamePattern:  m.GetNamePattern(),
+		Visibility:   irs.ImageVisibility(m.GetVisibility()),
+	}
+}
+
+func fromImagePageInfo(pageInfo irs.ImagePageInfo) *pb.ImagePageInfo {
+	m := &pb.ImagePageInfo{NextToken: pageInfo.NextToken}
+	for _, imageInfo := range pageInfo.ImageInfoList {
+		m.ImageInfoList = append(m.ImageInfoList, fromImageInfo(*imageInfo))
+	}
+	return m
+}
+
+//================ VNetwork
+
+func toVNetworkReqInfo(m *pb.VNetworkReqInfo) irs.VNetworkReqInfo {
+	return irs.VNetworkReqInfo{Name: m.GetName(), Id: m.GetId()}
+}
+
+func toVNetworkInfo(m *pb.VNetworkInfo) irs.VNetworkInfo {
+	return irs.VNetworkInfo{Name: m.GetName(), Id: m.GetId(), SubnetId: m.GetSubnetId()}
+}
+
+func fromVNetworkInfo(vNetworkInfo irs.VNetworkInfo) *pb.VNetworkInfo {
+	return &pb.VNetworkInfo{Name: vNetworkInfo.Name, Id: vNetworkInfo.Id, SubnetId: vNetworkInfo.SubnetId}
+}
+
+func fromVNetworkPageInfo(pageInfo irs.VNetworkPageInfo) *pb.VNetworkPageInfo {
+	m := &pb.VNetworkPageInfo{NextToken: pageInfo.NextToken}
+	for _, vNetworkInfo := range pageInfo.VNetworkInfoList {
+		m.VnetworkInfoList = append(m.VnetworkInfoList, fromVNetworkInfo(*vNetworkInfo))
+	}
+	return m
+}
+
+//================ Security
+
+func toSecurityRules(rules []*pb.SecurityRuleInfo) []*irs.SecurityRuleInfo {
+	var ruleList []*irs.SecurityRuleInfo
+	for _, rule := range rules {
+		ruleList = append(ruleList, &irs.SecurityRuleInfo{
+			FromPort:   rule.GetFromPort(),
+			ToPort:     rule.GetToPort(),
+			IPProtocol: rule.GetIpProtocol(),
+			Cidr:       rule.GetCidr(),
+		})
+	}
+	return ruleList
+}
+
+func fromSecurityRules(rules []*irs.SecurityRuleInfo) []*pb.SecurityRuleInfo {
+	var ruleList []*pb.SecurityRuleInfo
+	for _, rule := range rules {
+		ruleList = append(ruleList, &pb.SecurityRuleInfo{
+			FromPort:   rule.FromPort,
+			ToPort:     rule.ToPort,
+			IpProtocol: rule.IPProtocol,
+			Cidr:       rule.Cidr,
+		})
+	}
+	return ruleList
+}
+
+func toSecurityReqInfo(m *pb.SecurityReqInfo) irs.SecurityReqInfo {
+	return irs.SecurityReqInfo{
+		Name:                m.GetName(),
+		Id:                  m.GetId(),
+		GroupName:           m.GetGroupName(),
+		Description:         m.GetDescription(),
+		VpcId:               m.GetVpcId(),
+		IPPermissions:       toSecurityRules(m.GetIpPermissions()),
+		IPPermissionsEgress: toSecurityRules(m.GetIpPermissionsEgress()),
+	}
+}
+
+func toSecurityInfo(m *pb.SecurityInfo) irs.SecurityInfo {
+	return irs.SecurityInfo{
+		Name:                m.GetName(),
+		Id:                  m.GetId(),
+		GroupName:           m.GetGroupName(),
+		GroupID:             m.GetGroupId(),
+		IPPermissions:       toSecurityRules(m.GetIpPermissions()),
+		IPPermissionsEgress: toSecurityRules(m.GetIpPermissionsEgress()),
+		Description:         m.GetDescription(),
+		VpcID:               m.GetVpcId(),
+		OwnerID:             m.GetOwnerId(),
+	}
+}
+
+func fromSecurityInfo(securityInfo irs.SecurityInfo) *pb.SecurityInfo {
+	return &pb.SecurityInfo{
+		Name:                securityInfo.Name,
+		Id:                  securityInfo.Id,
+		GroupName:           securityInfo.GroupName,
+		GroupId:             securityInfo.GroupID,
+		IpPermissions:       fromSecurityRules(securityInfo.IPPermissions),
+		IpPermissionsEgress: fromSecurityRules(securityInfo.IPPermissionsEgress),
+		Description:         securityInfo.Description,
+		VpcId:               securityInfo.VpcID,
+		OwnerId:             securityInfo.OwnerID,
+	}
+}
+
+func fromSecurityPageInfo(pageInfo irs.SecurityPageInfo) *pb.SecurityPageInfo {
+	m := &pb.SecurityPageInfo{NextToken: pageInfo.NextToken}
+	for _, securityInfo := range pageInfo.SecurityInfoList {
+		m.SecurityInfoList = append(m.SecurityInfoList, fromSecurityInfo(*securityInfo))
+	}
+	return m
+}
+
+//================ KeyPair
+
+func toKeyPairReqInfo(m *pb.KeyPairReqInfo) irs.KeyPairReqInfo {
+	return irs.KeyPairReqInfo{Name: m.GetName(), Id: m.GetId()}
+}
+
+func toKeyPairInfo(m *pb.KeyPairInfo) irs.KeyPairInfo {
+	return irs.KeyPairInfo{
+		Name:        m.GetName(),
+		Id:          m.GetId(),
+		Fingerprint: m.GetFingerprint(),
+		KeyMaterial: m.GetKeyMaterial(),
+	}
+}
+
+func fromKeyPairInfo(keyPairInfo irs.KeyPairInfo) *pb.KeyPairInfo {
+	return &pb.KeyPairInfo{
+		Name:        keyPairInfo.Name,
+		Id:          keyPairInfo.Id,
+		Fingerprint: keyPairInfo.Fingerprint,
+		KeyMaterial: keyPairInfo.KeyMaterial,
+	}
+}
+
+func fromKeyPairPageInfo(pageInfo irs.KeyPairPageInfo) *pb.KeyPairPageInfo {
+	m := &pb.KeyPairPageInfo{NextToken: pageInfo.NextToken}
+	for _, keyPairInfo := range pageInfo.KeyPairInfoList {
+		m.KeyPairInfoList = append(m.KeyPairInfoList, fromKeyPairInfo(*keyPairInfo))
+	}
+	return m
+}
+
+//================ VNic
+
+func toVNicReqInfo(m *pb.VNicReqInfo) irs.VNicReqInfo {
+	return irs.VNicReqInfo{Name: m.GetName(), Id: m.GetId()}
+}
+
+func fromVNicInfo(vNicInfo irs.VNicInfo) *pb.VNicInfo {
+	return &pb.VNicInfo{Name: vNicInfo.Name, Id: vNicInfo.Id}
+}
+
+func fromVNicPageInfo(pageInfo irs.VNicPageInfo) *pb.VNicPageInfo {
+	m := &pb.VNicPageInfo{NextToken: pageInfo.NextToken}
+	for _, vNicInfo := range pageInfo.VNicInfoList {
+		m.VnicInfoList = append(m.VnicInfoList, fromVNicInfo(*vNicInfo))
+	}
+	return m
+}
+
+//================ PublicIP
+
+func toPublicIPReqInfo(m *pb.PublicIPReqInfo) irs.PublicIPReqInfo {
+	return irs.PublicIPReqInfo{Name: m.GetName(), Id: m.GetId()}
+}
+
+func toPublicIPInfo(m *pb.PublicIPInfo) irs.PublicIPInfo {
+	return irs.PublicIPInfo{
+		Name:                    m.GetName(),
+		Id:                      m.GetId(),
+		Domain:                  m.GetDomain(),
+		PublicIp:                m.GetPublicIp(),
+		PublicIpv4Pool:          m.GetPublicIpv4Pool(),
+		AllocationId:            m.GetAllocationId(),
+		AssociationId:           m.GetAssociationId(),
+		InstanceId:              m.GetInstanceId(),
+		NetworkInterfaceId:      m.GetNetworkInterfaceId(),
+		NetworkInterfaceOwnerId: m.GetNetworkInterfaceOwnerId(),
+		PrivateIpAddress:        m.GetPrivateIpAddress(),
+		Region:                  m.GetRegion(),
+		CreationTimestamp:       m.GetCreationTimestamp(),
+		Address:                 m.GetAddress(),
+		NetworkTier:             m.GetNetworkTier(),
+		AddressType:             m.GetAddressType(),
+		Status:                  m.GetStatus(),
+	}
+}
+
+func fromPublicIPInfo(publicIPInfo irs.PublicIPInfo) *pb.PublicIPInfo {
+	return &pb.PublicIPInfo{
+		Name:                    publicIPInfo.Name,
+		Id:                      publicIPInfo.Id,
+		Domain:                  publicIPInfo.Domain,
+		PublicIp:                publicIPInfo.PublicIp,
+		PublicIpv4Pool:          publicIPInfo.PublicIpv4Pool,
+		AllocationId:            publicIPInfo.AllocationId,
+		AssociationId:           publicIPInfo.AssociationId,
+		InstanceId:              publicIPInfo.InstanceId,
+		NetworkInterfaceId:      publicIPInfo.NetworkInterfaceId,
+		NetworkInterfaceOwnerId: publicIPInfo.NetworkInterfaceOwnerId,
+		PrivateIpAddress:        publicIPInfo.PrivateIpAddress,
+		Region:                  publicIPInfo.Region,
+		CreationTimestamp:       publicIPInfo.CreationTimestamp,
+		Address:                 publicIPInfo.Address,
+		NetworkTier:             publicIPInfo.NetworkTier,
+		AddressType:             publicIPInfo.AddressType,
+		Status:                  publicIPInfo.Status,
+	}
+}
+
+func fromPublicIPPageInfo(pageInfo irs.PublicIPPageInfo) *pb.PublicIPPageInfo {
+	m := &pb.PublicIPPageInfo{NextToken: pageInfo.NextToken}
+	for _, publicIPInfo := range pageInfo.PublicIPInfoList {
+		m.PublicIpInfoList = append(m.PublicIpInfoList, fromPublicIPInfo(*publicIPInfo))
+	}
+	return m
+}
+
+//================ VM
+
+func toVMReqInfo(m *pb.VMReqInfo) irs.VMReqInfo {
+	return irs.VMReqInfo{
+		Name:         m.GetName(),
+		ImageInfo:    toImageInfo(m.GetImageInfo()),
+		VNetworkInfo: toVNetworkInfo(m.GetVnetworkInfo()),
+		SecurityInfo: toSecurityInfo(m.GetSecurityInfo()),
+		KeyPairInfo:  toKeyPairInfo(m.GetKeyPairInfo()),
+		SpecID:       m.GetSpecId(),
+		PublicIPInfo: toPublicIPInfo(m.GetPublicIpInfo()),
+		LoginInfo: irs.LoginInfo{
+			AdminUsername: m.GetLoginInfo().GetAdminUsername(),
+			AdminPassword: m.GetLoginInfo().GetAdminPassword(),
+		},
+	}
+}
+
+func fromVMInfo(vmInfo irs.VMInfo) *pb.VMInfo {
+	return &pb.VMInfo{
+		Name:           vmInfo.Name,
+		Id:             vmInfo.Id,
+		StartTime:      toTimestamp(vmInfo.StartTime),
+		Region:         &pb.RegionInfo{Region: vmInfo.Region.Region, Zone: vmInfo.Region.Zone},
+		ImageId:        vmInfo.ImageID,
+		SpecId:         vmInfo.SpecID,
+		VnetworkId:     vmInfo.VNetworkID,
+		SubNetworkId:   vmInfo.SubNetworkID,
+		SecurityId:     vmInfo.SecurityID,
+		Vnic:           vmInfo.VNIC,
+		PublicIp:       vmInfo.PublicIP,
+		PublicDns:      vmInfo.PublicDNS,
+		PrivateIp:      vmInfo.PrivateIP,
+		PrivateDns:     vmInfo.PrivateDNS,
+		KeyPairId:      vmInfo.KeyPairID,
+		GuestUserId:    vmInfo.GuestUserID,
+		GuestUserPwd:   vmInfo.GuestUserPwd,
+		GuestBootDisk:  vmInfo.GuestBootDisk,
+		GuestBlockDisk: vmInfo.GuestBlockDisk,
+		AdditionalInfo: vmInfo.AdditionalInfo,
+	}
+}
+
+func fromVMPageInfo(pageInfo irs.VMPageInfo) *pb.VMPageInfo {
+	m := &pb.VMPageInfo{NextToken: pageInfo.NextToken}
+	for _, vmInfo := range pageInfo.VMInfoList {
+		m.VmInfoList = append(m.VmInfoList, fromVMInfo(*vmInfo))
+	}
+	return m
+}
+
+func fromVMBatchResults(results []*irs.VMBatchResult) *pb.StartVMsReply {
+	m := &pb.StartVMsReply{}
+	for _, result := range results {
+		batchResult := &pb.VMBatchResult{
+			Name:       result.Name,
+			VmInfo:     fromVMInfo(result.VMInfo),
+			RolledBack: result.RolledBack,
+		}
+		if result.Error != nil {
+			batchResult.Error = result.Error.Error()
+		}
+		m.VmBatchResults = append(m.VmBatchResults, batchResult)
+	}
+	return m
+}
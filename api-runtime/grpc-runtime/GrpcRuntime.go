@@ -0,0 +1,208 @@
+// gRPC API Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the gRPC server of CB-Spider. API definition: spiderpb/spider.proto
+// The services dispatch to the driver of the connection config of each request.
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+package grpcruntime
+
+import (
+	pb "github.com/cloud-barista/poc-cb-spider/api-runtime/grpc-runtime/spiderpb"
+	dim "github.com/cloud-barista/poc-cb-spider/cloud-driver-manager"
+	icon "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/connect"
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+	mw "github.com/cloud-barista/poc-cb-spider/cloud-driver/middleware"
+
+	cblog "github.com/cloud-barista/cb-log"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"context"
+	"encoding/json"
+	"net"
+	"reflect"
+	"strconv"
+)
+
+var cblogger *logrus.Logger
+
+func init() {
+	cblogger = cblog.GetLogger("CB-SPIDER gRPC")
+}
+
+// NewServer returns a gRPC server with every service of spider.proto registered.
+func NewServer(opts ...grpc.ServerOption) *grpc.Server {
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(traceUnary, recoverUnary),
+		grpc.ChainStreamInterceptor(traceStream, recoverStream),
+	)
+	server := grpc.NewServer(opts...)
+
+	pb.RegisterVMServiceServer(server, &vmService{})
+	pb.RegisterImageServiceServer(server, &imageService{})
+	pb.RegisterVNetworkServiceServer(server, &vNetworkService{})
+	pb.RegisterSecurityServiceServer(server, &securityService{})
+	pb.RegisterKeyPairServiceServer(server, &keyPairService{})
+	pb.RegisterVNicServiceServer(server, &vNicService{})
+	pb.RegisterPublicIPServiceServer(server, &publicIPService{})
+	return server
+}
+
+// RunServer serves the gRPC API on addr, ex) ":2048"
+func RunServer(addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	cblogger.Infof("CB-Spider gRPC API server is listening on %s", addr)
+	return NewServer().Serve(listener)
+}
+
+// 드라이버의 panic이 서버를 종료하지 않도록 Internal 에러로 응답 함.
+func recoverUnary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			cblogger.Errorf("%s: panic: %v", info.FullMethod, rec)
+			err = status.Errorf(codes.Internal, "internal error: %v", rec)
+		}
+	}()
+	return handler(ctx, req)
+}
+
+func recoverStream(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			cblogger.Errorf("%s: panic: %v", info.FullMethod, rec)
+			err = status.Errorf(codes.Internal, "internal error: %v", rec)
+		}
+	}()
+	return handler(srv, stream)
+}
+
+// GRPCCode maps the common error types of drivers to gRPC status codes.
+func GRPCCode(err error) codes.Code {
+	switch irs.ErrorCodeOf(err) {
+	case irs.InvalidArgumentError:
+		return codes.InvalidArgument
+	case irs.UnauthenticatedError:
+		return codes.Unauthenticated
+	case irs.PermissionDeniedError:
+		return codes.PermissionDenied
+	case irs.NotFoundError:
+		return codes.NotFound
+	case irs.AlreadyExistsError:
+		return codes.AlreadyExists
+	case irs.ThrottledError:
+		return codes.ResourceExhausted
+	case irs.NotSupportedError:
+		return codes.Unimplemented
+	case irs.UnavailableError:
+		return codes.Unavailable
+	case irs.TimeoutError:
+		return codes.DeadlineExceeded
+	}
+	return codes.Unknown
+}
+
+func statusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	code := GRPCCode(err)
+	if code == codes.Unknown {
+		cblogger.Error(err)
+	}
+	return status.Error(code, err.Error())
+}
+
+// withConnection connects with the connection config, and calls call with it.
+// The spans of the connection and its handler calls are children of ctx.
+func withConnection(ctx context.Context, connectionName string, call func(cloudConnection icon.CloudConnection) error) error {
+	if connectionName == "" {
+		return status.Error(codes.InvalidArgument, "connection_name is required")
+	}
+	dryRun, err := isDryRun(ctx)
+	if err != nil {
+		return statusError(err)
+	}
+	var plan *mw.DryRunPlan
+	if dryRun {
+		ctx, plan = mw.WithDryRun(ctx)
+	}
+
+	cloudConnection, err := dim.GetCloudConnectionContext(ctx, connectionName)
+	if err != nil {
+		return statusError(err)
+	}
+	defer cloudConnection.Close()
+
+	err = call(cloudConnection)
+	if plan != nil {
+		if err == nil {
+			err = plan.Err()
+		}
+		setDryRunTrailer(ctx, plan)
+	}
+	return statusError(err)
+}
+
+// Metadata of a dry run request, "true": the calls that change resources are validated only,
+// and the results are returned in the DryRunResultMetadata trailer as the JSON of []mw.DryRunResult.
+// The response message of a dry run is empty.
+const (
+	DryRunMetadata       = "x-dry-run"
+	DryRunResultMetadata = "x-dry-run-result"
+)
+
+func isDryRun(ctx context.Context) (bool, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false, nil
+	}
+	dryRun := metadataCarrier(md).Get(DryRunMetadata)
+	if dryRun == "" {
+		return false, nil
+	}
+	value, err := strconv.ParseBool(dryRun)
+	if err != nil {
+		return false, irs.InvalidArgument("invalid %s: %s", DryRunMetadata, dryRun)
+	}
+	return value, nil
+}
+
+func setDryRunTrailer(ctx context.Context, plan *mw.DryRunPlan) {
+	results := plan.Results()
+	if len(results) == 0 {
+		return
+	}
+	data, err := json.Marshal(results)
+	if err != nil {
+		cblogger.Error(err)
+		return
+	}
+	if err := grpc.SetTrailer(ctx, metadata.Pairs(DryRunResultMetadata, string(data))); err != nil {
+		cblogger.Error(err)
+	}
+}
+
+// checkHandler returns NotSupported for drivers that return (nil, nil) from Create*Handler().
+func checkHandler(handlerName string, handler interface{}, err error) error {
+	if err != nil {
+		return err
+	}
+	if handler == nil || (reflect.ValueOf(handler).Kind() == reflect.Ptr && reflect.ValueOf(handler).IsNil()) {
+		return irs.NotSupported("the driver does not support %s", handlerName)
+	}
+	return nil
+}
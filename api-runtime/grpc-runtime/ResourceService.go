@@ -0,0 +1,503 @@
+// gRPC API Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the gRPC services of Cloud Resources(Image, VNetwork, Security, KeyPair, VNic, PublicIP).
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+package grpcruntime
+
+import (
+	pb "github.com/cloud-barista/poc-cb-spider/api-runtime/grpc-runtime/spiderpb"
+	icon "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/connect"
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+
+	"context"
+)
+
+//================ Image
+
+type imageService struct {
+	pb.UnimplementedImageServiceServer
+}
+
+func imageHandler(cloudConnection icon.CloudConnection) (irs.ImageHandler, error) {
+	handler, err := cloudConnection.CreateImageHandler()
+	return handler, checkHandler("ImageHandler", handler, err)
+}
+
+func (s *imageService) CreateImage(ctx context.Context, req *pb.CreateImageRequest) (*pb.ImageInfo, error) {
+	var reply *pb.ImageInfo
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := imageHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		info, err := handler.CreateImage(toImageReqInfo(req.GetImageReqInfo()))
+		if err != nil {
+			return err
+		}
+		reply = fromImageInfo(info)
+		return nil
+	})
+	return reply, err
+}
+
+func (s *imageService) ListImagePage(ctx context.Context, req *pb.ListImageRequest) (*pb.ImagePageInfo, error) {
+	var reply *pb.ImagePageInfo
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := imageHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		pageInfo, err := handler.ListImagePage(toImageFilterInfo(req.GetImageFilterInfo()), toListReqInfo(req.GetListReqInfo()))
+		if err != nil {
+			return err
+		}
+		reply = fromImagePageInfo(pageInfo)
+		return nil
+	})
+	return reply, err
+}
+
+func (s *imageService) GetImage(ctx context.Context, req *pb.IDRequest) (*pb.ImageInfo, error) {
+	var reply *pb.ImageInfo
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := imageHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		info, err := handler.GetImage(req.GetId())
+		if err != nil {
+			return err
+		}
+		reply = fromImageInfo(info)
+		return nil
+	})
+	return reply, err
+}
+
+func (s *imageService) DeleteImage(ctx context.Context, req *pb.IDRequest) (*pb.ResultReply, error) {
+	var reply *pb.ResultReply
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := imageHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		result, err := handler.DeleteImage(req.GetId())
+		reply = &pb.ResultReply{Result: result}
+		return err
+	})
+	return reply, err
+}
+
+//================ VNetwork
+
+type vNetworkService struct {
+	pb.UnimplementedVNetworkServiceServer
+}
+
+func vNetworkHandler(cloudConnection icon.CloudConnection) (irs.VNetworkHandler, error) {
+	handler, err := cloudConnection.CreateVNetworkHandler()
+	return handler, checkHandler("VNetworkHandler", handler, err)
+}
+
+func (s *vNetworkService) CreateVNetwork(ctx context.Context, req *pb.CreateVNetworkRequest) (*pb.VNetworkInfo, error) {
+	var reply *pb.VNetworkInfo
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := vNetworkHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		info, err := handler.CreateVNetwork(toVNetworkReqInfo(req.GetVnetworkReqInfo()))
+		if err != nil {
+			return err
+		}
+		reply = fromVNetworkInfo(info)
+		return nil
+	})
+	return reply, err
+}
+
+func (s *vNetworkService) ListVNetworkPage(ctx context.Context, req *pb.ListRequest) (*pb.VNetworkPageInfo, error) {
+	var reply *pb.VNetworkPageInfo
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := vNetworkHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		pageInfo, err := handler.ListVNetworkPage(toListReqInfo(req.GetListReqInfo()))
+		if err != nil {
+			return err
+		}
+		reply = fromVNetworkPageInfo(pageInfo)
+		return nil
+	})
+	return reply, err
+}
+
+func (s *vNetworkService) GetVNetwork(ctx context.Context, req *pb.IDRequest) (*pb.VNetworkInfo, error) {
+	var reply *pb.VNetworkInfo
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := vNetworkHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		info, err := handler.GetVNetwork(req.GetId())
+		if err != nil {
+			return err
+		}
+		reply = fromVNetworkInfo(info)
+		return nil
+	})
+	return reply, err
+}
+
+func (s *vNetworkService) DeleteVNetwork(ctx context.Context, req *pb.IDRequest) (*pb.ResultReply, error) {
+	var reply *pb.ResultReply
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := vNetworkHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		result, err := handler.DeleteVNetwork(req.GetId())
+		reply = &pb.ResultReply{Result: result}
+		return err
+	})
+	return reply, err
+}
+
+//================ Security
+
+type securityService struct {
+	pb.UnimplementedSecurityServiceServer
+}
+
+func securityHandler(cloudConnection icon.CloudConnection) (irs.SecurityHandler, error) {
+	handler, err := cloudConnection.CreateSecurityHandler()
+	return handler, checkHandler("SecurityHandler", handler, err)
+}
+
+func (s *securityService) CreateSecurity(ctx context.Context, req *pb.CreateSecurityRequest) (*pb.SecurityInfo, error) {
+	var reply *pb.SecurityInfo
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := securityHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		info, err := handler.CreateSecurity(toSecurityReqInfo(req.GetSecurityReqInfo()))
+		if err != nil {
+			return err
+		}
+		reply = fromSecurityInfo(info)
+		return nil
+	})
+	return reply, err
+}
+
+func (s *securityService) ListSecurityPage(ctx context.Context, req *pb.ListRequest) (*pb.SecurityPageInfo, error) {
+	var reply *pb.SecurityPageInfo
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := securityHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		pageInfo, err := handler.ListSecurityPage(toListReqInfo(req.GetListReqInfo()))
+		if err != nil {
+			return err
+		}
+		reply = fromSecurityPageInfo(pageInfo)
+		return nil
+	})
+	return reply, err
+}
+
+func (s *securityService) GetSecurity(ctx context.Context, req *pb.IDRequest) (*pb.SecurityInfo, error) {
+	var reply *pb.SecurityInfo
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := securityHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		info, err := handler.GetSecurity(req.GetId())
+		if err != nil {
+			return err
+		}
+		reply = fromSecurityInfo(info)
+		return nil
+	})
+	return reply, err
+}
+
+func (s *securityService) DeleteSecurity(ctx context.Context, req *pb.IDRequest) (*pb.ResultReply, error) {
+	var reply *pb.ResultReply
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := securityHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		result, err := handler.DeleteSecurity(req.GetId())
+		reply = &pb.ResultReply{Result: result}
+		return err
+	})
+	return reply, err
+}
+
+//================ KeyPair
+
+type keyPairService struct {
+	pb.UnimplementedKeyPairServiceServer
+}
+
+func keyPairHandler(cloudConnection icon.CloudConnection) (irs.KeyPairHandler, error) {
+	handler, err := cloudConnection.CreateKeyPairHandler()
+	return handler, checkHandler("KeyPairHandler", handler, err)
+}
+
+func (s *keyPairService) CreateKey(ctx context.Context, req *pb.CreateKeyRequest) (*pb.KeyPairInfo, error) {
+	var reply *pb.KeyPairInfo
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := keyPairHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		info, err := handler.CreateKey(toKeyPairReqInfo(req.GetKeyPairReqInfo()))
+		if err != nil {
+			return err
+		}
+		reply = fromKeyPairInfo(info)
+		return nil
+	})
+	return reply, err
+}
+
+func (s *keyPairService) ListKeyPage(ctx context.Context, req *pb.ListRequest) (*pb.KeyPairPageInfo, error) {
+	var reply *pb.KeyPairPageInfo
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := keyPairHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		pageInfo, err := handler.ListKeyPage(toListReqInfo(req.GetListReqInfo()))
+		if err != nil {
+			return err
+		}
+		reply = fromKeyPairPageInfo(pageInfo)
+		return nil
+	})
+	return reply, err
+}
+
+func (s *keyPairService) GetKey(ctx context.Context, req *pb.IDRequest) (*pb.KeyPairInfo, error) {
+	var reply *pb.KeyPairInfo
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := keyPairHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		info, err := handler.GetKey(req.GetId())
+		if err != nil {
+			return err
+		}
+		reply = fromKeyPairInfo(info)
+		return nil
+	})
+	return reply, err
+}
+
+func (s *keyPairService) DeleteKey(ctx context.Context, req *pb.IDRequest) (*pb.ResultReply, error) {
+	var reply *pb.ResultReply
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := keyPairHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		result, err := handler.DeleteKey(req.GetId())
+		reply = &pb.ResultReply{Result: result}
+		return err
+	})
+	return reply, err
+}
+
+//================ VNic
+
+type vNicService struct {
+	pb.UnimplementedVNicServiceServer
+}
+
+func vNicHandler(cloudConnection icon.CloudConnection) (irs.VNicHandler, error) {
+	handler, err := cloudConnection.CreateVNicHandler()
+	return handler, checkHandler("VNicHandler", handler, err)
+}
+
+func (s *vNicService) CreateVNic(ctx context.Context, req *pb.CreateVNicRequest) (*pb.VNicInfo, error) {
+	var reply *pb.VNicInfo
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := vNicHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		info, err := handler.CreateVNic(toVNicReqInfo(req.GetVnicReqInfo()))
+		if err != nil {
+			return err
+		}
+		reply = fromVNicInfo(info)
+		return nil
+	})
+	return reply, err
+}
+
+func (s *vNicService) ListVNicPage(ctx context.Context, req *pb.ListRequest) (*pb.VNicPageInfo, error) {
+	var reply *pb.VNicPageInfo
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := vNicHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		pageInfo, err := handler.ListVNicPage(toListReqInfo(req.GetListReqInfo()))
+		if err != nil {
+			return err
+		}
+		reply = fromVNicPageInfo(pageInfo)
+		return nil
+	})
+	return reply, err
+}
+
+func (s *vNicService) GetVNic(ctx context.Context, req *pb.IDRequest) (*pb.VNicInfo, error) {
+	var reply *pb.VNicInfo
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := vNicHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		info, err := handler.GetVNic(req.GetId())
+		if err != nil {
+			return err
+		}
+		reply = fromVNicInfo(info)
+		return nil
+	})
+	return reply, err
+}
+
+func (s *vNicService) DeleteVNic(ctx context.Context, req *pb.IDRequest) (*pb.ResultReply, error) {
+	var reply *pb.ResultReply
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := vNicHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		result, err := handler.DeleteVNic(req.GetId())
+		reply = &pb.ResultReply{Result: result}
+		return err
+	})
+	return reply, err
+}
+
+//================ PublicIP
+
+type publicIPService struct {
+	pb.UnimplementedPublicIPServiceServer
+}
+
+func publicIPHandler(cloudConnection icon.CloudConnection) (irs.PublicIPHandler, error) {
+	handler, err := cloudConnection.CreatePublicIPHandler()
+	return handler, checkHandler("PublicIPHandler", handler, err)
+}
+
+func (s *publicIPService) CreatePublicIP(ctx context.Context, req *pb.CreatePublicIPRequest) (*pb.PublicIPInfo, error) {
+	var reply *pb.PublicIPInfo
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := publicIPHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		info, err := handler.CreatePublicIP(toPublicIPReqInfo(req.GetPublicIpReqInfo()))
+		if err != nil {
+			return err
+		}
+		reply = fromPublicIPInfo(info)
+		return nil
+	})
+	return reply, err
+}
+
+func (s *publicIPService) ListPublicIPPage(ctx context.Context, req *pb.ListRequest) (*pb.PublicIPPageInfo, error) {
+	var reply *pb.PublicIPPageInfo
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := publicIPHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		pageInfo, err := handler.ListPublicIPPage(toListReqInfo(req.GetListReqInfo()))
+		if err != nil {
+			return err
+		}
+		reply = fromPublicIPPageInfo(pageInfo)
+		return nil
+	})
+	return reply, err
+}
+
+func (s *publicIPService) GetPublicIP(ctx context.Context, req *pb.IDRequest) (*pb.PublicIPInfo, error) {
+	var reply *pb.PublicIPInfo
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := publicIPHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		info, err := handler.GetPublicIP(req.GetId())
+		if err != nil {
+			return err
+		}
+		reply = fromPublicIPInfo(info)
+		return nil
+	})
+	return reply, err
+}
+
+func (s *publicIPService) DeletePublicIP(ctx context.Context, req *pb.IDRequest) (*pb.ResultReply, error) {
+	var reply *pb.ResultReply
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := publicIPHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		result, err := handler.DeletePublicIP(req.GetId())
+		reply = &pb.ResultReply{Result: result}
+		return err
+	})
+	return reply, err
+}
+
+func (s *publicIPService) AssociatePublicIP(ctx context.Context, req *pb.AssociatePublicIPRequest) (*pb.ResultReply, error) {
+	var reply *pb.ResultReply
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := publicIPHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		result, err := handler.AssociatePublicIP(req.GetPublicIpId(), req.GetVmId())
+		reply = &pb.ResultReply{Result: result}
+		return err
+	})
+	return reply, err
+}
+
+func (s *publicIPService) DisassociatePublicIP(ctx context.Context, req *pb.IDRequest) (*pb.ResultReply, error) {
+	var reply *pb.ResultReply
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := publicIPHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		result, err := handler.DisassociatePublicIP(req.GetId())
+		reply = &pb.ResultReply{Result: result}
+		return err
+	})
+	return reply, err
+}
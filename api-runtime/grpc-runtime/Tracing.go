@@ -0,0 +1,110 @@
+// gRPC API Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the tracing of gRPC requests, a span per request as a child of the traceparent metadata,
+// and the caller, the ID and the idempotency key of requests for audit records, logs and create calls.
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+package grpcruntime
+
+import (
+	"github.com/cloud-barista/poc-cb-spider/cloud-driver/logger"
+	mw "github.com/cloud-barista/poc-cb-spider/cloud-driver/middleware"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"context"
+)
+
+var tracer = otel.Tracer(mw.TracerName)
+
+// Metadata of the user of a request, recorded in audit records. The gRPC API has no authentication yet.
+const UserMetadata = "x-spider-user"
+
+// Metadata of the ID of a request, logged by drivers as request_id. Generated if a request has none, and set in the response header.
+const RequestIDMetadata = "x-request-id"
+
+// Metadata of the idempotency key of a create request. A retried request with the same key returns the resource of the first request.
+const IdempotencyKeyMetadata = "idempotency-key"
+
+// metadataCarrier is the propagation.TextMapCarrier of incoming metadata.
+type metadataCarrier metadata.MD
+
+func (carrier metadataCarrier) Get(key string) string {
+	values := metadata.MD(carrier).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (carrier metadataCarrier) Set(key string, value string) {
+	metadata.MD(carrier).Set(key, value)
+}
+
+func (carrier metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(carrier))
+	for key := range carrier {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func startServerSpan(ctx context.Context, fullMethod string) (context.Context, trace.Span) {
+	caller := mw.Caller{API: "gRPC"}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		caller.Address = p.Addr.String()
+	}
+	var requestID string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+		caller.User = metadataCarrier(md).Get(UserMetadata)
+		requestID = metadataCarrier(md).Get(RequestIDMetadata)
+		if key := metadataCarrier(md).Get(IdempotencyKeyMetadata); key != "" {
+			ctx = mw.WithIdempotencyKey(ctx, key)
+		}
+	}
+	if requestID == "" {
+		requestID = logger.NewRequestID()
+	}
+	grpc.SetHeader(ctx, metadata.Pairs(RequestIDMetadata, requestID))
+	ctx = mw.WithCaller(ctx, caller)
+	ctx = logger.WithFields(ctx, logger.Fields{logger.RequestIDField: requestID})
+	return tracer.Start(ctx, fullMethod, trace.WithSpanKind(trace.SpanKindServer))
+}
+
+func traceUnary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, span := startServerSpan(ctx, info.FullMethod)
+	defer span.End()
+
+	resp, err := handler(ctx, req)
+	mw.SetSpanError(span, err)
+	return resp, err
+}
+
+// tracedStream replaces the Context of a stream with the span and the caller of the stream.
+type tracedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (stream *tracedStream) Context() context.Context {
+	return stream.ctx
+}
+
+func traceStream(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, span := startServerSpan(stream.Context(), info.FullMethod)
+	defer span.End()
+
+	err := handler(srv, &tracedStream{ServerStream: stream, ctx: ctx})
+	mw.SetSpanError(span, err)
+	return err
+}
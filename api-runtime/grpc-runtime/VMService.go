@@ -0,0 +1,274 @@
+// gRPC API Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the gRPC service of VM.
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+package grpcruntime
+
+import (
+	pb "github.com/cloud-barista/poc-cb-spider/api-runtime/grpc-runtime/spiderpb"
+	icon "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/connect"
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"context"
+	"time"
+)
+
+// Default polling interval of WatchVMStatus
+const DefaultWatchInterval = 5 * time.Second
+
+const terminatedStatus irs.VMStatus = "TERMINATED"
+
+type vmService struct {
+	pb.UnimplementedVMServiceServer
+}
+
+func vmHandler(cloudConnection icon.CloudConnection) (irs.VMHandler, error) {
+	handler, err := cloudConnection.CreateVMHandler()
+	return handler, checkHandler("VMHandler", handler, err)
+}
+
+func (s *vmService) StartVM(ctx context.Context, req *pb.StartVMRequest) (*pb.VMInfo, error) {
+	var reply *pb.VMInfo
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := vmHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		vmInfo, err := handler.StartVM(toVMReqInfo(req.GetVmReqInfo()))
+		if err != nil {
+			return err
+		}
+		reply = fromVMInfo(vmInfo)
+		return nil
+	})
+	return reply, err
+}
+
+func (s *vmService) StartVMs(ctx context.Context, req *pb.StartVMsRequest) (*pb.StartVMsReply, error) {
+	var reply *pb.StartVMsReply
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := vmHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		results, err := handler.StartVMs(toVMReqInfo(req.GetVmReqInfo()), int(req.GetCount()), int(req.GetMinCount()))
+		if err != nil {
+			return err
+		}
+		reply = fromVMBatchResults(results)
+		return nil
+	})
+	return reply, err
+}
+
+func (s *vmService) SuspendVM(ctx context.Context, req *pb.IDRequest) (*pb.VMStatusInfo, error) {
+	return vmControl(ctx, req, irs.VMHandler.SuspendVM)
+}
+
+func (s *vmService) ResumeVM(ctx context.Context, req *pb.IDRequest) (*pb.VMStatusInfo, error) {
+	return vmControl(ctx, req, irs.VMHandler.ResumeVM)
+}
+
+func (s *vmService) RebootVM(ctx context.Context, req *pb.IDRequest) (*pb.VMStatusInfo, error) {
+	return vmControl(ctx, req, irs.VMHandler.RebootVM)
+}
+
+func (s *vmService) TerminateVM(ctx context.Context, req *pb.IDRequest) (*pb.VMStatusInfo, error) {
+	return vmControl(ctx, req, irs.VMHandler.TerminateVM)
+}
+
+func (s *vmService) GetVMStatus(ctx context.Context, req *pb.IDRequest) (*pb.VMStatusInfo, error) {
+	return vmControl(ctx, req, nil)
+}
+
+// vmControl calls control(ex: SuspendVM) and replies the VM status after the call.
+// The control methods do not return errors, so the status tells the result.
+func vmControl(ctx context.Context, req *pb.IDRequest, control func(irs.VMHandler, string)) (*pb.VMStatusInfo, error) {
+	var reply *pb.VMStatusInfo
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := vmHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		if control != nil {
+			control(handler, req.GetId())
+		}
+		reply = &pb.VMStatusInfo{VmId: req.GetId(), VmStatus: string(handler.GetVMStatus(req.GetId()))}
+		return nil
+	})
+	return reply, err
+}
+
+func (s *vmService) ChangeVMSpec(ctx context.Context, req *pb.ChangeVMSpecRequest) (*pb.VMInfo, error) {
+	if req.GetSpecId() == "" {
+		return nil, statusError(irs.InvalidArgument("spec_id is required"))
+	}
+	var reply *pb.VMInfo
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := vmHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		vmInfo, err := handler.ChangeVMSpec(req.GetVmId(), req.GetSpecId())
+		if err != nil {
+			return err
+		}
+		reply = fromVMInfo(vmInfo)
+		return nil
+	})
+	return reply, err
+}
+
+func (s *vmService) ListVMStatus(ctx context.Context, req *pb.ConnectionRequest) (*pb.VMStatusList, error) {
+	var reply *pb.VMStatusList
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := vmHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		reply = &pb.VMStatusList{}
+		for _, statusInfo := range handler.ListVMStatus() {
+			reply.VmStatusInfoList = append(reply.VmStatusInfoList, &pb.VMStatusInfo{VmId: statusInfo.VmId, VmStatus: string(statusInfo.VmStatus)})
+		}
+		return nil
+	})
+	return reply, err
+}
+
+func (s *vmService) ListVMPage(ctx context.Context, req *pb.ListRequest) (*pb.VMPageInfo, error) {
+	var reply *pb.VMPageInfo
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := vmHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		pageInfo, err := handler.ListVMPage(toListReqInfo(req.GetListReqInfo()))
+		if err != nil {
+			return err
+		}
+		reply = fromVMPageInfo(pageInfo)
+		return nil
+	})
+	return reply, err
+}
+
+// VMHandler.GetVM()은 error를 반환하지 않으므로 Id가 없으면 NotFound로 응답 함.
+func (s *vmService) GetVM(ctx context.Context, req *pb.IDRequest) (*pb.VMInfo, error) {
+	var reply *pb.VMInfo
+	err := withConnection(ctx, req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := vmHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+		vmInfo := handler.GetVM(req.GetId())
+		if vmInfo.Id == "" {
+			return irs.NotFound("%s: VM not found", req.GetId())
+		}
+		reply = fromVMInfo(vmInfo)
+		return nil
+	})
+	return reply, err
+}
+
+// WatchVMStatus polls the VM status every interval and sends an event when it changes.
+// One connection is kept during the stream.
+func (s *vmService) WatchVMStatus(req *pb.WatchVMStatusRequest, stream grpc.ServerStreamingServer[pb.VMStatusEvent]) error {
+	interval := DefaultWatchInterval
+	if req.GetIntervalSeconds() > 0 {
+		interval = time.Duration(req.GetIntervalSeconds()) * time.Second
+	}
+
+	return withConnection(stream.Context(), req.GetConnectionName(), func(cloudConnection icon.CloudConnection) error {
+		handler, err := vmHandler(cloudConnection)
+		if err != nil {
+			return err
+		}
+
+		watcher := vmStatusWatcher{handler: handler, vmID: req.GetVmId(), statusMap: map[string]irs.VMStatus{}}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if stream.Context().Err() != nil {
+				return nil
+			}
+			done, err := watcher.poll(stream.Send)
+			if err != nil || done {
+				return err
+			}
+
+			select {
+			case <-stream.Context().Done():
+				return nil
+			case <-ticker.C:
+			}
+		}
+	})
+}
+
+type vmStatusWatcher struct {
+	handler   irs.VMHandler
+	vmID      string // "": every VM
+	statusMap map[string]irs.VMStatus
+}
+
+// poll sends events of changed VMs. done: the watched VM is terminated.
+// The watched VM without a status("") is not found, or terminated if it was found before.
+func (watcher *vmStatusWatcher) poll(send func(*pb.VMStatusEvent) error) (bool, error) {
+	current := map[string]irs.VMStatus{}
+	if watcher.vmID != "" {
+		vmStatus := watcher.handler.GetVMStatus(watcher.vmID)
+		if vmStatus == "" {
+			if _, ok := watcher.statusMap[watcher.vmID]; !ok {
+				return false, irs.NotFound("%s: VM not found", watcher.vmID)
+			}
+			vmStatus = terminatedStatus
+		}
+		current[watcher.vmID] = vmStatus
+	} else {
+		for _, statusInfo := range watcher.handler.ListVMStatus() {
+			current[statusInfo.VmId] = statusInfo.VmStatus
+		}
+		// 목록에서 사라진 VM은 삭제된 것으로 보고 함.
+		for vmID, previous := range watcher.statusMap {
+			if _, ok := current[vmID]; !ok && previous != terminatedStatus {
+				current[vmID] = terminatedStatus
+			}
+		}
+	}
+
+	now := timestamppb.Now()
+	for vmID, vmStatus := range current {
+		previous, ok := watcher.statusMap[vmID]
+		// 상태를 알 수 없는 경우("")는 변경으로 보지 않음.
+		if vmStatus == "" || (ok && previous == vmStatus) {
+			continue
+		}
+		event := &pb.VMStatusEvent{VmId: vmID, VmStatus: string(vmStatus), PreviousStatus: string(previous), Time: now}
+		if err := send(event); err != nil {
+			return false, err
+		}
+		watcher.statusMap[vmID] = vmStatus
+	}
+
+	if watcher.vmID != "" {
+		return watcher.statusMap[watcher.vmID] == terminatedStatus, nil
+	}
+	for vmID, vmStatus := range watcher.statusMap {
+		if vmStatus == terminatedStatus {
+			if _, ok := current[vmID]; !ok {
+				delete(watcher.statusMap, vmID)
+			}
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,3 @@
+# requires protoc, protoc-gen-go and protoc-gen-go-grpc in $PATH
+cd $CB_SPIDER_ROOT/api-runtime/grpc-runtime/spiderpb
+protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative spider.proto
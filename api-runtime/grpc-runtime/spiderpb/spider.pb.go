@@ -0,0 +1,3575 @@
+// gRPC API of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// Messages mirror cloud-driver/interfaces/resources(irs).
+// Resources are addressed by connection config name, like the REST API.
+// Errors are gRPC status codes mapped from irs error codes, ex) irs.NotFoundError -> NOT_FOUND
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: spider.proto
+
+package spiderpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ConnectionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionName string                 `protobuf:"bytes,1,opt,name=connection_name,json=connectionName,proto3" json:"connection_name,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ConnectionRequest) Reset() {
+	*x = ConnectionRequest{}
+	mi := &file_spider_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionRequest) ProtoMessage() {}
+
+func (x *ConnectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionRequest.ProtoReflect.Descriptor instead.
+func (*ConnectionRequest) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConnectionRequest) GetConnectionName() string {
+	if x != nil {
+		return x.ConnectionName
+	}
+	return ""
+}
+
+// id: resource ID of the handler, ex) VM ID, image ID
+type IDRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionName string                 `protobuf:"bytes,1,opt,name=connection_name,json=connectionName,proto3" json:"connection_name,omitempty"`
+	Id             string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *IDRequest) Reset() {
+	*x = IDRequest{}
+	mi := &file_spider_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IDRequest) ProtoMessage() {}
+
+func (x *IDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IDRequest.ProtoReflect.Descriptor instead.
+func (*IDRequest) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IDRequest) GetConnectionName() string {
+	if x != nil {
+		return x.ConnectionName
+	}
+	return ""
+}
+
+func (x *IDRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListReqInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                                                                             // 0: CSP default(or all, if the CSP does not page)
+	NextToken     string                 `protobuf:"bytes,2,opt,name=next_token,json=nextToken,proto3" json:"next_token,omitempty"`                                                                           // "": first page
+	NameFilter    string                 `protobuf:"bytes,3,opt,name=name_filter,json=nameFilter,proto3" json:"name_filter,omitempty"`                                                                        // '*', '?' wildcard, ex) web-*
+	TagFilter     map[string]string      `protobuf:"bytes,4,rep,name=tag_filter,json=tagFilter,proto3" json:"tag_filter,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // key: value, AWS Tag, GCP Label, Azure Tag
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReqInfo) Reset() {
+	*x = ListReqInfo{}
+	mi := &file_spider_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReqInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReqInfo) ProtoMessage() {}
+
+func (x *ListReqInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReqInfo.ProtoReflect.Descriptor instead.
+func (*ListReqInfo) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListReqInfo) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListReqInfo) GetNextToken() string {
+	if x != nil {
+		return x.NextToken
+	}
+	return ""
+}
+
+func (x *ListReqInfo) GetNameFilter() string {
+	if x != nil {
+		return x.NameFilter
+	}
+	return ""
+}
+
+func (x *ListReqInfo) GetTagFilter() map[string]string {
+	if x != nil {
+		return x.TagFilter
+	}
+	return nil
+}
+
+type ListRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionName string                 `protobuf:"bytes,1,opt,name=connection_name,json=connectionName,proto3" json:"connection_name,omitempty"`
+	ListReqInfo    *ListReqInfo           `protobuf:"bytes,2,opt,name=list_req_info,json=listReqInfo,proto3" json:"list_req_info,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListRequest) Reset() {
+	*x = ListRequest{}
+	mi := &file_spider_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRequest) ProtoMessage() {}
+
+func (x *ListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRequest.ProtoReflect.Descriptor instead.
+func (*ListRequest) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListRequest) GetConnectionName() string {
+	if x != nil {
+		return x.ConnectionName
+	}
+	return ""
+}
+
+func (x *ListRequest) GetListReqInfo() *ListReqInfo {
+	if x != nil {
+		return x.ListReqInfo
+	}
+	return nil
+}
+
+// Result of Delete, Associate and Disassociate calls
+type ResultReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResultReply) Reset() {
+	*x = ResultReply{}
+	mi := &file_spider_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResultReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultReply) ProtoMessage() {}
+
+func (x *ResultReply) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultReply.ProtoReflect.Descriptor instead.
+func (*ResultReply) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ResultReply) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+type ImageReqInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageReqInfo) Reset() {
+	*x = ImageReqInfo{}
+	mi := &file_spider_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageReqInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageReqInfo) ProtoMessage() {}
+
+func (x *ImageReqInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageReqInfo.ProtoReflect.Descriptor instead.
+func (*ImageReqInfo) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ImageReqInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImageReqInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ImageInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	GuestOs       string                 `protobuf:"bytes,3,opt,name=guest_os,json=guestOs,proto3" json:"guest_os,omitempty"` // normalized OS family, ex) ubuntu, centos, windows
+	Architecture  string                 `protobuf:"bytes,4,opt,name=architecture,proto3" json:"architecture,omitempty"`      // normalized, ex) x86_64, arm64
+	SizeGb        int64                  `protobuf:"varint,5,opt,name=size_gb,json=sizeGb,proto3" json:"size_gb,omitempty"`
+	CreationTime  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"` // unset if the CSP does not report it.
+	Visibility    string                 `protobuf:"bytes,7,opt,name=visibility,proto3" json:"visibility,omitempty"`                         // PUBLIC, PRIVATE
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageInfo) Reset() {
+	*x = ImageInfo{}
+	mi := &file_spider_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageInfo) ProtoMessage() {}
+
+func (x *ImageInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageInfo.ProtoReflect.Descriptor instead.
+func (*ImageInfo) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ImageInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImageInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImageInfo) GetGuestOs() string {
+	if x != nil {
+		return x.GuestOs
+	}
+	return ""
+}
+
+func (x *ImageInfo) GetArchitecture() string {
+	if x != nil {
+		return x.Architecture
+	}
+	return ""
+}
+
+func (x *ImageInfo) GetSizeGb() int64 {
+	if x != nil {
+		return x.SizeGb
+	}
+	return 0
+}
+
+func (x *ImageInfo) GetCreationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreationTime
+	}
+	return nil
+}
+
+func (x *ImageInfo) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+type ImageFilterInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Owner         string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	GuestOs       string                 `protobuf:"bytes,2,opt,name=guest_os,json=guestOs,proto3" json:"guest_os,omitempty"`
+	Architecture  string                 `protobuf:"bytes,3,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	NamePattern   string                 `protobuf:"bytes,4,opt,name=name_pattern,json=namePattern,proto3" json:"name_pattern,omitempty"` // '*', '?' wildcard, ex) ubuntu-18.04*
+	Visibility    string                 `protobuf:"bytes,5,opt,name=visibility,proto3" json:"visibility,omitempty"`                      // PUBLIC, PRIVATE or "" (all)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageFilterInfo) Reset() {
+	*x = ImageFilterInfo{}
+	mi := &file_spider_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageFilterInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageFilterInfo) ProtoMessage() {}
+
+func (x *ImageFilterInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageFilterInfo.ProtoReflect.Descriptor instead.
+func (*ImageFilterInfo) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ImageFilterInfo) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ImageFilterInfo) GetGuestOs() string {
+	if x != nil {
+		return x.GuestOs
+	}
+	return ""
+}
+
+func (x *ImageFilterInfo) GetArchitecture() string {
+	if x != nil {
+		return x.Architecture
+	}
+	return ""
+}
+
+func (x *ImageFilterInfo) GetNamePattern() string {
+	if x != nil {
+		return x.NamePattern
+	}
+	return ""
+}
+
+func (x *ImageFilterInfo) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+type ImagePageInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ImageInfoList []*ImageInfo           `protobuf:"bytes,1,rep,name=image_info_list,json=imageInfoList,proto3" json:"image_info_list,omitempty"`
+	NextToken     string                 `protobuf:"bytes,2,opt,name=next_token,json=nextToken,proto3" json:"next_token,omitempty"` // "": last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImagePageInfo) Reset() {
+	*x = ImagePageInfo{}
+	mi := &file_spider_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImagePageInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImagePageInfo) ProtoMessage() {}
+
+func (x *ImagePageInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImagePageInfo.ProtoReflect.Descriptor instead.
+func (*ImagePageInfo) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ImagePageInfo) GetImageInfoList() []*ImageInfo {
+	if x != nil {
+		return x.ImageInfoList
+	}
+	return nil
+}
+
+func (x *ImagePageInfo) GetNextToken() string {
+	if x != nil {
+		return x.NextToken
+	}
+	return ""
+}
+
+type CreateImageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionName string                 `protobuf:"bytes,1,opt,name=connection_name,json=connectionName,proto3" json:"connection_name,omitempty"`
+	ImageReqInfo   *ImageReqInfo          `protobuf:"bytes,2,opt,name=image_req_info,json=imageReqInfo,proto3" json:"image_req_info,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateImageRequest) Reset() {
+	*x = CreateImageRequest{}
+	mi := &file_spider_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateImageRequest) ProtoMessage() {}
+
+func (x *CreateImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateImageRequest.ProtoReflect.Descriptor instead.
+func (*CreateImageRequest) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateImageRequest) GetConnectionName() string {
+	if x != nil {
+		return x.ConnectionName
+	}
+	return ""
+}
+
+func (x *CreateImageRequest) GetImageReqInfo() *ImageReqInfo {
+	if x != nil {
+		return x.ImageReqInfo
+	}
+	return nil
+}
+
+type ListImageRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionName  string                 `protobuf:"bytes,1,opt,name=connection_name,json=connectionName,proto3" json:"connection_name,omitempty"`
+	ImageFilterInfo *ImageFilterInfo       `protobuf:"bytes,2,opt,name=image_filter_info,json=imageFilterInfo,proto3" json:"image_filter_info,omitempty"`
+	ListReqInfo     *ListReqInfo           `protobuf:"bytes,3,opt,name=list_req_info,json=listReqInfo,proto3" json:"list_req_info,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListImageRequest) Reset() {
+	*x = ListImageRequest{}
+	mi := &file_spider_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListImageRequest) ProtoMessage() {}
+
+func (x *ListImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListImageRequest.ProtoReflect.Descriptor instead.
+func (*ListImageRequest) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListImageRequest) GetConnectionName() string {
+	if x != nil {
+		return x.ConnectionName
+	}
+	return ""
+}
+
+func (x *ListImageRequest) GetImageFilterInfo() *ImageFilterInfo {
+	if x != nil {
+		return x.ImageFilterInfo
+	}
+	return nil
+}
+
+func (x *ListImageRequest) GetListReqInfo() *ListReqInfo {
+	if x != nil {
+		return x.ListReqInfo
+	}
+	return nil
+}
+
+type VNetworkReqInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VNetworkReqInfo) Reset() {
+	*x = VNetworkReqInfo{}
+	mi := &file_spider_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VNetworkReqInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VNetworkReqInfo) ProtoMessage() {}
+
+func (x *VNetworkReqInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VNetworkReqInfo.ProtoReflect.Descriptor instead.
+func (*VNetworkReqInfo) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *VNetworkReqInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VNetworkReqInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type VNetworkInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	SubnetId      string                 `protobuf:"bytes,3,opt,name=subnet_id,json=subnetId,proto3" json:"subnet_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VNetworkInfo) Reset() {
+	*x = VNetworkInfo{}
+	mi := &file_spider_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VNetworkInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VNetworkInfo) ProtoMessage() {}
+
+func (x *VNetworkInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VNetworkInfo.ProtoReflect.Descriptor instead.
+func (*VNetworkInfo) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *VNetworkInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VNetworkInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VNetworkInfo) GetSubnetId() string {
+	if x != nil {
+		return x.SubnetId
+	}
+	return ""
+}
+
+type VNetworkPageInfo struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	VnetworkInfoList []*VNetworkInfo        `protobuf:"bytes,1,rep,name=vnetwork_info_list,json=vnetworkInfoList,proto3" json:"vnetwork_info_list,omitempty"`
+	NextToken        string                 `protobuf:"bytes,2,opt,name=next_token,json=nextToken,proto3" json:"next_token,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *VNetworkPageInfo) Reset() {
+	*x = VNetworkPageInfo{}
+	mi := &file_spider_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VNetworkPageInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VNetworkPageInfo) ProtoMessage() {}
+
+func (x *VNetworkPageInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VNetworkPageInfo.ProtoReflect.Descriptor instead.
+func (*VNetworkPageInfo) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *VNetworkPageInfo) GetVnetworkInfoList() []*VNetworkInfo {
+	if x != nil {
+		return x.VnetworkInfoList
+	}
+	return nil
+}
+
+func (x *VNetworkPageInfo) GetNextToken() string {
+	if x != nil {
+		return x.NextToken
+	}
+	return ""
+}
+
+type CreateVNetworkRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionName  string                 `protobuf:"bytes,1,opt,name=connection_name,json=connectionName,proto3" json:"connection_name,omitempty"`
+	VnetworkReqInfo *VNetworkReqInfo       `protobuf:"bytes,2,opt,name=vnetwork_req_info,json=vnetworkReqInfo,proto3" json:"vnetwork_req_info,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateVNetworkRequest) Reset() {
+	*x = CreateVNetworkRequest{}
+	mi := &file_spider_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVNetworkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVNetworkRequest) ProtoMessage() {}
+
+func (x *CreateVNetworkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVNetworkRequest.ProtoReflect.Descriptor instead.
+func (*CreateVNetworkRequest) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateVNetworkRequest) GetConnectionName() string {
+	if x != nil {
+		return x.ConnectionName
+	}
+	return ""
+}
+
+func (x *CreateVNetworkRequest) GetVnetworkReqInfo() *VNetworkReqInfo {
+	if x != nil {
+		return x.VnetworkReqInfo
+	}
+	return nil
+}
+
+type SecurityRuleInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromPort      int64                  `protobuf:"varint,1,opt,name=from_port,json=fromPort,proto3" json:"from_port,omitempty"`
+	ToPort        int64                  `protobuf:"varint,2,opt,name=to_port,json=toPort,proto3" json:"to_port,omitempty"`
+	IpProtocol    string                 `protobuf:"bytes,3,opt,name=ip_protocol,json=ipProtocol,proto3" json:"ip_protocol,omitempty"`
+	Cidr          string                 `protobuf:"bytes,4,opt,name=cidr,proto3" json:"cidr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecurityRuleInfo) Reset() {
+	*x = SecurityRuleInfo{}
+	mi := &file_spider_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecurityRuleInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityRuleInfo) ProtoMessage() {}
+
+func (x *SecurityRuleInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityRuleInfo.ProtoReflect.Descriptor instead.
+func (*SecurityRuleInfo) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SecurityRuleInfo) GetFromPort() int64 {
+	if x != nil {
+		return x.FromPort
+	}
+	return 0
+}
+
+func (x *SecurityRuleInfo) GetToPort() int64 {
+	if x != nil {
+		return x.ToPort
+	}
+	return 0
+}
+
+func (x *SecurityRuleInfo) GetIpProtocol() string {
+	if x != nil {
+		return x.IpProtocol
+	}
+	return ""
+}
+
+func (x *SecurityRuleInfo) GetCidr() string {
+	if x != nil {
+		return x.Cidr
+	}
+	return ""
+}
+
+type SecurityReqInfo struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Name                string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id                  string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	GroupName           string                 `protobuf:"bytes,3,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	Description         string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	VpcId               string                 `protobuf:"bytes,5,opt,name=vpc_id,json=vpcId,proto3" json:"vpc_id,omitempty"`
+	IpPermissions       []*SecurityRuleInfo    `protobuf:"bytes,6,rep,name=ip_permissions,json=ipPermissions,proto3" json:"ip_permissions,omitempty"`                     // InBounds
+	IpPermissionsEgress []*SecurityRuleInfo    `protobuf:"bytes,7,rep,name=ip_permissions_egress,json=ipPermissionsEgress,proto3" json:"ip_permissions_egress,omitempty"` // OutBounds
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SecurityReqInfo) Reset() {
+	*x = SecurityReqInfo{}
+	mi := &file_spider_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecurityReqInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityReqInfo) ProtoMessage() {}
+
+func (x *SecurityReqInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityReqInfo.ProtoReflect.Descriptor instead.
+func (*SecurityReqInfo) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SecurityReqInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SecurityReqInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SecurityReqInfo) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *SecurityReqInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SecurityReqInfo) GetVpcId() string {
+	if x != nil {
+		return x.VpcId
+	}
+	return ""
+}
+
+func (x *SecurityReqInfo) GetIpPermissions() []*SecurityRuleInfo {
+	if x != nil {
+		return x.IpPermissions
+	}
+	return nil
+}
+
+func (x *SecurityReqInfo) GetIpPermissionsEgress() []*SecurityRuleInfo {
+	if x != nil {
+		return x.IpPermissionsEgress
+	}
+	return nil
+}
+
+type SecurityInfo struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Name                string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id                  string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	GroupName           string                 `protobuf:"bytes,3,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	GroupId             string                 `protobuf:"bytes,4,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	IpPermissions       []*SecurityRuleInfo    `protobuf:"bytes,5,rep,name=ip_permissions,json=ipPermissions,proto3" json:"ip_permissions,omitempty"`
+	IpPermissionsEgress []*SecurityRuleInfo    `protobuf:"bytes,6,rep,name=ip_permissions_egress,json=ipPermissionsEgress,proto3" json:"ip_permissions_egress,omitempty"`
+	Description         string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	VpcId               string                 `protobuf:"bytes,8,opt,name=vpc_id,json=vpcId,proto3" json:"vpc_id,omitempty"`
+	OwnerId             string                 `protobuf:"bytes,9,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SecurityInfo) Reset() {
+	*x = SecurityInfo{}
+	mi := &file_spider_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecurityInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityInfo) ProtoMessage() {}
+
+func (x *SecurityInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityInfo.ProtoReflect.Descriptor instead.
+func (*SecurityInfo) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SecurityInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SecurityInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SecurityInfo) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *SecurityInfo) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *SecurityInfo) GetIpPermissions() []*SecurityRuleInfo {
+	if x != nil {
+		return x.IpPermissions
+	}
+	return nil
+}
+
+func (x *SecurityInfo) GetIpPermissionsEgress() []*SecurityRuleInfo {
+	if x != nil {
+		return x.IpPermissionsEgress
+	}
+	return nil
+}
+
+func (x *SecurityInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SecurityInfo) GetVpcId() string {
+	if x != nil {
+		return x.VpcId
+	}
+	return ""
+}
+
+func (x *SecurityInfo) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+type SecurityPageInfo struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SecurityInfoList []*SecurityInfo        `protobuf:"bytes,1,rep,name=security_info_list,json=securityInfoList,proto3" json:"security_info_list,omitempty"`
+	NextToken        string                 `protobuf:"bytes,2,opt,name=next_token,json=nextToken,proto3" json:"next_token,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SecurityPageInfo) Reset() {
+	*x = SecurityPageInfo{}
+	mi := &file_spider_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecurityPageInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityPageInfo) ProtoMessage() {}
+
+func (x *SecurityPageInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityPageInfo.ProtoReflect.Descriptor instead.
+func (*SecurityPageInfo) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SecurityPageInfo) GetSecurityInfoList() []*SecurityInfo {
+	if x != nil {
+		return x.SecurityInfoList
+	}
+	return nil
+}
+
+func (x *SecurityPageInfo) GetNextToken() string {
+	if x != nil {
+		return x.NextToken
+	}
+	return ""
+}
+
+type CreateSecurityRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionName  string                 `protobuf:"bytes,1,opt,name=connection_name,json=connectionName,proto3" json:"connection_name,omitempty"`
+	SecurityReqInfo *SecurityReqInfo       `protobuf:"bytes,2,opt,name=security_req_info,json=securityReqInfo,proto3" json:"security_req_info,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateSecurityRequest) Reset() {
+	*x = CreateSecurityRequest{}
+	mi := &file_spider_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSecurityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSecurityRequest) ProtoMessage() {}
+
+func (x *CreateSecurityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSecurityRequest.ProtoReflect.Descriptor instead.
+func (*CreateSecurityRequest) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateSecurityRequest) GetConnectionName() string {
+	if x != nil {
+		return x.ConnectionName
+	}
+	return ""
+}
+
+func (x *CreateSecurityRequest) GetSecurityReqInfo() *SecurityReqInfo {
+	if x != nil {
+		return x.SecurityReqInfo
+	}
+	return nil
+}
+
+type KeyPairReqInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeyPairReqInfo) Reset() {
+	*x = KeyPairReqInfo{}
+	mi := &file_spider_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyPairReqInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyPairReqInfo) ProtoMessage() {}
+
+func (x *KeyPairReqInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyPairReqInfo.ProtoReflect.Descriptor instead.
+func (*KeyPairReqInfo) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *KeyPairReqInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KeyPairReqInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type KeyPairInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Fingerprint   string                 `protobuf:"bytes,3,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	KeyMaterial   string                 `protobuf:"bytes,4,opt,name=key_material,json=keyMaterial,proto3" json:"key_material,omitempty"` // private key, only returned by CreateKey
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeyPairInfo) Reset() {
+	*x = KeyPairInfo{}
+	mi := &file_spider_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyPairInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyPairInfo) ProtoMessage() {}
+
+func (x *KeyPairInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyPairInfo.ProtoReflect.Descriptor instead.
+func (*KeyPairInfo) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *KeyPairInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KeyPairInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *KeyPairInfo) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *KeyPairInfo) GetKeyMaterial() string {
+	if x != nil {
+		return x.KeyMaterial
+	}
+	return ""
+}
+
+type KeyPairPageInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	KeyPairInfoList []*KeyPairInfo         `protobuf:"bytes,1,rep,name=key_pair_info_list,json=keyPairInfoList,proto3" json:"key_pair_info_list,omitempty"`
+	NextToken       string                 `protobuf:"bytes,2,opt,name=next_token,json=nextToken,proto3" json:"next_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *KeyPairPageInfo) Reset() {
+	*x = KeyPairPageInfo{}
+	mi := &file_spider_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyPairPageInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyPairPageInfo) ProtoMessage() {}
+
+func (x *KeyPairPageInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyPairPageInfo.ProtoReflect.Descriptor instead.
+func (*KeyPairPageInfo) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *KeyPairPageInfo) GetKeyPairInfoList() []*KeyPairInfo {
+	if x != nil {
+		return x.KeyPairInfoList
+	}
+	return nil
+}
+
+func (x *KeyPairPageInfo) GetNextToken() string {
+	if x != nil {
+		return x.NextToken
+	}
+	return ""
+}
+
+type CreateKeyRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionName string                 `protobuf:"bytes,1,opt,name=connection_name,json=connectionName,proto3" json:"connection_name,omitempty"`
+	KeyPairReqInfo *KeyPairReqInfo        `protobuf:"bytes,2,opt,name=key_pair_req_info,json=keyPairReqInfo,proto3" json:"key_pair_req_info,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateKeyRequest) Reset() {
+	*x = CreateKeyRequest{}
+	mi := &file_spider_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateKeyRequest) ProtoMessage() {}
+
+func (x *CreateKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateKeyRequest) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateKeyRequest) GetConnectionName() string {
+	if x != nil {
+		return x.ConnectionName
+	}
+	return ""
+}
+
+func (x *CreateKeyRequest) GetKeyPairReqInfo() *KeyPairReqInfo {
+	if x != nil {
+		return x.KeyPairReqInfo
+	}
+	return nil
+}
+
+type VNicReqInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VNicReqInfo) Reset() {
+	*x = VNicReqInfo{}
+	mi := &file_spider_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VNicReqInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VNicReqInfo) ProtoMessage() {}
+
+func (x *VNicReqInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VNicReqInfo.ProtoReflect.Descriptor instead.
+func (*VNicReqInfo) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *VNicReqInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VNicReqInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type VNicInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VNicInfo) Reset() {
+	*x = VNicInfo{}
+	mi := &file_spider_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VNicInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VNicInfo) ProtoMessage() {}
+
+func (x *VNicInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VNicInfo.ProtoReflect.Descriptor instead.
+func (*VNicInfo) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *VNicInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VNicInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type VNicPageInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VnicInfoList  []*VNicInfo            `protobuf:"bytes,1,rep,name=vnic_info_list,json=vnicInfoList,proto3" json:"vnic_info_list,omitempty"`
+	NextToken     string                 `protobuf:"bytes,2,opt,name=next_token,json=nextToken,proto3" json:"next_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VNicPageInfo) Reset() {
+	*x = VNicPageInfo{}
+	mi := &file_spider_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VNicPageInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VNicPageInfo) ProtoMessage() {}
+
+func (x *VNicPageInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VNicPageInfo.ProtoReflect.Descriptor instead.
+func (*VNicPageInfo) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *VNicPageInfo) GetVnicInfoList() []*VNicInfo {
+	if x != nil {
+		return x.VnicInfoList
+	}
+	return nil
+}
+
+func (x *VNicPageInfo) GetNextToken() string {
+	if x != nil {
+		return x.NextToken
+	}
+	return ""
+}
+
+type CreateVNicRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionName string                 `protobuf:"bytes,1,opt,name=connection_name,json=connectionName,proto3" json:"connection_name,omitempty"`
+	VnicReqInfo    *VNicReqInfo           `protobuf:"bytes,2,opt,name=vnic_req_info,json=vnicReqInfo,proto3" json:"vnic_req_info,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateVNicRequest) Reset() {
+	*x = CreateVNicRequest{}
+	mi := &file_spider_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVNicRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVNicRequest) ProtoMessage() {}
+
+func (x *CreateVNicRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVNicRequest.ProtoReflect.Descriptor instead.
+func (*CreateVNicRequest) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateVNicRequest) GetConnectionName() string {
+	if x != nil {
+		return x.ConnectionName
+	}
+	return ""
+}
+
+func (x *CreateVNicRequest) GetVnicReqInfo() *VNicReqInfo {
+	if x != nil {
+		return x.VnicReqInfo
+	}
+	return nil
+}
+
+type PublicIPReqInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublicIPReqInfo) Reset() {
+	*x = PublicIPReqInfo{}
+	mi := &file_spider_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublicIPReqInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicIPReqInfo) ProtoMessage() {}
+
+func (x *PublicIPReqInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicIPReqInfo.ProtoReflect.Descriptor instead.
+func (*PublicIPReqInfo) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PublicIPReqInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PublicIPReqInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PublicIPInfo struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Name                    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id                      string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Domain                  string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	PublicIp                string                 `protobuf:"bytes,4,opt,name=public_ip,json=publicIp,proto3" json:"public_ip,omitempty"`
+	PublicIpv4Pool          string                 `protobuf:"bytes,5,opt,name=public_ipv4_pool,json=publicIpv4Pool,proto3" json:"public_ipv4_pool,omitempty"`
+	AllocationId            string                 `protobuf:"bytes,6,opt,name=allocation_id,json=allocationId,proto3" json:"allocation_id,omitempty"`
+	AssociationId           string                 `protobuf:"bytes,7,opt,name=association_id,json=associationId,proto3" json:"association_id,omitempty"`
+	InstanceId              string                 `protobuf:"bytes,8,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	NetworkInterfaceId      string                 `protobuf:"bytes,9,opt,name=network_interface_id,json=networkInterfaceId,proto3" json:"network_interface_id,omitempty"`
+	NetworkInterfaceOwnerId string                 `protobuf:"bytes,10,opt,name=network_interface_owner_id,json=networkInterfaceOwnerId,proto3" json:"network_interface_owner_id,omitempty"`
+	PrivateIpAddress        string                 `protobuf:"bytes,11,opt,name=private_ip_address,json=privateIpAddress,proto3" json:"private_ip_address,omitempty"`
+	Region                  string                 `protobuf:"bytes,12,opt,name=region,proto3" json:"region,omitempty"`
+	CreationTimestamp       string                 `protobuf:"bytes,13,opt,name=creation_timestamp,json=creationTimestamp,proto3" json:"creation_timestamp,omitempty"`
+	Address                 string                 `protobuf:"bytes,14,opt,name=address,proto3" json:"address,omitempty"`
+	NetworkTier             string                 `protobuf:"bytes,15,opt,name=network_tier,json=networkTier,proto3" json:"network_tier,omitempty"`
+	AddressType             string                 `protobuf:"bytes,16,opt,name=address_type,json=addressType,proto3" json:"address_type,omitempty"`
+	Status                  string                 `protobuf:"bytes,17,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *PublicIPInfo) Reset() {
+	*x = PublicIPInfo{}
+	mi := &file_spider_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublicIPInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicIPInfo) ProtoMessage() {}
+
+func (x *PublicIPInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicIPInfo.ProtoReflect.Descriptor instead.
+func (*PublicIPInfo) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PublicIPInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PublicIPInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PublicIPInfo) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *PublicIPInfo) GetPublicIp() string {
+	if x != nil {
+		return x.PublicIp
+	}
+	return ""
+}
+
+func (x *PublicIPInfo) GetPublicIpv4Pool() string {
+	if x != nil {
+		return x.PublicIpv4Pool
+	}
+	return ""
+}
+
+func (x *PublicIPInfo) GetAllocationId() string {
+	if x != nil {
+		return x.AllocationId
+	}
+	return ""
+}
+
+func (x *PublicIPInfo) GetAssociationId() string {
+	if x != nil {
+		return x.AssociationId
+	}
+	return ""
+}
+
+func (x *PublicIPInfo) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *PublicIPInfo) GetNetworkInterfaceId() string {
+	if x != nil {
+		return x.NetworkInterfaceId
+	}
+	return ""
+}
+
+func (x *PublicIPInfo) GetNetworkInterfaceOwnerId() string {
+	if x != nil {
+		return x.NetworkInterfaceOwnerId
+	}
+	return ""
+}
+
+func (x *PublicIPInfo) GetPrivateIpAddress() string {
+	if x != nil {
+		return x.PrivateIpAddress
+	}
+	return ""
+}
+
+func (x *PublicIPInfo) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *PublicIPInfo) GetCreationTimestamp() string {
+	if x != nil {
+		return x.CreationTimestamp
+	}
+	return ""
+}
+
+func (x *PublicIPInfo) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PublicIPInfo) GetNetworkTier() string {
+	if x != nil {
+		return x.NetworkTier
+	}
+	return ""
+}
+
+func (x *PublicIPInfo) GetAddressType() string {
+	if x != nil {
+		return x.AddressType
+	}
+	return ""
+}
+
+func (x *PublicIPInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type PublicIPPageInfo struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PublicIpInfoList []*PublicIPInfo        `protobuf:"bytes,1,rep,name=public_ip_info_list,json=publicIpInfoList,proto3" json:"public_ip_info_list,omitempty"`
+	NextToken        string                 `protobuf:"bytes,2,opt,name=next_token,json=nextToken,proto3" json:"next_token,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PublicIPPageInfo) Reset() {
+	*x = PublicIPPageInfo{}
+	mi := &file_spider_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublicIPPageInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicIPPageInfo) ProtoMessage() {}
+
+func (x *PublicIPPageInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicIPPageInfo.ProtoReflect.Descriptor instead.
+func (*PublicIPPageInfo) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PublicIPPageInfo) GetPublicIpInfoList() []*PublicIPInfo {
+	if x != nil {
+		return x.PublicIpInfoList
+	}
+	return nil
+}
+
+func (x *PublicIPPageInfo) GetNextToken() string {
+	if x != nil {
+		return x.NextToken
+	}
+	return ""
+}
+
+type CreatePublicIPRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionName  string                 `protobuf:"bytes,1,opt,name=connection_name,json=connectionName,proto3" json:"connection_name,omitempty"`
+	PublicIpReqInfo *PublicIPReqInfo       `protobuf:"bytes,2,opt,name=public_ip_req_info,json=publicIpReqInfo,proto3" json:"public_ip_req_info,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreatePublicIPRequest) Reset() {
+	*x = CreatePublicIPRequest{}
+	mi := &file_spider_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePublicIPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePublicIPRequest) ProtoMessage() {}
+
+func (x *CreatePublicIPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePublicIPRequest.ProtoReflect.Descriptor instead.
+func (*CreatePublicIPRequest) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreatePublicIPRequest) GetConnectionName() string {
+	if x != nil {
+		return x.ConnectionName
+	}
+	return ""
+}
+
+func (x *CreatePublicIPRequest) GetPublicIpReqInfo() *PublicIPReqInfo {
+	if x != nil {
+		return x.PublicIpReqInfo
+	}
+	return nil
+}
+
+// vm_id: VM ID or NIC ID, depends on the CSP
+type AssociatePublicIPRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionName string                 `protobuf:"bytes,1,opt,name=connection_name,json=connectionName,proto3" json:"connection_name,omitempty"`
+	PublicIpId     string                 `protobuf:"bytes,2,opt,name=public_ip_id,json=publicIpId,proto3" json:"public_ip_id,omitempty"`
+	VmId           string                 `protobuf:"bytes,3,opt,name=vm_id,json=vmId,proto3" json:"vm_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AssociatePublicIPRequest) Reset() {
+	*x = AssociatePublicIPRequest{}
+	mi := &file_spider_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssociatePublicIPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssociatePublicIPRequest) ProtoMessage() {}
+
+func (x *AssociatePublicIPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssociatePublicIPRequest.ProtoReflect.Descriptor instead.
+func (*AssociatePublicIPRequest) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AssociatePublicIPRequest) GetConnectionName() string {
+	if x != nil {
+		return x.ConnectionName
+	}
+	return ""
+}
+
+func (x *AssociatePublicIPRequest) GetPublicIpId() string {
+	if x != nil {
+		return x.PublicIpId
+	}
+	return ""
+}
+
+func (x *AssociatePublicIPRequest) GetVmId() string {
+	if x != nil {
+		return x.VmId
+	}
+	return ""
+}
+
+type LoginInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdminUsername string                 `protobuf:"bytes,1,opt,name=admin_username,json=adminUsername,proto3" json:"admin_username,omitempty"`
+	AdminPassword string                 `protobuf:"bytes,2,opt,name=admin_password,json=adminPassword,proto3" json:"admin_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginInfo) Reset() {
+	*x = LoginInfo{}
+	mi := &file_spider_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginInfo) ProtoMessage() {}
+
+func (x *LoginInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginInfo.ProtoReflect.Descriptor instead.
+func (*LoginInfo) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *LoginInfo) GetAdminUsername() string {
+	if x != nil {
+		return x.AdminUsername
+	}
+	return ""
+}
+
+func (x *LoginInfo) GetAdminPassword() string {
+	if x != nil {
+		return x.AdminPassword
+	}
+	return ""
+}
+
+type VMReqInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ImageInfo     *ImageInfo             `protobuf:"bytes,2,opt,name=image_info,json=imageInfo,proto3" json:"image_info,omitempty"`
+	VnetworkInfo  *VNetworkInfo          `protobuf:"bytes,3,opt,name=vnetwork_info,json=vnetworkInfo,proto3" json:"vnetwork_info,omitempty"`
+	SecurityInfo  *SecurityInfo          `protobuf:"bytes,4,opt,name=security_info,json=securityInfo,proto3" json:"security_info,omitempty"`
+	KeyPairInfo   *KeyPairInfo           `protobuf:"bytes,5,opt,name=key_pair_info,json=keyPairInfo,proto3" json:"key_pair_info,omitempty"`
+	SpecId        string                 `protobuf:"bytes,6,opt,name=spec_id,json=specId,proto3" json:"spec_id,omitempty"` // instance type or flavour, etc...
+	PublicIpInfo  *PublicIPInfo          `protobuf:"bytes,7,opt,name=public_ip_info,json=publicIpInfo,proto3" json:"public_ip_info,omitempty"`
+	LoginInfo     *LoginInfo             `protobuf:"bytes,8,opt,name=login_info,json=loginInfo,proto3" json:"login_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VMReqInfo) Reset() {
+	*x = VMReqInfo{}
+	mi := &file_spider_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VMReqInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VMReqInfo) ProtoMessage() {}
+
+func (x *VMReqInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VMReqInfo.ProtoReflect.Descriptor instead.
+func (*VMReqInfo) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *VMReqInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VMReqInfo) GetImageInfo() *ImageInfo {
+	if x != nil {
+		return x.ImageInfo
+	}
+	return nil
+}
+
+func (x *VMReqInfo) GetVnetworkInfo() *VNetworkInfo {
+	if x != nil {
+		return x.VnetworkInfo
+	}
+	return nil
+}
+
+func (x *VMReqInfo) GetSecurityInfo() *SecurityInfo {
+	if x != nil {
+		return x.SecurityInfo
+	}
+	return nil
+}
+
+func (x *VMReqInfo) GetKeyPairInfo() *KeyPairInfo {
+	if x != nil {
+		return x.KeyPairInfo
+	}
+	return nil
+}
+
+func (x *VMReqInfo) GetSpecId() string {
+	if x != nil {
+		return x.SpecId
+	}
+	return ""
+}
+
+func (x *VMReqInfo) GetPublicIpInfo() *PublicIPInfo {
+	if x != nil {
+		return x.PublicIpInfo
+	}
+	return nil
+}
+
+func (x *VMReqInfo) GetLoginInfo() *LoginInfo {
+	if x != nil {
+		return x.LoginInfo
+	}
+	return nil
+}
+
+type RegionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Region        string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	Zone          string                 `protobuf:"bytes,2,opt,name=zone,proto3" json:"zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegionInfo) Reset() {
+	*x = RegionInfo{}
+	mi := &file_spider_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegionInfo) ProtoMessage() {}
+
+func (x *RegionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegionInfo.ProtoReflect.Descriptor instead.
+func (*RegionInfo) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RegionInfo) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *RegionInfo) GetZone() string {
+	if x != nil {
+		return x.Zone
+	}
+	return ""
+}
+
+type VMInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id             string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	StartTime      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	Region         *RegionInfo            `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	ImageId        string                 `protobuf:"bytes,5,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	SpecId         string                 `protobuf:"bytes,6,opt,name=spec_id,json=specId,proto3" json:"spec_id,omitempty"`
+	VnetworkId     string                 `protobuf:"bytes,7,opt,name=vnetwork_id,json=vnetworkId,proto3" json:"vnetwork_id,omitempty"`
+	SubNetworkId   string                 `protobuf:"bytes,8,opt,name=sub_network_id,json=subNetworkId,proto3" json:"sub_network_id,omitempty"`
+	SecurityId     string                 `protobuf:"bytes,9,opt,name=security_id,json=securityId,proto3" json:"security_id,omitempty"`
+	Vnic           string                 `protobuf:"bytes,10,opt,name=vnic,proto3" json:"vnic,omitempty"`
+	PublicIp       string                 `protobuf:"bytes,11,opt,name=public_ip,json=publicIp,proto3" json:"public_ip,omitempty"`
+	PublicDns      string                 `protobuf:"bytes,12,opt,name=public_dns,json=publicDns,proto3" json:"public_dns,omitempty"`
+	PrivateIp      string                 `protobuf:"bytes,13,opt,name=private_ip,json=privateIp,proto3" json:"private_ip,omitempty"`
+	PrivateDns     string                 `protobuf:"bytes,14,opt,name=private_dns,json=privateDns,proto3" json:"private_dns,omitempty"`
+	KeyPairId      string                 `protobuf:"bytes,15,opt,name=key_pair_id,json=keyPairId,proto3" json:"key_pair_id,omitempty"`
+	GuestUserId    string                 `protobuf:"bytes,16,opt,name=guest_user_id,json=guestUserId,proto3" json:"guest_user_id,omitempty"`
+	GuestUserPwd   string                 `protobuf:"bytes,17,opt,name=guest_user_pwd,json=guestUserPwd,proto3" json:"guest_user_pwd,omitempty"`
+	GuestBootDisk  string                 `protobuf:"bytes,18,opt,name=guest_boot_disk,json=guestBootDisk,proto3" json:"guest_boot_disk,omitempty"`
+	GuestBlockDisk string                 `protobuf:"bytes,19,opt,name=guest_block_disk,json=guestBlockDisk,proto3" json:"guest_block_disk,omitempty"`
+	AdditionalInfo string                 `protobuf:"bytes,20,opt,name=additional_info,json=additionalInfo,proto3" json:"additional_info,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *VMInfo) Reset() {
+	*x = VMInfo{}
+	mi := &file_spider_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VMInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VMInfo) ProtoMessage() {}
+
+func (x *VMInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VMInfo.ProtoReflect.Descriptor instead.
+func (*VMInfo) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *VMInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VMInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VMInfo) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *VMInfo) GetRegion() *RegionInfo {
+	if x != nil {
+		return x.Region
+	}
+	return nil
+}
+
+func (x *VMInfo) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+func (x *VMInfo) GetSpecId() string {
+	if x != nil {
+		return x.SpecId
+	}
+	return ""
+}
+
+func (x *VMInfo) GetVnetworkId() string {
+	if x != nil {
+		return x.VnetworkId
+	}
+	return ""
+}
+
+func (x *VMInfo) GetSubNetworkId() string {
+	if x != nil {
+		return x.SubNetworkId
+	}
+	return ""
+}
+
+func (x *VMInfo) GetSecurityId() string {
+	if x != nil {
+		return x.SecurityId
+	}
+	return ""
+}
+
+func (x *VMInfo) GetVnic() string {
+	if x != nil {
+		return x.Vnic
+	}
+	return ""
+}
+
+func (x *VMInfo) GetPublicIp() string {
+	if x != nil {
+		return x.PublicIp
+	}
+	return ""
+}
+
+func (x *VMInfo) GetPublicDns() string {
+	if x != nil {
+		return x.PublicDns
+	}
+	return ""
+}
+
+func (x *VMInfo) GetPrivateIp() string {
+	if x != nil {
+		return x.PrivateIp
+	}
+	return ""
+}
+
+func (x *VMInfo) GetPrivateDns() string {
+	if x != nil {
+		return x.PrivateDns
+	}
+	return ""
+}
+
+func (x *VMInfo) GetKeyPairId() string {
+	if x != nil {
+		return x.KeyPairId
+	}
+	return ""
+}
+
+func (x *VMInfo) GetGuestUserId() string {
+	if x != nil {
+		return x.GuestUserId
+	}
+	return ""
+}
+
+func (x *VMInfo) GetGuestUserPwd() string {
+	if x != nil {
+		return x.GuestUserPwd
+	}
+	return ""
+}
+
+func (x *VMInfo) GetGuestBootDisk() string {
+	if x != nil {
+		return x.GuestBootDisk
+	}
+	return ""
+}
+
+func (x *VMInfo) GetGuestBlockDisk() string {
+	if x != nil {
+		return x.GuestBlockDisk
+	}
+	return ""
+}
+
+func (x *VMInfo) GetAdditionalInfo() string {
+	if x != nil {
+		return x.AdditionalInfo
+	}
+	return ""
+}
+
+type VMPageInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VmInfoList    []*VMInfo              `protobuf:"bytes,1,rep,name=vm_info_list,json=vmInfoList,proto3" json:"vm_info_list,omitempty"`
+	NextToken     string                 `protobuf:"bytes,2,opt,name=next_token,json=nextToken,proto3" json:"next_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VMPageInfo) Reset() {
+	*x = VMPageInfo{}
+	mi := &file_spider_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VMPageInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VMPageInfo) ProtoMessage() {}
+
+func (x *VMPageInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VMPageInfo.ProtoReflect.Descriptor instead.
+func (*VMPageInfo) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *VMPageInfo) GetVmInfoList() []*VMInfo {
+	if x != nil {
+		return x.VmInfoList
+	}
+	return nil
+}
+
+func (x *VMPageInfo) GetNextToken() string {
+	if x != nil {
+		return x.NextToken
+	}
+	return ""
+}
+
+// vm_status: PENDING, RUNNING, SUSPENDING, SUSPENDED, REBOOTING, TERMINATING, TERMINATED
+// or a CSP status the driver does not map.
+type VMStatusInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VmId          string                 `protobuf:"bytes,1,opt,name=vm_id,json=vmId,proto3" json:"vm_id,omitempty"`
+	VmStatus      string                 `protobuf:"bytes,2,opt,name=vm_status,json=vmStatus,proto3" json:"vm_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VMStatusInfo) Reset() {
+	*x = VMStatusInfo{}
+	mi := &file_spider_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VMStatusInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VMStatusInfo) ProtoMessage() {}
+
+func (x *VMStatusInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VMStatusInfo.ProtoReflect.Descriptor instead.
+func (*VMStatusInfo) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *VMStatusInfo) GetVmId() string {
+	if x != nil {
+		return x.VmId
+	}
+	return ""
+}
+
+func (x *VMStatusInfo) GetVmStatus() string {
+	if x != nil {
+		return x.VmStatus
+	}
+	return ""
+}
+
+type VMStatusList struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	VmStatusInfoList []*VMStatusInfo        `protobuf:"bytes,1,rep,name=vm_status_info_list,json=vmStatusInfoList,proto3" json:"vm_status_info_list,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *VMStatusList) Reset() {
+	*x = VMStatusList{}
+	mi := &file_spider_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VMStatusList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VMStatusList) ProtoMessage() {}
+
+func (x *VMStatusList) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VMStatusList.ProtoReflect.Descriptor instead.
+func (*VMStatusList) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *VMStatusList) GetVmStatusInfoList() []*VMStatusInfo {
+	if x != nil {
+		return x.VmStatusInfoList
+	}
+	return nil
+}
+
+// Result of one VM of StartVMs
+type VMBatchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	VmInfo        *VMInfo                `protobuf:"bytes,2,opt,name=vm_info,json=vmInfo,proto3" json:"vm_info,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"` // "": created
+	RolledBack    bool                   `protobuf:"varint,4,opt,name=rolled_back,json=rolledBack,proto3" json:"rolled_back,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VMBatchResult) Reset() {
+	*x = VMBatchResult{}
+	mi := &file_spider_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VMBatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VMBatchResult) ProtoMessage() {}
+
+func (x *VMBatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VMBatchResult.ProtoReflect.Descriptor instead.
+func (*VMBatchResult) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *VMBatchResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VMBatchResult) GetVmInfo() *VMInfo {
+	if x != nil {
+		return x.VmInfo
+	}
+	return nil
+}
+
+func (x *VMBatchResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *VMBatchResult) GetRolledBack() bool {
+	if x != nil {
+		return x.RolledBack
+	}
+	return false
+}
+
+type StartVMRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionName string                 `protobuf:"bytes,1,opt,name=connection_name,json=connectionName,proto3" json:"connection_name,omitempty"`
+	VmReqInfo      *VMReqInfo             `protobuf:"bytes,2,opt,name=vm_req_info,json=vmReqInfo,proto3" json:"vm_req_info,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StartVMRequest) Reset() {
+	*x = StartVMRequest{}
+	mi := &file_spider_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartVMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartVMRequest) ProtoMessage() {}
+
+func (x *StartVMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartVMRequest.ProtoReflect.Descriptor instead.
+func (*StartVMRequest) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *StartVMRequest) GetConnectionName() string {
+	if x != nil {
+		return x.ConnectionName
+	}
+	return ""
+}
+
+func (x *StartVMRequest) GetVmReqInfo() *VMReqInfo {
+	if x != nil {
+		return x.VmReqInfo
+	}
+	return nil
+}
+
+type StartVMsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionName string                 `protobuf:"bytes,1,opt,name=connection_name,json=connectionName,proto3" json:"connection_name,omitempty"`
+	VmReqInfo      *VMReqInfo             `protobuf:"bytes,2,opt,name=vm_req_info,json=vmReqInfo,proto3" json:"vm_req_info,omitempty"`
+	Count          int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	MinCount       int32                  `protobuf:"varint,4,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"` // 0: no rollback
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StartVMsRequest) Reset() {
+	*x = StartVMsRequest{}
+	mi := &file_spider_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartVMsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartVMsRequest) ProtoMessage() {}
+
+func (x *StartVMsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartVMsRequest.ProtoReflect.Descriptor instead.
+func (*StartVMsRequest) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *StartVMsRequest) GetConnectionName() string {
+	if x != nil {
+		return x.ConnectionName
+	}
+	return ""
+}
+
+func (x *StartVMsRequest) GetVmReqInfo() *VMReqInfo {
+	if x != nil {
+		return x.VmReqInfo
+	}
+	return nil
+}
+
+func (x *StartVMsRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *StartVMsRequest) GetMinCount() int32 {
+	if x != nil {
+		return x.MinCount
+	}
+	return 0
+}
+
+type StartVMsReply struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	VmBatchResults []*VMBatchResult       `protobuf:"bytes,1,rep,name=vm_batch_results,json=vmBatchResults,proto3" json:"vm_batch_results,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StartVMsReply) Reset() {
+	*x = StartVMsReply{}
+	mi := &file_spider_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartVMsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartVMsReply) ProtoMessage() {}
+
+func (x *StartVMsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartVMsReply.ProtoReflect.Descriptor instead.
+func (*StartVMsReply) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *StartVMsReply) GetVmBatchResults() []*VMBatchResult {
+	if x != nil {
+		return x.VmBatchResults
+	}
+	return nil
+}
+
+type ChangeVMSpecRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionName string                 `protobuf:"bytes,1,opt,name=connection_name,json=connectionName,proto3" json:"connection_name,omitempty"`
+	VmId           string                 `protobuf:"bytes,2,opt,name=vm_id,json=vmId,proto3" json:"vm_id,omitempty"`
+	SpecId         string                 `protobuf:"bytes,3,opt,name=spec_id,json=specId,proto3" json:"spec_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ChangeVMSpecRequest) Reset() {
+	*x = ChangeVMSpecRequest{}
+	mi := &file_spider_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeVMSpecRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeVMSpecRequest) ProtoMessage() {}
+
+func (x *ChangeVMSpecRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeVMSpecRequest.ProtoReflect.Descriptor instead.
+func (*ChangeVMSpecRequest) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ChangeVMSpecRequest) GetConnectionName() string {
+	if x != nil {
+		return x.ConnectionName
+	}
+	return ""
+}
+
+func (x *ChangeVMSpecRequest) GetVmId() string {
+	if x != nil {
+		return x.VmId
+	}
+	return ""
+}
+
+func (x *ChangeVMSpecRequest) GetSpecId() string {
+	if x != nil {
+		return x.SpecId
+	}
+	return ""
+}
+
+// vm_id "": watch every VM of the connection, VMs removed from ListVMStatus are reported as TERMINATED.
+// interval_seconds 0: default(5 seconds)
+type WatchVMStatusRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionName  string                 `protobuf:"bytes,1,opt,name=connection_name,json=connectionName,proto3" json:"connection_name,omitempty"`
+	VmId            string                 `protobuf:"bytes,2,opt,name=vm_id,json=vmId,proto3" json:"vm_id,omitempty"`
+	IntervalSeconds int32                  `protobuf:"varint,3,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WatchVMStatusRequest) Reset() {
+	*x = WatchVMStatusRequest{}
+	mi := &file_spider_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchVMStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchVMStatusRequest) ProtoMessage() {}
+
+func (x *WatchVMStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchVMStatusRequest.ProtoReflect.Descriptor instead.
+func (*WatchVMStatusRequest) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *WatchVMStatusRequest) GetConnectionName() string {
+	if x != nil {
+		return x.ConnectionName
+	}
+	return ""
+}
+
+func (x *WatchVMStatusRequest) GetVmId() string {
+	if x != nil {
+		return x.VmId
+	}
+	return ""
+}
+
+func (x *WatchVMStatusRequest) GetIntervalSeconds() int32 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+// The first event of each VM has no previous_status.
+type VMStatusEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	VmId           string                 `protobuf:"bytes,1,opt,name=vm_id,json=vmId,proto3" json:"vm_id,omitempty"`
+	VmStatus       string                 `protobuf:"bytes,2,opt,name=vm_status,json=vmStatus,proto3" json:"vm_status,omitempty"`
+	PreviousStatus string                 `protobuf:"bytes,3,opt,name=previous_status,json=previousStatus,proto3" json:"previous_status,omitempty"`
+	Time           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *VMStatusEvent) Reset() {
+	*x = VMStatusEvent{}
+	mi := &file_spider_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VMStatusEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VMStatusEvent) ProtoMessage() {}
+
+func (x *VMStatusEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_spider_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VMStatusEvent.ProtoReflect.Descriptor instead.
+func (*VMStatusEvent) Descriptor() ([]byte, []int) {
+	return file_spider_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *VMStatusEvent) GetVmId() string {
+	if x != nil {
+		return x.VmId
+	}
+	return ""
+}
+
+func (x *VMStatusEvent) GetVmStatus() string {
+	if x != nil {
+		return x.VmStatus
+	}
+	return ""
+}
+
+func (x *VMStatusEvent) GetPreviousStatus() string {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return ""
+}
+
+func (x *VMStatusEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+var File_spider_proto protoreflect.FileDescriptor
+
+const file_spider_proto_rawDesc = "" +
+	"\n" +
+	"\fspider.proto\x12\fcbspider.api\x1a\x1fgoogle/protobuf/timestamp.proto\"<\n" +
+	"\x11ConnectionRequest\x12'\n" +
+	"\x0fconnection_name\x18\x01 \x01(\tR\x0econnectionName\"D\n" +
+	"\tIDRequest\x12'\n" +
+	"\x0fconnection_name\x18\x01 \x01(\tR\x0econnectionName\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xf1\x01\n" +
+	"\vListReqInfo\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"next_token\x18\x02 \x01(\tR\tnextToken\x12\x1f\n" +
+	"\vname_filter\x18\x03 \x01(\tR\n" +
+	"nameFilter\x12G\n" +
+	"\n" +
+	"tag_filter\x18\x04 \x03(\v2(.cbspider.api.ListReqInfo.TagFilterEntryR\ttagFilter\x1a<\n" +
+	"\x0eTagFilterEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"u\n" +
+	"\vListRequest\x12'\n" +
+	"\x0fconnection_name\x18\x01 \x01(\tR\x0econnectionName\x12=\n" +
+	"\rlist_req_info\x18\x02 \x01(\v2\x19.cbspider.api.ListReqInfoR\vlistReqInfo\"%\n" +
+	"\vResultReply\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\"2\n" +
+	"\fImageReqInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xe8\x01\n" +
+	"\tImageInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x19\n" +
+	"\bguest_os\x18\x03 \x01(\tR\aguestOs\x12\"\n" +
+	"\farchitecture\x18\x04 \x01(\tR\farchitecture\x12\x17\n" +
+	"\asize_gb\x18\x05 \x01(\x03R\x06sizeGb\x12?\n" +
+	"\rcreation_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\fcreationTime\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\a \x01(\tR\n" +
+	"visibility\"\xa9\x01\n" +
+	"\x0fImageFilterInfo\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x19\n" +
+	"\bguest_os\x18\x02 \x01(\tR\aguestOs\x12\"\n" +
+	"\farchitecture\x18\x03 \x01(\tR\farchitecture\x12!\n" +
+	"\fname_pattern\x18\x04 \x01(\tR\vnamePattern\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x05 \x01(\tR\n" +
+	"visibility\"o\n" +
+	"\rImagePageInfo\x12?\n" +
+	"\x0fimage_info_list\x18\x01 \x03(\v2\x17.cbspider.api.ImageInfoR\rimageInfoList\x12\x1d\n" +
+	"\n" +
+	"next_token\x18\x02 \x01(\tR\tnextToken\"\x7f\n" +
+	"\x12CreateImageRequest\x12'\n" +
+	"\x0fconnection_name\x18\x01 \x01(\tR\x0econnectionName\x12@\n" +
+	"\x0eimage_req_info\x18\x02 \x01(\v2\x1a.cbspider.api.ImageReqInfoR\fimageReqInfo\"\xc5\x01\n" +
+	"\x10ListImageRequest\x12'\n" +
+	"\x0fconnection_name\x18\x01 \x01(\tR\x0econnectionName\x12I\n" +
+	"\x11image_filter_info\x18\x02 \x01(\v2\x1d.cbspider.api.ImageFilterInfoR\x0fimageFilterInfo\x12=\n" +
+	"\rlist_req_info\x18\x03 \x01(\v2\x19.cbspider.api.ListReqInfoR\vlistReqInfo\"5\n" +
+	"\x0fVNetworkReqInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"O\n" +
+	"\fVNetworkInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1b\n" +
+	"\tsubnet_id\x18\x03 \x01(\tR\bsubnetId\"{\n" +
+	"\x10VNetworkPageInfo\x12H\n" +
+	"\x12vnetwork_info_list\x18\x01 \x03(\v2\x1a.cbspider.api.VNetworkInfoR\x10vnetworkInfoList\x12\x1d\n" +
+	"\n" +
+	"next_token\x18\x02 \x01(\tR\tnextToken\"\x8b\x01\n" +
+	"\x15CreateVNetworkRequest\x12'\n" +
+	"\x0fconnection_name\x18\x01 \x01(\tR\x0econnectionName\x12I\n" +
+	"\x11vnetwork_req_info\x18\x02 \x01(\v2\x1d.cbspider.api.VNetworkReqInfoR\x0fvnetworkReqInfo\"}\n" +
+	"\x10SecurityRuleInfo\x12\x1b\n" +
+	"\tfrom_port\x18\x01 \x01(\x03R\bfromPort\x12\x17\n" +
+	"\ato_port\x18\x02 \x01(\x03R\x06toPort\x12\x1f\n" +
+	"\vip_protocol\x18\x03 \x01(\tR\n" +
+	"ipProtocol\x12\x12\n" +
+	"\x04cidr\x18\x04 \x01(\tR\x04cidr\"\xa8\x02\n" +
+	"\x0fSecurityReqInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"group_name\x18\x03 \x01(\tR\tgroupName\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x15\n" +
+	"\x06vpc_id\x18\x05 \x01(\tR\x05vpcId\x12E\n" +
+	"\x0eip_permissions\x18\x06 \x03(\v2\x1e.cbspider.api.SecurityRuleInfoR\ripPermissions\x12R\n" +
+	"\x15ip_permissions_egress\x18\a \x03(\v2\x1e.cbspider.api.SecurityRuleInfoR\x13ipPermissionsEgress\"\xdb\x02\n" +
+	"\fSecurityInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"group_name\x18\x03 \x01(\tR\tgroupName\x12\x19\n" +
+	"\bgroup_id\x18\x04 \x01(\tR\agroupId\x12E\n" +
+	"\x0eip_permissions\x18\x05 \x03(\v2\x1e.cbspider.api.SecurityRuleInfoR\ripPermissions\x12R\n" +
+	"\x15ip_permissions_egress\x18\x06 \x03(\v2\x1e.cbspider.api.SecurityRuleInfoR\x13ipPermissionsEgress\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\x12\x15\n" +
+	"\x06vpc_id\x18\b \x01(\tR\x05vpcId\x12\x19\n" +
+	"\bowner_id\x18\t \x01(\tR\aownerId\"{\n" +
+	"\x10SecurityPageInfo\x12H\n" +
+	"\x12security_info_list\x18\x01 \x03(\v2\x1a.cbspider.api.SecurityInfoR\x10securityInfoList\x12\x1d\n" +
+	"\n" +
+	"next_token\x18\x02 \x01(\tR\tnextToken\"\x8b\x01\n" +
+	"\x15CreateSecurityRequest\x12'\n" +
+	"\x0fconnection_name\x18\x01 \x01(\tR\x0econnectionName\x12I\n" +
+	"\x11security_req_info\x18\x02 \x01(\v2\x1d.cbspider.api.SecurityReqInfoR\x0fsecurityReqInfo\"4\n" +
+	"\x0eKeyPairReqInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"v\n" +
+	"\vKeyPairInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12 \n" +
+	"\vfingerprint\x18\x03 \x01(\tR\vfingerprint\x12!\n" +
+	"\fkey_material\x18\x04 \x01(\tR\vkeyMaterial\"x\n" +
+	"\x0fKeyPairPageInfo\x12F\n" +
+	"\x12key_pair_info_list\x18\x01 \x03(\v2\x19.cbspider.api.KeyPairInfoR\x0fkeyPairInfoList\x12\x1d\n" +
+	"\n" +
+	"next_token\x18\x02 \x01(\tR\tnextToken\"\x84\x01\n" +
+	"\x10CreateKeyRequest\x12'\n" +
+	"\x0fconnection_name\x18\x01 \x01(\tR\x0econnectionName\x12G\n" +
+	"\x11key_pair_req_info\x18\x02 \x01(\v2\x1c.cbspider.api.KeyPairReqInfoR\x0ekeyPairReqInfo\"1\n" +
+	"\vVNicReqInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\".\n" +
+	"\bVNicInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"k\n" +
+	"\fVNicPageInfo\x12<\n" +
+	"\x0evnic_info_list\x18\x01 \x03(\v2\x16.cbspider.api.VNicInfoR\fvnicInfoList\x12\x1d\n" +
+	"\n" +
+	"next_token\x18\x02 \x01(\tR\tnextToken\"{\n" +
+	"\x11CreateVNicRequest\x12'\n" +
+	"\x0fconnection_name\x18\x01 \x01(\tR\x0econnectionName\x12=\n" +
+	"\rvnic_req_info\x18\x02 \x01(\v2\x19.cbspider.api.VNicReqInfoR\vvnicReqInfo\"5\n" +
+	"\x0fPublicIPReqInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xda\x04\n" +
+	"\fPublicIPInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x16\n" +
+	"\x06domain\x18\x03 \x01(\tR\x06domain\x12\x1b\n" +
+	"\tpublic_ip\x18\x04 \x01(\tR\bpublicIp\x12(\n" +
+	"\x10public_ipv4_pool\x18\x05 \x01(\tR\x0epublicIpv4Pool\x12#\n" +
+	"\rallocation_id\x18\x06 \x01(\tR\fallocationId\x12%\n" +
+	"\x0eassociation_id\x18\a \x01(\tR\rassociationId\x12\x1f\n" +
+	"\vinstance_id\x18\b \x01(\tR\n" +
+	"instanceId\x120\n" +
+	"\x14network_interface_id\x18\t \x01(\tR\x12networkInterfaceId\x12;\n" +
+	"\x1anetwork_interface_owner_id\x18\n" +
+	" \x01(\tR\x17networkInterfaceOwnerId\x12,\n" +
+	"\x12private_ip_address\x18\v \x01(\tR\x10privateIpAddress\x12\x16\n" +
+	"\x06region\x18\f \x01(\tR\x06region\x12-\n" +
+	"\x12creation_timestamp\x18\r \x01(\tR\x11creationTimestamp\x12\x18\n" +
+	"\aaddress\x18\x0e \x01(\tR\aaddress\x12!\n" +
+	"\fnetwork_tier\x18\x0f \x01(\tR\vnetworkTier\x12!\n" +
+	"\faddress_type\x18\x10 \x01(\tR\vaddressType\x12\x16\n" +
+	"\x06status\x18\x11 \x01(\tR\x06status\"|\n" +
+	"\x10PublicIPPageInfo\x12I\n" +
+	"\x13public_ip_info_list\x18\x01 \x03(\v2\x1a.cbspider.api.PublicIPInfoR\x10publicIpInfoList\x12\x1d\n" +
+	"\n" +
+	"next_token\x18\x02 \x01(\tR\tnextToken\"\x8c\x01\n" +
+	"\x15CreatePublicIPRequest\x12'\n" +
+	"\x0fconnection_name\x18\x01 \x01(\tR\x0econnectionName\x12J\n" +
+	"\x12public_ip_req_info\x18\x02 \x01(\v2\x1d.cbspider.api.PublicIPReqInfoR\x0fpublicIpReqInfo\"z\n" +
+	"\x18AssociatePublicIPRequest\x12'\n" +
+	"\x0fconnection_name\x18\x01 \x01(\tR\x0econnectionName\x12 \n" +
+	"\fpublic_ip_id\x18\x02 \x01(\tR\n" +
+	"publicIpId\x12\x13\n" +
+	"\x05vm_id\x18\x03 \x01(\tR\x04vmId\"Y\n" +
+	"\tLoginInfo\x12%\n" +
+	"\x0eadmin_username\x18\x01 \x01(\tR\radminUsername\x12%\n" +
+	"\x0eadmin_password\x18\x02 \x01(\tR\radminPassword\"\xab\x03\n" +
+	"\tVMReqInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x126\n" +
+	"\n" +
+	"image_info\x18\x02 \x01(\v2\x17.cbspider.api.ImageInfoR\timageInfo\x12?\n" +
+	"\rvnetwork_info\x18\x03 \x01(\v2\x1a.cbspider.api.VNetworkInfoR\fvnetworkInfo\x12?\n" +
+	"\rsecurity_info\x18\x04 \x01(\v2\x1a.cbspider.api.SecurityInfoR\fsecurityInfo\x12=\n" +
+	"\rkey_pair_info\x18\x05 \x01(\v2\x19.cbspider.api.KeyPairInfoR\vkeyPairInfo\x12\x17\n" +
+	"\aspec_id\x18\x06 \x01(\tR\x06specId\x12@\n" +
+	"\x0epublic_ip_info\x18\a \x01(\v2\x1a.cbspider.api.PublicIPInfoR\fpublicIpInfo\x126\n" +
+	"\n" +
+	"login_info\x18\b \x01(\v2\x17.cbspider.api.LoginInfoR\tloginInfo\"8\n" +
+	"\n" +
+	"RegionInfo\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\x12\x12\n" +
+	"\x04zone\x18\x02 \x01(\tR\x04zone\"\xaa\x05\n" +
+	"\x06VMInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x120\n" +
+	"\x06region\x18\x04 \x01(\v2\x18.cbspider.api.RegionInfoR\x06region\x12\x19\n" +
+	"\bimage_id\x18\x05 \x01(\tR\aimageId\x12\x17\n" +
+	"\aspec_id\x18\x06 \x01(\tR\x06specId\x12\x1f\n" +
+	"\vvnetwork_id\x18\a \x01(\tR\n" +
+	"vnetworkId\x12$\n" +
+	"\x0esub_network_id\x18\b \x01(\tR\fsubNetworkId\x12\x1f\n" +
+	"\vsecurity_id\x18\t \x01(\tR\n" +
+	"securityId\x12\x12\n" +
+	"\x04vnic\x18\n" +
+	" \x01(\tR\x04vnic\x12\x1b\n" +
+	"\tpublic_ip\x18\v \x01(\tR\bpublicIp\x12\x1d\n" +
+	"\n" +
+	"public_dns\x18\f \x01(\tR\tpublicDns\x12\x1d\n" +
+	"\n" +
+	"private_ip\x18\r \x01(\tR\tprivateIp\x12\x1f\n" +
+	"\vprivate_dns\x18\x0e \x01(\tR\n" +
+	"privateDns\x12\x1e\n" +
+	"\vkey_pair_id\x18\x0f \x01(\tR\tkeyPairId\x12\"\n" +
+	"\rguest_user_id\x18\x10 \x01(\tR\vguestUserId\x12$\n" +
+	"\x0eguest_user_pwd\x18\x11 \x01(\tR\fguestUserPwd\x12&\n" +
+	"\x0fguest_boot_disk\x18\x12 \x01(\tR\rguestBootDisk\x12(\n" +
+	"\x10guest_block_disk\x18\x13 \x01(\tR\x0eguestBlockDisk\x12'\n" +
+	"\x0fadditional_info\x18\x14 \x01(\tR\x0eadditionalInfo\"c\n" +
+	"\n" +
+	"VMPageInfo\x126\n" +
+	"\fvm_info_list\x18\x01 \x03(\v2\x14.cbspider.api.VMInfoR\n" +
+	"vmInfoList\x12\x1d\n" +
+	"\n" +
+	"next_token\x18\x02 \x01(\tR\tnextToken\"@\n" +
+	"\fVMStatusInfo\x12\x13\n" +
+	"\x05vm_id\x18\x01 \x01(\tR\x04vmId\x12\x1b\n" +
+	"\tvm_status\x18\x02 \x01(\tR\bvmStatus\"Y\n" +
+	"\fVMStatusList\x12I\n" +
+	"\x13vm_status_info_list\x18\x01 \x03(\v2\x1a.cbspider.api.VMStatusInfoR\x10vmStatusInfoList\"\x89\x01\n" +
+	"\rVMBatchResult\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12-\n" +
+	"\avm_info\x18\x02 \x01(\v2\x14.cbspider.api.VMInfoR\x06vmInfo\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x1f\n" +
+	"\vrolled_back\x18\x04 \x01(\bR\n" +
+	"rolledBack\"r\n" +
+	"\x0eStartVMRequest\x12'\n" +
+	"\x0fconnection_name\x18\x01 \x01(\tR\x0econnectionName\x127\n" +
+	"\vvm_req_info\x18\x02 \x01(\v2\x17.cbspider.api.VMReqInfoR\tvmReqInfo\"\xa6\x01\n" +
+	"\x0fStartVMsRequest\x12'\n" +
+	"\x0fconnection_name\x18\x01 \x01(\tR\x0econnectionName\x127\n" +
+	"\vvm_req_info\x18\x02 \x01(\v2\x17.cbspider.api.VMReqInfoR\tvmReqInfo\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x12\x1b\n" +
+	"\tmin_count\x18\x04 \x01(\x05R\bminCount\"V\n" +
+	"\rStartVMsReply\x12E\n" +
+	"\x10vm_batch_results\x18\x01 \x03(\v2\x1b.cbspider.api.VMBatchResultR\x0evmBatchResults\"l\n" +
+	"\x13ChangeVMSpecRequest\x12'\n" +
+	"\x0fconnection_name\x18\x01 \x01(\tR\x0econnectionName\x12\x13\n" +
+	"\x05vm_id\x18\x02 \x01(\tR\x04vmId\x12\x17\n" +
+	"\aspec_id\x18\x03 \x01(\tR\x06specId\"\x7f\n" +
+	"\x14WatchVMStatusRequest\x12'\n" +
+	"\x0fconnection_name\x18\x01 \x01(\tR\x0econnectionName\x12\x13\n" +
+	"\x05vm_id\x18\x02 \x01(\tR\x04vmId\x12)\n" +
+	"\x10interval_seconds\x18\x03 \x01(\x05R\x0fintervalSeconds\"\x9a\x01\n" +
+	"\rVMStatusEvent\x12\x13\n" +
+	"\x05vm_id\x18\x01 \x01(\tR\x04vmId\x12\x1b\n" +
+	"\tvm_status\x18\x02 \x01(\tR\bvmStatus\x12'\n" +
+	"\x0fprevious_status\x18\x03 \x01(\tR\x0epreviousStatus\x12.\n" +
+	"\x04time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04time2\xa7\x02\n" +
+	"\fImageService\x12H\n" +
+	"\vCreateImage\x12 .cbspider.api.CreateImageRequest\x1a\x17.cbspider.api.ImageInfo\x12L\n" +
+	"\rListImagePage\x12\x1e.cbspider.api.ListImageRequest\x1a\x1b.cbspider.api.ImagePageInfo\x12<\n" +
+	"\bGetImage\x12\x17.cbspider.api.IDRequest\x1a\x17.cbspider.api.ImageInfo\x12A\n" +
+	"\vDeleteImage\x12\x17.cbspider.api.IDRequest\x1a\x19.cbspider.api.ResultReply2\xbd\x02\n" +
+	"\x0fVNetworkService\x12Q\n" +
+	"\x0eCreateVNetwork\x12#.cbspider.api.CreateVNetworkRequest\x1a\x1a.cbspider.api.VNetworkInfo\x12M\n" +
+	"\x10ListVNetworkPage\x12\x19.cbspider.api.ListRequest\x1a\x1e.cbspider.api.VNetworkPageInfo\x12B\n" +
+	"\vGetVNetwork\x12\x17.cbspider.api.IDRequest\x1a\x1a.cbspider.api.VNetworkInfo\x12D\n" +
+	"\x0eDeleteVNetwork\x12\x17.cbspider.api.IDRequest\x1a\x19.cbspider.api.ResultReply2\xbd\x02\n" +
+	"\x0fSecurityService\x12Q\n" +
+	"\x0eCreateSecurity\x12#.cbspider.api.CreateSecurityRequest\x1a\x1a.cbspider.api.SecurityInfo\x12M\n" +
+	"\x10ListSecurityPage\x12\x19.cbspider.api.ListRequest\x1a\x1e.cbspider.api.SecurityPageInfo\x12B\n" +
+	"\vGetSecurity\x12\x17.cbspider.api.IDRequest\x1a\x1a.cbspider.api.SecurityInfo\x12D\n" +
+	"\x0eDeleteSecurity\x12\x17.cbspider.api.IDRequest\x1a\x19.cbspider.api.ResultReply2\xa0\x02\n" +
+	"\x0eKeyPairService\x12F\n" +
+	"\tCreateKey\x12\x1e.cbspider.api.CreateKeyRequest\x1a\x19.cbspider.api.KeyPairInfo\x12G\n" +
+	"\vListKeyPage\x12\x19.cbspider.api.ListRequest\x1a\x1d.cbspider.api.KeyPairPageInfo\x12<\n" +
+	"\x06GetKey\x12\x17.cbspider.api.IDRequest\x1a\x19.cbspider.api.KeyPairInfo\x12?\n" +
+	"\tDeleteKey\x12\x17.cbspider.api.IDRequest\x1a\x19.cbspider.api.ResultReply2\x99\x02\n" +
+	"\vVNicService\x12E\n" +
+	"\n" +
+	"CreateVNic\x12\x1f.cbspider.api.CreateVNicRequest\x1a\x16.cbspider.api.VNicInfo\x12E\n" +
+	"\fListVNicPage\x12\x19.cbspider.api.ListRequest\x1a\x1a.cbspider.api.VNicPageInfo\x12:\n" +
+	"\aGetVNic\x12\x17.cbspider.api.IDRequest\x1a\x16.cbspider.api.VNicInfo\x12@\n" +
+	"\n" +
+	"DeleteVNic\x12\x17.cbspider.api.IDRequest\x1a\x19.cbspider.api.ResultReply2\xe1\x03\n" +
+	"\x0fPublicIPService\x12Q\n" +
+	"\x0eCreatePublicIP\x12#.cbspider.api.CreatePublicIPRequest\x1a\x1a.cbspider.api.PublicIPInfo\x12M\n" +
+	"\x10ListPublicIPPage\x12\x19.cbspider.api.ListRequest\x1a\x1e.cbspider.api.PublicIPPageInfo\x12B\n" +
+	"\vGetPublicIP\x12\x17.cbspider.api.IDRequest\x1a\x1a.cbspider.api.PublicIPInfo\x12D\n" +
+	"\x0eDeletePublicIP\x12\x17.cbspider.api.IDRequest\x1a\x19.cbspider.api.ResultReply\x12V\n" +
+	"\x11AssociatePublicIP\x12&.cbspider.api.AssociatePublicIPRequest\x1a\x19.cbspider.api.ResultReply\x12J\n" +
+	"\x14DisassociatePublicIP\x12\x17.cbspider.api.IDRequest\x1a\x19.cbspider.api.ResultReply2\xc3\x06\n" +
+	"\tVMService\x12=\n" +
+	"\aStartVM\x12\x1c.cbspider.api.StartVMRequest\x1a\x14.cbspider.api.VMInfo\x12F\n" +
+	"\bStartVMs\x12\x1d.cbspider.api.StartVMsRequest\x1a\x1b.cbspider.api.StartVMsReply\x12@\n" +
+	"\tSuspendVM\x12\x17.cbspider.api.IDRequest\x1a\x1a.cbspider.api.VMStatusInfo\x12?\n" +
+	"\bResumeVM\x12\x17.cbspider.api.IDRequest\x1a\x1a.cbspider.api.VMStatusInfo\x12?\n" +
+	"\bRebootVM\x12\x17.cbspider.api.IDRequest\x1a\x1a.cbspider.api.VMStatusInfo\x12B\n" +
+	"\vTerminateVM\x12\x17.cbspider.api.IDRequest\x1a\x1a.cbspider.api.VMStatusInfo\x12G\n" +
+	"\fChangeVMSpec\x12!.cbspider.api.ChangeVMSpecRequest\x1a\x14.cbspider.api.VMInfo\x12K\n" +
+	"\fListVMStatus\x12\x1f.cbspider.api.ConnectionRequest\x1a\x1a.cbspider.api.VMStatusList\x12B\n" +
+	"\vGetVMStatus\x12\x17.cbspider.api.IDRequest\x1a\x1a.cbspider.api.VMStatusInfo\x12R\n" +
+	"\rWatchVMStatus\x12\".cbspider.api.WatchVMStatusRequest\x1a\x1b.cbspider.api.VMStatusEvent0\x01\x12A\n" +
+	"\n" +
+	"ListVMPage\x12\x19.cbspider.api.ListRequest\x1a\x18.cbspider.api.VMPageInfo\x126\n" +
+	"\x05GetVM\x12\x17.cbspider.api.IDRequest\x1a\x14.cbspider.api.VMInfoBJZHgithub.com/cloud-barista/poc-cb-spider/api-runtime/grpc-runtime/spiderpbb\x06proto3"
+
+var (
+	file_spider_proto_rawDescOnce sync.Once
+	file_spider_proto_rawDescData []byte
+)
+
+func file_spider_proto_rawDescGZIP() []byte {
+	file_spider_proto_rawDescOnce.Do(func() {
+		file_spider_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_spider_proto_rawDesc), len(file_spider_proto_rawDesc)))
+	})
+	return file_spider_proto_rawDescData
+}
+
+var file_spider_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
+var file_spider_proto_goTypes = []any{
+	(*ConnectionRequest)(nil),        // 0: cbspider.api.ConnectionRequest
+	(*IDRequest)(nil),                // 1: cbspider.api.IDRequest
+	(*ListReqInfo)(nil),              // 2: cbspider.api.ListReqInfo
+	(*ListRequest)(nil),              // 3: cbspider.api.ListRequest
+	(*ResultReply)(nil),              // 4: cbspider.api.ResultReply
+	(*ImageReqInfo)(nil),             // 5: cbspider.api.ImageReqInfo
+	(*ImageInfo)(nil),                // 6: cbspider.api.ImageInfo
+	(*ImageFilterInfo)(nil),          // 7: cbspider.api.ImageFilterInfo
+	(*ImagePageInfo)(nil),            // 8: cbspider.api.ImagePageInfo
+	(*CreateImageRequest)(nil),       // 9: cbspider.api.CreateImageRequest
+	(*ListImageRequest)(nil),         // 10: cbspider.api.ListImageRequest
+	(*VNetworkReqInfo)(nil),          // 11: cbspider.api.VNetworkReqInfo
+	(*VNetworkInfo)(nil),             // 12: cbspider.api.VNetworkInfo
+	(*VNetworkPageInfo)(nil),         // 13: cbspider.api.VNetworkPageInfo
+	(*CreateVNetworkRequest)(nil),    // 14: cbspider.api.CreateVNetworkRequest
+	(*SecurityRuleInfo)(nil),         // 15: cbspider.api.SecurityRuleInfo
+	(*SecurityReqInfo)(nil),          // 16: cbspider.api.SecurityReqInfo
+	(*SecurityInfo)(nil),             // 17: cbspider.api.SecurityInfo
+	(*SecurityPageInfo)(nil),         // 18: cbspider.api.SecurityPageInfo
+	(*CreateSecurityRequest)(nil),    // 19: cbspider.api.CreateSecurityRequest
+	(*KeyPairReqInfo)(nil),           // 20: cbspider.api.KeyPairReqInfo
+	(*KeyPairInfo)(nil),              // 21: cbspider.api.KeyPairInfo
+	(*KeyPairPageInfo)(nil),          // 22: cbspider.api.KeyPairPageInfo
+	(*CreateKeyRequest)(nil),         // 23: cbspider.api.CreateKeyRequest
+	(*VNicReqInfo)(nil),              // 24: cbspider.api.VNicReqInfo
+	(*VNicInfo)(nil),                 // 25: cbspider.api.VNicInfo
+	(*VNicPageInfo)(nil),             // 26: cbspider.api.VNicPageInfo
+	(*CreateVNicRequest)(nil),        // 27: cbspider.api.CreateVNicRequest
+	(*PublicIPReqInfo)(nil),          // 28: cbspider.api.PublicIPReqInfo
+	(*PublicIPInfo)(nil),             // 29: cbspider.api.PublicIPInfo
+	(*PublicIPPageInfo)(nil),         // 30: cbspider.api.PublicIPPageInfo
+	(*CreatePublicIPRequest)(nil),    // 31: cbspider.api.CreatePublicIPRequest
+	(*AssociatePublicIPRequest)(nil), // 32: cbspider.api.AssociatePublicIPRequest
+	(*LoginInfo)(nil),                // 33: cbspider.api.LoginInfo
+	(*VMReqInfo)(nil),                // 34: cbspider.api.VMReqInfo
+	(*RegionInfo)(nil),               // 35: cbspider.api.RegionInfo
+	(*VMInfo)(nil),                   // 36: cbspider.api.VMInfo
+	(*VMPageInfo)(nil),               // 37: cbspider.api.VMPageInfo
+	(*VMStatusInfo)(nil),             // 38: cbspider.api.VMStatusInfo
+	(*VMStatusList)(nil),             // 39: cbspider.api.VMStatusList
+	(*VMBatchResult)(nil),            // 40: cbspider.api.VMBatchResult
+	(*StartVMRequest)(nil),           // 41: cbspider.api.StartVMRequest
+	(*StartVMsRequest)(nil),          // 42: cbspider.api.StartVMsRequest
+	(*StartVMsReply)(nil),            // 43: cbspider.api.StartVMsReply
+	(*ChangeVMSpecRequest)(nil),      // 44: cbspider.api.ChangeVMSpecRequest
+	(*WatchVMStatusRequest)(nil),     // 45: cbspider.api.WatchVMStatusRequest
+	(*VMStatusEvent)(nil),            // 46: cbspider.api.VMStatusEvent
+	nil,                              // 47: cbspider.api.ListReqInfo.TagFilterEntry
+	(*timestamppb.Timestamp)(nil),    // 48: google.protobuf.Timestamp
+}
+var file_spider_proto_depIdxs = []int32{
+	47, // 0: cbspider.api.ListReqInfo.tag_filter:type_name -> cbspider.api.ListReqInfo.TagFilterEntry
+	2,  // 1: cbspider.api.ListRequest.list_req_info:type_name -> cbspider.api.ListReqInfo
+	48, // 2: cbspider.api.ImageInfo.creation_time:type_name -> google.protobuf.Timestamp
+	6,  // 3: cbspider.api.ImagePageInfo.image_info_list:type_name -> cbspider.api.ImageInfo
+	5,  // 4: cbspider.api.CreateImageRequest.image_req_info:type_name -> cbspider.api.ImageReqInfo
+	7,  // 5: cbspider.api.ListImageRequest.image_filter_info:type_name -> cbspider.api.ImageFilterInfo
+	2,  // 6: cbspider.api.ListImageRequest.list_req_info:type_name -> cbspider.api.ListReqInfo
+	12, // 7: cbspider.api.VNetworkPageInfo.vnetwork_info_list:type_name -> cbspider.api.VNetworkInfo
+	11, // 8: cbspider.api.CreateVNetworkRequest.vnetwork_req_info:type_name -> cbspider.api.VNetworkReqInfo
+	15, // 9: cbspider.api.SecurityReqInfo.ip_permissions:type_name -> cbspider.api.SecurityRuleInfo
+	15, // 10: cbspider.api.SecurityReqInfo.ip_permissions_egress:type_name -> cbspider.api.SecurityRuleInfo
+	15, // 11: cbspider.api.SecurityInfo.ip_permissions:type_name -> cbspider.api.SecurityRuleInfo
+	15, // 12: cbspider.api.SecurityInfo.ip_permissions_egress:type_name -> cbspider.api.SecurityRuleInfo
+	17, // 13: cbspider.api.SecurityPageInfo.security_info_list:type_name -> cbspider.api.SecurityInfo
+	16, // 14: cbspider.api.CreateSecurityRequest.security_req_info:type_name -> cbspider.api.SecurityReqInfo
+	21, // 15: cbspider.api.KeyPairPageInfo.key_pair_info_list:type_name -> cbspider.api.KeyPairInfo
+	20, // 16: cbspider.api.CreateKeyRequest.key_pair_req_info:type_name -> cbspider.api.KeyPairReqInfo
+	25, // 17: cbspider.api.VNicPageInfo.vnic_info_list:type_name -> cbspider.api.VNicInfo
+	24, // 18: cbspider.api.CreateVNicRequest.vnic_req_info:type_name -> cbspider.api.VNicReqInfo
+	29, // 19: cbspider.api.PublicIPPageInfo.public_ip_info_list:type_name -> cbspider.api.PublicIPInfo
+	28, // 20: cbspider.api.CreatePublicIPRequest.public_ip_req_info:type_name -> cbspider.api.PublicIPReqInfo
+	6,  // 21: cbspider.api.VMReqInfo.image_info:type_name -> cbspider.api.ImageInfo
+	12, // 22: cbspider.api.VMReqInfo.vnetwork_info:type_name -> cbspider.api.VNetworkInfo
+	17, // 23: cbspider.api.VMReqInfo.security_info:type_name -> cbspider.api.SecurityInfo
+	21, // 24: cbspider.api.VMReqInfo.key_pair_info:type_name -> cbspider.api.KeyPairInfo
+	29, // 25: cbspider.api.VMReqInfo.public_ip_info:type_name -> cbspider.api.PublicIPInfo
+	33, // 26: cbspider.api.VMReqInfo.login_info:type_name -> cbspider.api.LoginInfo
+	48, // 27: cbspider.api.VMInfo.start_time:type_name -> google.protobuf.Timestamp
+	35, // 28: cbspider.api.VMInfo.region:type_name -> cbspider.api.RegionInfo
+	36, // 29: cbspider.api.VMPageInfo.vm_info_list:type_name -> cbspider.api.VMInfo
+	38, // 30: cbspider.api.VMStatusList.vm_status_info_list:type_name -> cbspider.api.VMStatusInfo
+	36, // 31: cbspider.api.VMBatchResult.vm_info:type_name -> cbspider.api.VMInfo
+	34, // 32: cbspider.api.StartVMRequest.vm_req_info:type_name -> cbspider.api.VMReqInfo
+	34, // 33: cbspider.api.StartVMsRequest.vm_req_info:type_name -> cbspider.api.VMReqInfo
+	40, // 34: cbspider.api.StartVMsReply.vm_batch_results:type_name -> cbspider.api.VMBatchResult
+	48, // 35: cbspider.api.VMStatusEvent.time:type_name -> google.protobuf.Timestamp
+	9,  // 36: cbspider.api.ImageService.CreateImage:input_type -> cbspider.api.CreateImageRequest
+	10, // 37: cbspider.api.ImageService.ListImagePage:input_type -> cbspider.api.ListImageRequest
+	1,  // 38: cbspider.api.ImageService.GetImage:input_type -> cbspider.api.IDRequest
+	1,  // 39: cbspider.api.ImageService.DeleteImage:input_type -> cbspider.api.IDRequest
+	14, // 40: cbspider.api.VNetworkService.CreateVNetwork:input_type -> cbspider.api.CreateVNetworkRequest
+	3,  // 41: cbspider.api.VNetworkService.ListVNetworkPage:input_type -> cbspider.api.ListRequest
+	1,  // 42: cbspider.api.VNetworkService.GetVNetwork:input_type -> cbspider.api.IDRequest
+	1,  // 43: cbspider.api.VNetworkService.DeleteVNetwork:input_type -> cbspider.api.IDRequest
+	19, // 44: cbspider.api.SecurityService.CreateSecurity:input_type -> cbspider.api.CreateSecurityRequest
+	3,  // 45: cbspider.api.SecurityService.ListSecurityPage:input_type -> cbspider.api.ListRequest
+	1,  // 46: cbspider.api.SecurityService.GetSecurity:input_type -> cbspider.api.IDRequest
+	1,  // 47: cbspider.api.SecurityService.DeleteSecurity:input_type -> cbspider.api.IDRequest
+	23, // 48: cbspider.api.KeyPairService.CreateKey:input_type -> cbspider.api.CreateKeyRequest
+	3,  // 49: cbspider.api.KeyPairService.ListKeyPage:input_type -> cbspider.api.ListRequest
+	1,  // 50: cbspider.api.KeyPairService.GetKey:input_type -> cbspider.api.IDRequest
+	1,  // 51: cbspider.api.KeyPairService.DeleteKey:input_type -> cbspider.api.IDRequest
+	27, // 52: cbspider.api.VNicService.CreateVNic:input_type -> cbspider.api.CreateVNicRequest
+	3,  // 53: cbspider.api.VNicService.ListVNicPage:input_type -> cbspider.api.ListRequest
+	1,  // 54: cbspider.api.VNicService.GetVNic:input_type -> cbspider.api.IDRequest
+	1,  // 55: cbspider.api.VNicService.DeleteVNic:input_type -> cbspider.api.IDRequest
+	31, // 56: cbspider.api.PublicIPService.CreatePublicIP:input_type -> cbspider.api.CreatePublicIPRequest
+	3,  // 57: cbspider.api.PublicIPService.ListPublicIPPage:input_type -> cbspider.api.ListRequest
+	1,  // 58: cbspider.api.PublicIPService.GetPublicIP:input_type -> cbspider.api.IDRequest
+	1,  // 59: cbspider.api.PublicIPService.DeletePublicIP:input_type -> cbspider.api.IDRequest
+	32, // 60: cbspider.api.PublicIPService.AssociatePublicIP:input_type -> cbspider.api.AssociatePublicIPRequest
+	1,  // 61: cbspider.api.PublicIPService.DisassociatePublicIP:input_type -> cbspider.api.IDRequest
+	41, // 62: cbspider.api.VMService.StartVM:input_type -> cbspider.api.StartVMRequest
+	42, // 63: cbspider.api.VMService.StartVMs:input_type -> cbspider.api.StartVMsRequest
+	1,  // 64: cbspider.api.VMService.SuspendVM:input_type -> cbspider.api.IDRequest
+	1,  // 65: cbspider.api.VMService.ResumeVM:input_type -> cbspider.api.IDRequest
+	1,  // 66: cbspider.api.VMService.RebootVM:input_type -> cbspider.api.IDRequest
+	1,  // 67: cbspider.api.VMService.TerminateVM:input_type -> cbspider.api.IDRequest
+	44, // 68: cbspider.api.VMService.ChangeVMSpec:input_type -> cbspider.api.ChangeVMSpecRequest
+	0,  // 69: cbspider.api.VMService.ListVMStatus:input_type -> cbspider.api.ConnectionRequest
+	1,  // 70: cbspider.api.VMService.GetVMStatus:input_type -> cbspider.api.IDRequest
+	45, // 71: cbspider.api.VMService.WatchVMStatus:input_type -> cbspider.api.WatchVMStatusRequest
+	3,  // 72: cbspider.api.VMService.ListVMPage:input_type -> cbspider.api.ListRequest
+	1,  // 73: cbspider.api.VMService.GetVM:input_type -> cbspider.api.IDRequest
+	6,  // 74: cbspider.api.ImageService.CreateImage:output_type -> cbspider.api.ImageInfo
+	8,  // 75: cbspider.api.ImageService.ListImagePage:output_type -> cbspider.api.ImagePageInfo
+	6,  // 76: cbspider.api.ImageService.GetImage:output_type -> cbspider.api.ImageInfo
+	4,  // 77: cbspider.api.ImageService.DeleteImage:output_type -> cbspider.api.ResultReply
+	12, // 78: cbspider.api.VNetworkService.CreateVNetwork:output_type -> cbspider.api.VNetworkInfo
+	13, // 79: cbspider.api.VNetworkService.ListVNetworkPage:output_type -> cbspider.api.VNetworkPageInfo
+	12, // 80: cbspider.api.VNetworkService.GetVNetwork:output_type -> cbspider.api.VNetworkInfo
+	4,  // 81: cbspider.api.VNetworkService.DeleteVNetwork:output_type -> cbspider.api.ResultReply
+	17, // 82: cbspider.api.SecurityService.CreateSecurity:output_type -> cbspider.api.SecurityInfo
+	18, // 83: cbspider.api.SecurityService.ListSecurityPage:output_type -> cbspider.api.SecurityPageInfo
+	17, // 84: cbspider.api.SecurityService.GetSecurity:output_type -> cbspider.api.SecurityInfo
+	4,  // 85: cbspider.api.SecurityService.DeleteSecurity:output_type -> cbspider.api.ResultReply
+	21, // 86: cbspider.api.KeyPairService.CreateKey:output_type -> cbspider.api.KeyPairInfo
+	22, // 87: cbspider.api.KeyPairService.ListKeyPage:output_type -> cbspider.api.KeyPairPageInfo
+	21, // 88: cbspider.api.KeyPairService.GetKey:output_type -> cbspider.api.KeyPairInfo
+	4,  // 89: cbspider.api.KeyPairService.DeleteKey:output_type -> cbspider.api.ResultReply
+	25, // 90: cbspider.api.VNicService.CreateVNic:output_type -> cbspider.api.VNicInfo
+	26, // 91: cbspider.api.VNicService.ListVNicPage:output_type -> cbspider.api.VNicPageInfo
+	25, // 92: cbspider.api.VNicService.GetVNic:output_type -> cbspider.api.VNicInfo
+	4,  // 93: cbspider.api.VNicService.DeleteVNic:output_type -> cbspider.api.ResultReply
+	29, // 94: cbspider.api.PublicIPService.CreatePublicIP:output_type -> cbspider.api.PublicIPInfo
+	30, // 95: cbspider.api.PublicIPService.ListPublicIPPage:output_type -> cbspider.api.PublicIPPageInfo
+	29, // 96: cbspider.api.PublicIPService.GetPublicIP:output_type -> cbspider.api.PublicIPInfo
+	4,  // 97: cbspider.api.PublicIPService.DeletePublicIP:output_type -> cbspider.api.ResultReply
+	4,  // 98: cbspider.api.PublicIPService.AssociatePublicIP:output_type -> cbspider.api.ResultReply
+	4,  // 99: cbspider.api.PublicIPService.DisassociatePublicIP:output_type -> cbspider.api.ResultReply
+	36, // 100: cbspider.api.VMService.StartVM:output_type -> cbspider.api.VMInfo
+	43, // 101: cbspider.api.VMService.StartVMs:output_type -> cbspider.api.StartVMsReply
+	38, // 102: cbspider.api.VMService.SuspendVM:output_type -> cbspider.api.VMStatusInfo
+	38, // 103: cbspider.api.VMService.ResumeVM:output_type -> cbspider.api.VMStatusInfo
+	38, // 104: cbspider.api.VMService.RebootVM:output_type -> cbspider.api.VMStatusInfo
+	38, // 105: cbspider.api.VMService.TerminateVM:output_type -> cbspider.api.VMStatusInfo
+	36, // 106: cbspider.api.VMService.ChangeVMSpec:output_type -> cbspider.api.VMInfo
+	39, // 107: cbspider.api.VMService.ListVMStatus:output_type -> cbspider.api.VMStatusList
+	38, // 108: cbspider.api.VMService.GetVMStatus:output_type -> cbspider.api.VMStatusInfo
+	46, // 109: cbspider.api.VMService.WatchVMStatus:output_type -> cbspider.api.VMStatusEvent
+	37, // 110: cbspider.api.VMService.ListVMPage:output_type -> cbspider.api.VMPageInfo
+	36, // 111: cbspider.api.VMService.GetVM:output_type -> cbspider.api.VMInfo
+	74, // [74:112] is the sub-list for method output_type
+	36, // [36:74] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
+}
+
+func init() { file_spider_proto_init() }
+func file_spider_proto_init() {
+	if File_spider_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_spider_proto_rawDesc), len(file_spider_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   48,
+			NumExtensions: 0,
+			NumServices:   7,
+		},
+		GoTypes:           file_spider_proto_goTypes,
+		DependencyIndexes: file_spider_proto_depIdxs,
+		MessageInfos:      file_spider_proto_msgTypes,
+	}.Build()
+	File_spider_proto = out.File
+	file_spider_proto_goTypes = nil
+	file_spider_proto_depIdxs = nil
+}
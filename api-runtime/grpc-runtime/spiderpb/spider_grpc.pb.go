@@ -0,0 +1,1933 @@
+// gRPC API of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// Messages mirror cloud-driver/interfaces/resources(irs).
+// Resources are addressed by connection config name, like the REST API.
+// Errors are gRPC status codes mapped from irs error codes, ex) irs.NotFoundError -> NOT_FOUND
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: spider.proto
+
+package spiderpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ImageService_CreateImage_FullMethodName   = "/cbspider.api.ImageService/CreateImage"
+	ImageService_ListImagePage_FullMethodName = "/cbspider.api.ImageService/ListImagePage"
+	ImageService_GetImage_FullMethodName      = "/cbspider.api.ImageService/GetImage"
+	ImageService_DeleteImage_FullMethodName   = "/cbspider.api.ImageService/DeleteImage"
+)
+
+// ImageServiceClient is the client API for ImageService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ImageServiceClient interface {
+	CreateImage(ctx context.Context, in *CreateImageRequest, opts ...grpc.CallOption) (*ImageInfo, error)
+	ListImagePage(ctx context.Context, in *ListImageRequest, opts ...grpc.CallOption) (*ImagePageInfo, error)
+	GetImage(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*ImageInfo, error)
+	DeleteImage(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*ResultReply, error)
+}
+
+type imageServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewImageServiceClient(cc grpc.ClientConnInterface) ImageServiceClient {
+	return &imageServiceClient{cc}
+}
+
+func (c *imageServiceClient) CreateImage(ctx context.Context, in *CreateImageRequest, opts ...grpc.CallOption) (*ImageInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImageInfo)
+	err := c.cc.Invoke(ctx, ImageService_CreateImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) ListImagePage(ctx context.Context, in *ListImageRequest, opts ...grpc.CallOption) (*ImagePageInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImagePageInfo)
+	err := c.cc.Invoke(ctx, ImageService_ListImagePage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) GetImage(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*ImageInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImageInfo)
+	err := c.cc.Invoke(ctx, ImageService_GetImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) DeleteImage(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*ResultReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResultReply)
+	err := c.cc.Invoke(ctx, ImageService_DeleteImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ImageServiceServer is the server API for ImageService service.
+// All implementations must embed UnimplementedImageServiceServer
+// for forward compatibility.
+type ImageServiceServer interface {
+	CreateImage(context.Context, *CreateImageRequest) (*ImageInfo, error)
+	ListImagePage(context.Context, *ListImageRequest) (*ImagePageInfo, error)
+	GetImage(context.Context, *IDRequest) (*ImageInfo, error)
+	DeleteImage(context.Context, *IDRequest) (*ResultReply, error)
+	mustEmbedUnimplementedImageServiceServer()
+}
+
+// UnimplementedImageServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedImageServiceServer struct{}
+
+func (UnimplementedImageServiceServer) CreateImage(context.Context, *CreateImageRequest) (*ImageInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateImage not implemented")
+}
+func (UnimplementedImageServiceServer) ListImagePage(context.Context, *ListImageRequest) (*ImagePageInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListImagePage not implemented")
+}
+func (UnimplementedImageServiceServer) GetImage(context.Context, *IDRequest) (*ImageInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetImage not implemented")
+}
+func (UnimplementedImageServiceServer) DeleteImage(context.Context, *IDRequest) (*ResultReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteImage not implemented")
+}
+func (UnimplementedImageServiceServer) mustEmbedUnimplementedImageServiceServer() {}
+func (UnimplementedImageServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeImageServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ImageServiceServer will
+// result in compilation errors.
+type UnsafeImageServiceServer interface {
+	mustEmbedUnimplementedImageServiceServer()
+}
+
+func RegisterImageServiceServer(s grpc.ServiceRegistrar, srv ImageServiceServer) {
+	// If the following call panics, it indicates UnimplementedImageServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ImageService_ServiceDesc, srv)
+}
+
+func _ImageService_CreateImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).CreateImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_CreateImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).CreateImage(ctx, req.(*CreateImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_ListImagePage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).ListImagePage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_ListImagePage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).ListImagePage(ctx, req.(*ListImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_GetImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).GetImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_GetImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).GetImage(ctx, req.(*IDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_DeleteImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).DeleteImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_DeleteImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).DeleteImage(ctx, req.(*IDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ImageService_ServiceDesc is the grpc.ServiceDesc for ImageService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ImageService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cbspider.api.ImageService",
+	HandlerType: (*ImageServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateImage",
+			Handler:    _ImageService_CreateImage_Handler,
+		},
+		{
+			MethodName: "ListImagePage",
+			Handler:    _ImageService_ListImagePage_Handler,
+		},
+		{
+			MethodName: "GetImage",
+			Handler:    _ImageService_GetImage_Handler,
+		},
+		{
+			MethodName: "DeleteImage",
+			Handler:    _ImageService_DeleteImage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "spider.proto",
+}
+
+const (
+	VNetworkService_CreateVNetwork_FullMethodName   = "/cbspider.api.VNetworkService/CreateVNetwork"
+	VNetworkService_ListVNetworkPage_FullMethodName = "/cbspider.api.VNetworkService/ListVNetworkPage"
+	VNetworkService_GetVNetwork_FullMethodName      = "/cbspider.api.VNetworkService/GetVNetwork"
+	VNetworkService_DeleteVNetwork_FullMethodName   = "/cbspider.api.VNetworkService/DeleteVNetwork"
+)
+
+// VNetworkServiceClient is the client API for VNetworkService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type VNetworkServiceClient interface {
+	CreateVNetwork(ctx context.Context, in *CreateVNetworkRequest, opts ...grpc.CallOption) (*VNetworkInfo, error)
+	ListVNetworkPage(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*VNetworkPageInfo, error)
+	GetVNetwork(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*VNetworkInfo, error)
+	DeleteVNetwork(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*ResultReply, error)
+}
+
+type vNetworkServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVNetworkServiceClient(cc grpc.ClientConnInterface) VNetworkServiceClient {
+	return &vNetworkServiceClient{cc}
+}
+
+func (c *vNetworkServiceClient) CreateVNetwork(ctx context.Context, in *CreateVNetworkRequest, opts ...grpc.CallOption) (*VNetworkInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VNetworkInfo)
+	err := c.cc.Invoke(ctx, VNetworkService_CreateVNetwork_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vNetworkServiceClient) ListVNetworkPage(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*VNetworkPageInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VNetworkPageInfo)
+	err := c.cc.Invoke(ctx, VNetworkService_ListVNetworkPage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vNetworkServiceClient) GetVNetwork(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*VNetworkInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VNetworkInfo)
+	err := c.cc.Invoke(ctx, VNetworkService_GetVNetwork_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vNetworkServiceClient) DeleteVNetwork(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*ResultReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResultReply)
+	err := c.cc.Invoke(ctx, VNetworkService_DeleteVNetwork_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VNetworkServiceServer is the server API for VNetworkService service.
+// All implementations must embed UnimplementedVNetworkServiceServer
+// for forward compatibility.
+type VNetworkServiceServer interface {
+	CreateVNetwork(context.Context, *CreateVNetworkRequest) (*VNetworkInfo, error)
+	ListVNetworkPage(context.Context, *ListRequest) (*VNetworkPageInfo, error)
+	GetVNetwork(context.Context, *IDRequest) (*VNetworkInfo, error)
+	DeleteVNetwork(context.Context, *IDRequest) (*ResultReply, error)
+	mustEmbedUnimplementedVNetworkServiceServer()
+}
+
+// UnimplementedVNetworkServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedVNetworkServiceServer struct{}
+
+func (UnimplementedVNetworkServiceServer) CreateVNetwork(context.Context, *CreateVNetworkRequest) (*VNetworkInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateVNetwork not implemented")
+}
+func (UnimplementedVNetworkServiceServer) ListVNetworkPage(context.Context, *ListRequest) (*VNetworkPageInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListVNetworkPage not implemented")
+}
+func (UnimplementedVNetworkServiceServer) GetVNetwork(context.Context, *IDRequest) (*VNetworkInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetVNetwork not implemented")
+}
+func (UnimplementedVNetworkServiceServer) DeleteVNetwork(context.Context, *IDRequest) (*ResultReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteVNetwork not implemented")
+}
+func (UnimplementedVNetworkServiceServer) mustEmbedUnimplementedVNetworkServiceServer() {}
+func (UnimplementedVNetworkServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeVNetworkServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VNetworkServiceServer will
+// result in compilation errors.
+type UnsafeVNetworkServiceServer interface {
+	mustEmbedUnimplementedVNetworkServiceServer()
+}
+
+func RegisterVNetworkServiceServer(s grpc.ServiceRegistrar, srv VNetworkServiceServer) {
+	// If the following call panics, it indicates UnimplementedVNetworkServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&VNetworkService_ServiceDesc, srv)
+}
+
+func _VNetworkService_CreateVNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVNetworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VNetworkServiceServer).CreateVNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VNetworkService_CreateVNetwork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VNetworkServiceServer).CreateVNetwork(ctx, req.(*CreateVNetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VNetworkService_ListVNetworkPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VNetworkServiceServer).ListVNetworkPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VNetworkService_ListVNetworkPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VNetworkServiceServer).ListVNetworkPage(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VNetworkService_GetVNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VNetworkServiceServer).GetVNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VNetworkService_GetVNetwork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VNetworkServiceServer).GetVNetwork(ctx, req.(*IDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VNetworkService_DeleteVNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VNetworkServiceServer).DeleteVNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VNetworkService_DeleteVNetwork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VNetworkServiceServer).DeleteVNetwork(ctx, req.(*IDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VNetworkService_ServiceDesc is the grpc.ServiceDesc for VNetworkService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VNetworkService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cbspider.api.VNetworkService",
+	HandlerType: (*VNetworkServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateVNetwork",
+			Handler:    _VNetworkService_CreateVNetwork_Handler,
+		},
+		{
+			MethodName: "ListVNetworkPage",
+			Handler:    _VNetworkService_ListVNetworkPage_Handler,
+		},
+		{
+			MethodName: "GetVNetwork",
+			Handler:    _VNetworkService_GetVNetwork_Handler,
+		},
+		{
+			MethodName: "DeleteVNetwork",
+			Handler:    _VNetworkService_DeleteVNetwork_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "spider.proto",
+}
+
+const (
+	SecurityService_CreateSecurity_FullMethodName   = "/cbspider.api.SecurityService/CreateSecurity"
+	SecurityService_ListSecurityPage_FullMethodName = "/cbspider.api.SecurityService/ListSecurityPage"
+	SecurityService_GetSecurity_FullMethodName      = "/cbspider.api.SecurityService/GetSecurity"
+	SecurityService_DeleteSecurity_FullMethodName   = "/cbspider.api.SecurityService/DeleteSecurity"
+)
+
+// SecurityServiceClient is the client API for SecurityService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SecurityServiceClient interface {
+	CreateSecurity(ctx context.Context, in *CreateSecurityRequest, opts ...grpc.CallOption) (*SecurityInfo, error)
+	ListSecurityPage(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*SecurityPageInfo, error)
+	GetSecurity(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*SecurityInfo, error)
+	DeleteSecurity(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*ResultReply, error)
+}
+
+type securityServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSecurityServiceClient(cc grpc.ClientConnInterface) SecurityServiceClient {
+	return &securityServiceClient{cc}
+}
+
+func (c *securityServiceClient) CreateSecurity(ctx context.Context, in *CreateSecurityRequest, opts ...grpc.CallOption) (*SecurityInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SecurityInfo)
+	err := c.cc.Invoke(ctx, SecurityService_CreateSecurity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityServiceClient) ListSecurityPage(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*SecurityPageInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SecurityPageInfo)
+	err := c.cc.Invoke(ctx, SecurityService_ListSecurityPage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityServiceClient) GetSecurity(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*SecurityInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SecurityInfo)
+	err := c.cc.Invoke(ctx, SecurityService_GetSecurity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityServiceClient) DeleteSecurity(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*ResultReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResultReply)
+	err := c.cc.Invoke(ctx, SecurityService_DeleteSecurity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SecurityServiceServer is the server API for SecurityService service.
+// All implementations must embed UnimplementedSecurityServiceServer
+// for forward compatibility.
+type SecurityServiceServer interface {
+	CreateSecurity(context.Context, *CreateSecurityRequest) (*SecurityInfo, error)
+	ListSecurityPage(context.Context, *ListRequest) (*SecurityPageInfo, error)
+	GetSecurity(context.Context, *IDRequest) (*SecurityInfo, error)
+	DeleteSecurity(context.Context, *IDRequest) (*ResultReply, error)
+	mustEmbedUnimplementedSecurityServiceServer()
+}
+
+// UnimplementedSecurityServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSecurityServiceServer struct{}
+
+func (UnimplementedSecurityServiceServer) CreateSecurity(context.Context, *CreateSecurityRequest) (*SecurityInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateSecurity not implemented")
+}
+func (UnimplementedSecurityServiceServer) ListSecurityPage(context.Context, *ListRequest) (*SecurityPageInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSecurityPage not implemented")
+}
+func (UnimplementedSecurityServiceServer) GetSecurity(context.Context, *IDRequest) (*SecurityInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSecurity not implemented")
+}
+func (UnimplementedSecurityServiceServer) DeleteSecurity(context.Context, *IDRequest) (*ResultReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteSecurity not implemented")
+}
+func (UnimplementedSecurityServiceServer) mustEmbedUnimplementedSecurityServiceServer() {}
+func (UnimplementedSecurityServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeSecurityServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SecurityServiceServer will
+// result in compilation errors.
+type UnsafeSecurityServiceServer interface {
+	mustEmbedUnimplementedSecurityServiceServer()
+}
+
+func RegisterSecurityServiceServer(s grpc.ServiceRegistrar, srv SecurityServiceServer) {
+	// If the following call panics, it indicates UnimplementedSecurityServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SecurityService_ServiceDesc, srv)
+}
+
+func _SecurityService_CreateSecurity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSecurityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityServiceServer).CreateSecurity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityService_CreateSecurity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityServiceServer).CreateSecurity(ctx, req.(*CreateSecurityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityService_ListSecurityPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityServiceServer).ListSecurityPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityService_ListSecurityPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityServiceServer).ListSecurityPage(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityService_GetSecurity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityServiceServer).GetSecurity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityService_GetSecurity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityServiceServer).GetSecurity(ctx, req.(*IDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityService_DeleteSecurity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityServiceServer).DeleteSecurity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityService_DeleteSecurity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityServiceServer).DeleteSecurity(ctx, req.(*IDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SecurityService_ServiceDesc is the grpc.ServiceDesc for SecurityService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SecurityService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cbspider.api.SecurityService",
+	HandlerType: (*SecurityServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSecurity",
+			Handler:    _SecurityService_CreateSecurity_Handler,
+		},
+		{
+			MethodName: "ListSecurityPage",
+			Handler:    _SecurityService_ListSecurityPage_Handler,
+		},
+		{
+			MethodName: "GetSecurity",
+			Handler:    _SecurityService_GetSecurity_Handler,
+		},
+		{
+			MethodName: "DeleteSecurity",
+			Handler:    _SecurityService_DeleteSecurity_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "spider.proto",
+}
+
+const (
+	KeyPairService_CreateKey_FullMethodName   = "/cbspider.api.KeyPairService/CreateKey"
+	KeyPairService_ListKeyPage_FullMethodName = "/cbspider.api.KeyPairService/ListKeyPage"
+	KeyPairService_GetKey_FullMethodName      = "/cbspider.api.KeyPairService/GetKey"
+	KeyPairService_DeleteKey_FullMethodName   = "/cbspider.api.KeyPairService/DeleteKey"
+)
+
+// KeyPairServiceClient is the client API for KeyPairService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type KeyPairServiceClient interface {
+	CreateKey(ctx context.Context, in *CreateKeyRequest, opts ...grpc.CallOption) (*KeyPairInfo, error)
+	ListKeyPage(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*KeyPairPageInfo, error)
+	GetKey(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*KeyPairInfo, error)
+	DeleteKey(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*ResultReply, error)
+}
+
+type keyPairServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewKeyPairServiceClient(cc grpc.ClientConnInterface) KeyPairServiceClient {
+	return &keyPairServiceClient{cc}
+}
+
+func (c *keyPairServiceClient) CreateKey(ctx context.Context, in *CreateKeyRequest, opts ...grpc.CallOption) (*KeyPairInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KeyPairInfo)
+	err := c.cc.Invoke(ctx, KeyPairService_CreateKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyPairServiceClient) ListKeyPage(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*KeyPairPageInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KeyPairPageInfo)
+	err := c.cc.Invoke(ctx, KeyPairService_ListKeyPage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyPairServiceClient) GetKey(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*KeyPairInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KeyPairInfo)
+	err := c.cc.Invoke(ctx, KeyPairService_GetKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyPairServiceClient) DeleteKey(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*ResultReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResultReply)
+	err := c.cc.Invoke(ctx, KeyPairService_DeleteKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// KeyPairServiceServer is the server API for KeyPairService service.
+// All implementations must embed UnimplementedKeyPairServiceServer
+// for forward compatibility.
+type KeyPairServiceServer interface {
+	CreateKey(context.Context, *CreateKeyRequest) (*KeyPairInfo, error)
+	ListKeyPage(context.Context, *ListRequest) (*KeyPairPageInfo, error)
+	GetKey(context.Context, *IDRequest) (*KeyPairInfo, error)
+	DeleteKey(context.Context, *IDRequest) (*ResultReply, error)
+	mustEmbedUnimplementedKeyPairServiceServer()
+}
+
+// UnimplementedKeyPairServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedKeyPairServiceServer struct{}
+
+func (UnimplementedKeyPairServiceServer) CreateKey(context.Context, *CreateKeyRequest) (*KeyPairInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateKey not implemented")
+}
+func (UnimplementedKeyPairServiceServer) ListKeyPage(context.Context, *ListRequest) (*KeyPairPageInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListKeyPage not implemented")
+}
+func (UnimplementedKeyPairServiceServer) GetKey(context.Context, *IDRequest) (*KeyPairInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetKey not implemented")
+}
+func (UnimplementedKeyPairServiceServer) DeleteKey(context.Context, *IDRequest) (*ResultReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteKey not implemented")
+}
+func (UnimplementedKeyPairServiceServer) mustEmbedUnimplementedKeyPairServiceServer() {}
+func (UnimplementedKeyPairServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeKeyPairServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to KeyPairServiceServer will
+// result in compilation errors.
+type UnsafeKeyPairServiceServer interface {
+	mustEmbedUnimplementedKeyPairServiceServer()
+}
+
+func RegisterKeyPairServiceServer(s grpc.ServiceRegistrar, srv KeyPairServiceServer) {
+	// If the following call panics, it indicates UnimplementedKeyPairServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&KeyPairService_ServiceDesc, srv)
+}
+
+func _KeyPairService_CreateKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyPairServiceServer).CreateKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyPairService_CreateKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyPairServiceServer).CreateKey(ctx, req.(*CreateKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyPairService_ListKeyPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyPairServiceServer).ListKeyPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyPairService_ListKeyPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyPairServiceServer).ListKeyPage(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyPairService_GetKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyPairServiceServer).GetKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyPairService_GetKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyPairServiceServer).GetKey(ctx, req.(*IDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyPairService_DeleteKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyPairServiceServer).DeleteKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyPairService_DeleteKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyPairServiceServer).DeleteKey(ctx, req.(*IDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// KeyPairService_ServiceDesc is the grpc.ServiceDesc for KeyPairService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var KeyPairService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cbspider.api.KeyPairService",
+	HandlerType: (*KeyPairServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateKey",
+			Handler:    _KeyPairService_CreateKey_Handler,
+		},
+		{
+			MethodName: "ListKeyPage",
+			Handler:    _KeyPairService_ListKeyPage_Handler,
+		},
+		{
+			MethodName: "GetKey",
+			Handler:    _KeyPairService_GetKey_Handler,
+		},
+		{
+			MethodName: "DeleteKey",
+			Handler:    _KeyPairService_DeleteKey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "spider.proto",
+}
+
+const (
+	VNicService_CreateVNic_FullMethodName   = "/cbspider.api.VNicService/CreateVNic"
+	VNicService_ListVNicPage_FullMethodName = "/cbspider.api.VNicService/ListVNicPage"
+	VNicService_GetVNic_FullMethodName      = "/cbspider.api.VNicService/GetVNic"
+	VNicService_DeleteVNic_FullMethodName   = "/cbspider.api.VNicService/DeleteVNic"
+)
+
+// VNicServiceClient is the client API for VNicService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type VNicServiceClient interface {
+	CreateVNic(ctx context.Context, in *CreateVNicRequest, opts ...grpc.CallOption) (*VNicInfo, error)
+	ListVNicPage(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*VNicPageInfo, error)
+	GetVNic(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*VNicInfo, error)
+	DeleteVNic(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*ResultReply, error)
+}
+
+type vNicServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVNicServiceClient(cc grpc.ClientConnInterface) VNicServiceClient {
+	return &vNicServiceClient{cc}
+}
+
+func (c *vNicServiceClient) CreateVNic(ctx context.Context, in *CreateVNicRequest, opts ...grpc.CallOption) (*VNicInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VNicInfo)
+	err := c.cc.Invoke(ctx, VNicService_CreateVNic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vNicServiceClient) ListVNicPage(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*VNicPageInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VNicPageInfo)
+	err := c.cc.Invoke(ctx, VNicService_ListVNicPage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vNicServiceClient) GetVNic(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*VNicInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VNicInfo)
+	err := c.cc.Invoke(ctx, VNicService_GetVNic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vNicServiceClient) DeleteVNic(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*ResultReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResultReply)
+	err := c.cc.Invoke(ctx, VNicService_DeleteVNic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VNicServiceServer is the server API for VNicService service.
+// All implementations must embed UnimplementedVNicServiceServer
+// for forward compatibility.
+type VNicServiceServer interface {
+	CreateVNic(context.Context, *CreateVNicRequest) (*VNicInfo, error)
+	ListVNicPage(context.Context, *ListRequest) (*VNicPageInfo, error)
+	GetVNic(context.Context, *IDRequest) (*VNicInfo, error)
+	DeleteVNic(context.Context, *IDRequest) (*ResultReply, error)
+	mustEmbedUnimplementedVNicServiceServer()
+}
+
+// UnimplementedVNicServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedVNicServiceServer struct{}
+
+func (UnimplementedVNicServiceServer) CreateVNic(context.Context, *CreateVNicRequest) (*VNicInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateVNic not implemented")
+}
+func (UnimplementedVNicServiceServer) ListVNicPage(context.Context, *ListRequest) (*VNicPageInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListVNicPage not implemented")
+}
+func (UnimplementedVNicServiceServer) GetVNic(context.Context, *IDRequest) (*VNicInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetVNic not implemented")
+}
+func (UnimplementedVNicServiceServer) DeleteVNic(context.Context, *IDRequest) (*ResultReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteVNic not implemented")
+}
+func (UnimplementedVNicServiceServer) mustEmbedUnimplementedVNicServiceServer() {}
+func (UnimplementedVNicServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeVNicServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VNicServiceServer will
+// result in compilation errors.
+type UnsafeVNicServiceServer interface {
+	mustEmbedUnimplementedVNicServiceServer()
+}
+
+func RegisterVNicServiceServer(s grpc.ServiceRegistrar, srv VNicServiceServer) {
+	// If the following call panics, it indicates UnimplementedVNicServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&VNicService_ServiceDesc, srv)
+}
+
+func _VNicService_CreateVNic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVNicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VNicServiceServer).CreateVNic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VNicService_CreateVNic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VNicServiceServer).CreateVNic(ctx, req.(*CreateVNicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VNicService_ListVNicPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VNicServiceServer).ListVNicPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VNicService_ListVNicPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VNicServiceServer).ListVNicPage(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VNicService_GetVNic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VNicServiceServer).GetVNic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VNicService_GetVNic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VNicServiceServer).GetVNic(ctx, req.(*IDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VNicService_DeleteVNic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VNicServiceServer).DeleteVNic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VNicService_DeleteVNic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VNicServiceServer).DeleteVNic(ctx, req.(*IDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VNicService_ServiceDesc is the grpc.ServiceDesc for VNicService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VNicService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cbspider.api.VNicService",
+	HandlerType: (*VNicServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateVNic",
+			Handler:    _VNicService_CreateVNic_Handler,
+		},
+		{
+			MethodName: "ListVNicPage",
+			Handler:    _VNicService_ListVNicPage_Handler,
+		},
+		{
+			MethodName: "GetVNic",
+			Handler:    _VNicService_GetVNic_Handler,
+		},
+		{
+			MethodName: "DeleteVNic",
+			Handler:    _VNicService_DeleteVNic_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "spider.proto",
+}
+
+const (
+	PublicIPService_CreatePublicIP_FullMethodName       = "/cbspider.api.PublicIPService/CreatePublicIP"
+	PublicIPService_ListPublicIPPage_FullMethodName     = "/cbspider.api.PublicIPService/ListPublicIPPage"
+	PublicIPService_GetPublicIP_FullMethodName          = "/cbspider.api.PublicIPService/GetPublicIP"
+	PublicIPService_DeletePublicIP_FullMethodName       = "/cbspider.api.PublicIPService/DeletePublicIP"
+	PublicIPService_AssociatePublicIP_FullMethodName    = "/cbspider.api.PublicIPService/AssociatePublicIP"
+	PublicIPService_DisassociatePublicIP_FullMethodName = "/cbspider.api.PublicIPService/DisassociatePublicIP"
+)
+
+// PublicIPServiceClient is the client API for PublicIPService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PublicIPServiceClient interface {
+	CreatePublicIP(ctx context.Context, in *CreatePublicIPRequest, opts ...grpc.CallOption) (*PublicIPInfo, error)
+	ListPublicIPPage(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*PublicIPPageInfo, error)
+	GetPublicIP(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*PublicIPInfo, error)
+	DeletePublicIP(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*ResultReply, error)
+	AssociatePublicIP(ctx context.Context, in *AssociatePublicIPRequest, opts ...grpc.CallOption) (*ResultReply, error)
+	DisassociatePublicIP(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*ResultReply, error)
+}
+
+type publicIPServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPublicIPServiceClient(cc grpc.ClientConnInterface) PublicIPServiceClient {
+	return &publicIPServiceClient{cc}
+}
+
+func (c *publicIPServiceClient) CreatePublicIP(ctx context.Context, in *CreatePublicIPRequest, opts ...grpc.CallOption) (*PublicIPInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublicIPInfo)
+	err := c.cc.Invoke(ctx, PublicIPService_CreatePublicIP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicIPServiceClient) ListPublicIPPage(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*PublicIPPageInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublicIPPageInfo)
+	err := c.cc.Invoke(ctx, PublicIPService_ListPublicIPPage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicIPServiceClient) GetPublicIP(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*PublicIPInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublicIPInfo)
+	err := c.cc.Invoke(ctx, PublicIPService_GetPublicIP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicIPServiceClient) DeletePublicIP(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*ResultReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResultReply)
+	err := c.cc.Invoke(ctx, PublicIPService_DeletePublicIP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicIPServiceClient) AssociatePublicIP(ctx context.Context, in *AssociatePublicIPRequest, opts ...grpc.CallOption) (*ResultReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResultReply)
+	err := c.cc.Invoke(ctx, PublicIPService_AssociatePublicIP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicIPServiceClient) DisassociatePublicIP(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*ResultReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResultReply)
+	err := c.cc.Invoke(ctx, PublicIPService_DisassociatePublicIP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PublicIPServiceServer is the server API for PublicIPService service.
+// All implementations must embed UnimplementedPublicIPServiceServer
+// for forward compatibility.
+type PublicIPServiceServer interface {
+	CreatePublicIP(context.Context, *CreatePublicIPRequest) (*PublicIPInfo, error)
+	ListPublicIPPage(context.Context, *ListRequest) (*PublicIPPageInfo, error)
+	GetPublicIP(context.Context, *IDRequest) (*PublicIPInfo, error)
+	DeletePublicIP(context.Context, *IDRequest) (*ResultReply, error)
+	AssociatePublicIP(context.Context, *AssociatePublicIPRequest) (*ResultReply, error)
+	DisassociatePublicIP(context.Context, *IDRequest) (*ResultReply, error)
+	mustEmbedUnimplementedPublicIPServiceServer()
+}
+
+// UnimplementedPublicIPServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPublicIPServiceServer struct{}
+
+func (UnimplementedPublicIPServiceServer) CreatePublicIP(context.Context, *CreatePublicIPRequest) (*PublicIPInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePublicIP not implemented")
+}
+func (UnimplementedPublicIPServiceServer) ListPublicIPPage(context.Context, *ListRequest) (*PublicIPPageInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPublicIPPage not implemented")
+}
+func (UnimplementedPublicIPServiceServer) GetPublicIP(context.Context, *IDRequest) (*PublicIPInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPublicIP not implemented")
+}
+func (UnimplementedPublicIPServiceServer) DeletePublicIP(context.Context, *IDRequest) (*ResultReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeletePublicIP not implemented")
+}
+func (UnimplementedPublicIPServiceServer) AssociatePublicIP(context.Context, *AssociatePublicIPRequest) (*ResultReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method AssociatePublicIP not implemented")
+}
+func (UnimplementedPublicIPServiceServer) DisassociatePublicIP(context.Context, *IDRequest) (*ResultReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method DisassociatePublicIP not implemented")
+}
+func (UnimplementedPublicIPServiceServer) mustEmbedUnimplementedPublicIPServiceServer() {}
+func (UnimplementedPublicIPServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafePublicIPServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PublicIPServiceServer will
+// result in compilation errors.
+type UnsafePublicIPServiceServer interface {
+	mustEmbedUnimplementedPublicIPServiceServer()
+}
+
+func RegisterPublicIPServiceServer(s grpc.ServiceRegistrar, srv PublicIPServiceServer) {
+	// If the following call panics, it indicates UnimplementedPublicIPServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PublicIPService_ServiceDesc, srv)
+}
+
+func _PublicIPService_CreatePublicIP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePublicIPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicIPServiceServer).CreatePublicIP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PublicIPService_CreatePublicIP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicIPServiceServer).CreatePublicIP(ctx, req.(*CreatePublicIPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicIPService_ListPublicIPPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicIPServiceServer).ListPublicIPPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PublicIPService_ListPublicIPPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicIPServiceServer).ListPublicIPPage(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicIPService_GetPublicIP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicIPServiceServer).GetPublicIP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PublicIPService_GetPublicIP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicIPServiceServer).GetPublicIP(ctx, req.(*IDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicIPService_DeletePublicIP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicIPServiceServer).DeletePublicIP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PublicIPService_DeletePublicIP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicIPServiceServer).DeletePublicIP(ctx, req.(*IDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicIPService_AssociatePublicIP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssociatePublicIPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicIPServiceServer).AssociatePublicIP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PublicIPService_AssociatePublicIP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicIPServiceServer).AssociatePublicIP(ctx, req.(*AssociatePublicIPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicIPService_DisassociatePublicIP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicIPServiceServer).DisassociatePublicIP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PublicIPService_DisassociatePublicIP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicIPServiceServer).DisassociatePublicIP(ctx, req.(*IDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PublicIPService_ServiceDesc is the grpc.ServiceDesc for PublicIPService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PublicIPService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cbspider.api.PublicIPService",
+	HandlerType: (*PublicIPServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePublicIP",
+			Handler:    _PublicIPService_CreatePublicIP_Handler,
+		},
+		{
+			MethodName: "ListPublicIPPage",
+			Handler:    _PublicIPService_ListPublicIPPage_Handler,
+		},
+		{
+			MethodName: "GetPublicIP",
+			Handler:    _PublicIPService_GetPublicIP_Handler,
+		},
+		{
+			MethodName: "DeletePublicIP",
+			Handler:    _PublicIPService_DeletePublicIP_Handler,
+		},
+		{
+			MethodName: "AssociatePublicIP",
+			Handler:    _PublicIPService_AssociatePublicIP_Handler,
+		},
+		{
+			MethodName: "DisassociatePublicIP",
+			Handler:    _PublicIPService_DisassociatePublicIP_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "spider.proto",
+}
+
+const (
+	VMService_StartVM_FullMethodName       = "/cbspider.api.VMService/StartVM"
+	VMService_StartVMs_FullMethodName      = "/cbspider.api.VMService/StartVMs"
+	VMService_SuspendVM_FullMethodName     = "/cbspider.api.VMService/SuspendVM"
+	VMService_ResumeVM_FullMethodName      = "/cbspider.api.VMService/ResumeVM"
+	VMService_RebootVM_FullMethodName      = "/cbspider.api.VMService/RebootVM"
+	VMService_TerminateVM_FullMethodName   = "/cbspider.api.VMService/TerminateVM"
+	VMService_ChangeVMSpec_FullMethodName  = "/cbspider.api.VMService/ChangeVMSpec"
+	VMService_ListVMStatus_FullMethodName  = "/cbspider.api.VMService/ListVMStatus"
+	VMService_GetVMStatus_FullMethodName   = "/cbspider.api.VMService/GetVMStatus"
+	VMService_WatchVMStatus_FullMethodName = "/cbspider.api.VMService/WatchVMStatus"
+	VMService_ListVMPage_FullMethodName    = "/cbspider.api.VMService/ListVMPage"
+	VMService_GetVM_FullMethodName         = "/cbspider.api.VMService/GetVM"
+)
+
+// VMServiceClient is the client API for VMService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type VMServiceClient interface {
+	StartVM(ctx context.Context, in *StartVMRequest, opts ...grpc.CallOption) (*VMInfo, error)
+	StartVMs(ctx context.Context, in *StartVMsRequest, opts ...grpc.CallOption) (*StartVMsReply, error)
+	// Suspend, Resume, Reboot and Terminate reply the VM status after the request.
+	SuspendVM(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*VMStatusInfo, error)
+	ResumeVM(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*VMStatusInfo, error)
+	RebootVM(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*VMStatusInfo, error)
+	TerminateVM(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*VMStatusInfo, error)
+	ChangeVMSpec(ctx context.Context, in *ChangeVMSpecRequest, opts ...grpc.CallOption) (*VMInfo, error)
+	ListVMStatus(ctx context.Context, in *ConnectionRequest, opts ...grpc.CallOption) (*VMStatusList, error)
+	GetVMStatus(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*VMStatusInfo, error)
+	// WatchVMStatus polls GetVMStatus(ListVMStatus) and streams status transitions.
+	// The stream ends when the watched VM is TERMINATED or the client cancels.
+	WatchVMStatus(ctx context.Context, in *WatchVMStatusRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VMStatusEvent], error)
+	ListVMPage(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*VMPageInfo, error)
+	GetVM(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*VMInfo, error)
+}
+
+type vMServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVMServiceClient(cc grpc.ClientConnInterface) VMServiceClient {
+	return &vMServiceClient{cc}
+}
+
+func (c *vMServiceClient) StartVM(ctx context.Context, in *StartVMRequest, opts ...grpc.CallOption) (*VMInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VMInfo)
+	err := c.cc.Invoke(ctx, VMService_StartVM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMServiceClient) StartVMs(ctx context.Context, in *StartVMsRequest, opts ...grpc.CallOption) (*StartVMsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartVMsReply)
+	err := c.cc.Invoke(ctx, VMService_StartVMs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMServiceClient) SuspendVM(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*VMStatusInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VMStatusInfo)
+	err := c.cc.Invoke(ctx, VMService_SuspendVM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMServiceClient) ResumeVM(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*VMStatusInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VMStatusInfo)
+	err := c.cc.Invoke(ctx, VMService_ResumeVM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMServiceClient) RebootVM(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*VMStatusInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VMStatusInfo)
+	err := c.cc.Invoke(ctx, VMService_RebootVM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMServiceClient) TerminateVM(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*VMStatusInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VMStatusInfo)
+	err := c.cc.Invoke(ctx, VMService_TerminateVM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMServiceClient) ChangeVMSpec(ctx context.Context, in *ChangeVMSpecRequest, opts ...grpc.CallOption) (*VMInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VMInfo)
+	err := c.cc.Invoke(ctx, VMService_ChangeVMSpec_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMServiceClient) ListVMStatus(ctx context.Context, in *ConnectionRequest, opts ...grpc.CallOption) (*VMStatusList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VMStatusList)
+	err := c.cc.Invoke(ctx, VMService_ListVMStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMServiceClient) GetVMStatus(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*VMStatusInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VMStatusInfo)
+	err := c.cc.Invoke(ctx, VMService_GetVMStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMServiceClient) WatchVMStatus(ctx context.Context, in *WatchVMStatusRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VMStatusEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VMService_ServiceDesc.Streams[0], VMService_WatchVMStatus_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchVMStatusRequest, VMStatusEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMService_WatchVMStatusClient = grpc.ServerStreamingClient[VMStatusEvent]
+
+func (c *vMServiceClient) ListVMPage(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*VMPageInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VMPageInfo)
+	err := c.cc.Invoke(ctx, VMService_ListVMPage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMServiceClient) GetVM(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*VMInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VMInfo)
+	err := c.cc.Invoke(ctx, VMService_GetVM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VMServiceServer is the server API for VMService service.
+// All implementations must embed UnimplementedVMServiceServer
+// for forward compatibility.
+type VMServiceServer interface {
+	StartVM(context.Context, *StartVMRequest) (*VMInfo, error)
+	StartVMs(context.Context, *StartVMsRequest) (*StartVMsReply, error)
+	// Suspend, Resume, Reboot and Terminate reply the VM status after the request.
+	SuspendVM(context.Context, *IDRequest) (*VMStatusInfo, error)
+	ResumeVM(context.Context, *IDRequest) (*VMStatusInfo, error)
+	RebootVM(context.Context, *IDRequest) (*VMStatusInfo, error)
+	TerminateVM(context.Context, *IDRequest) (*VMStatusInfo, error)
+	ChangeVMSpec(context.Context, *ChangeVMSpecRequest) (*VMInfo, error)
+	ListVMStatus(context.Context, *ConnectionRequest) (*VMStatusList, error)
+	GetVMStatus(context.Context, *IDRequest) (*VMStatusInfo, error)
+	// WatchVMStatus polls GetVMStatus(ListVMStatus) and streams status transitions.
+	// The stream ends when the watched VM is TERMINATED or the client cancels.
+	WatchVMStatus(*WatchVMStatusRequest, grpc.ServerStreamingServer[VMStatusEvent]) error
+	ListVMPage(context.Context, *ListRequest) (*VMPageInfo, error)
+	GetVM(context.Context, *IDRequest) (*VMInfo, error)
+	mustEmbedUnimplementedVMServiceServer()
+}
+
+// UnimplementedVMServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedVMServiceServer struct{}
+
+func (UnimplementedVMServiceServer) StartVM(context.Context, *StartVMRequest) (*VMInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartVM not implemented")
+}
+func (UnimplementedVMServiceServer) StartVMs(context.Context, *StartVMsRequest) (*StartVMsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartVMs not implemented")
+}
+func (UnimplementedVMServiceServer) SuspendVM(context.Context, *IDRequest) (*VMStatusInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method SuspendVM not implemented")
+}
+func (UnimplementedVMServiceServer) ResumeVM(context.Context, *IDRequest) (*VMStatusInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResumeVM not implemented")
+}
+func (UnimplementedVMServiceServer) RebootVM(context.Context, *IDRequest) (*VMStatusInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method RebootVM not implemented")
+}
+func (UnimplementedVMServiceServer) TerminateVM(context.Context, *IDRequest) (*VMStatusInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method TerminateVM not implemented")
+}
+func (UnimplementedVMServiceServer) ChangeVMSpec(context.Context, *ChangeVMSpecRequest) (*VMInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method ChangeVMSpec not implemented")
+}
+func (UnimplementedVMServiceServer) ListVMStatus(context.Context, *ConnectionRequest) (*VMStatusList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListVMStatus not implemented")
+}
+func (UnimplementedVMServiceServer) GetVMStatus(context.Context, *IDRequest) (*VMStatusInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetVMStatus not implemented")
+}
+func (UnimplementedVMServiceServer) WatchVMStatus(*WatchVMStatusRequest, grpc.ServerStreamingServer[VMStatusEvent]) error {
+	return status.Error(codes.Unimplemented, "method WatchVMStatus not implemented")
+}
+func (UnimplementedVMServiceServer) ListVMPage(context.Context, *ListRequest) (*VMPageInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListVMPage not implemented")
+}
+func (UnimplementedVMServiceServer) GetVM(context.Context, *IDRequest) (*VMInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetVM not implemented")
+}
+func (UnimplementedVMServiceServer) mustEmbedUnimplementedVMServiceServer() {}
+func (UnimplementedVMServiceServer) testEmbeddedByValue()                   {}
+
+// UnsafeVMServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VMServiceServer will
+// result in compilation errors.
+type UnsafeVMServiceServer interface {
+	mustEmbedUnimplementedVMServiceServer()
+}
+
+func RegisterVMServiceServer(s grpc.ServiceRegistrar, srv VMServiceServer) {
+	// If the following call panics, it indicates UnimplementedVMServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&VMService_ServiceDesc, srv)
+}
+
+func _VMService_StartVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartVMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMServiceServer).StartVM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMService_StartVM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMServiceServer).StartVM(ctx, req.(*StartVMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMService_StartVMs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartVMsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMServiceServer).StartVMs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMService_StartVMs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMServiceServer).StartVMs(ctx, req.(*StartVMsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMService_SuspendVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMServiceServer).SuspendVM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMService_SuspendVM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMServiceServer).SuspendVM(ctx, req.(*IDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMService_ResumeVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMServiceServer).ResumeVM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMService_ResumeVM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMServiceServer).ResumeVM(ctx, req.(*IDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMService_RebootVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMServiceServer).RebootVM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMService_RebootVM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMServiceServer).RebootVM(ctx, req.(*IDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMService_TerminateVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMServiceServer).TerminateVM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMService_TerminateVM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMServiceServer).TerminateVM(ctx, req.(*IDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMService_ChangeVMSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeVMSpecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMServiceServer).ChangeVMSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMService_ChangeVMSpec_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMServiceServer).ChangeVMSpec(ctx, req.(*ChangeVMSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMService_ListVMStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMServiceServer).ListVMStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMService_ListVMStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMServiceServer).ListVMStatus(ctx, req.(*ConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMService_GetVMStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMServiceServer).GetVMStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMService_GetVMStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMServiceServer).GetVMStatus(ctx, req.(*IDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMService_WatchVMStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchVMStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VMServiceServer).WatchVMStatus(m, &grpc.GenericServerStream[WatchVMStatusRequest, VMStatusEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMService_WatchVMStatusServer = grpc.ServerStreamingServer[VMStatusEvent]
+
+func _VMService_ListVMPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMServiceServer).ListVMPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMService_ListVMPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMServiceServer).ListVMPage(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMService_GetVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMServiceServer).GetVM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMService_GetVM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMServiceServer).GetVM(ctx, req.(*IDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VMService_ServiceDesc is the grpc.ServiceDesc for VMService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VMService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cbspider.api.VMService",
+	HandlerType: (*VMServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StartVM",
+			Handler:    _VMService_StartVM_Handler,
+		},
+		{
+			MethodName: "StartVMs",
+			Handler:    _VMService_StartVMs_Handler,
+		},
+		{
+			MethodName: "SuspendVM",
+			Handler:    _VMService_SuspendVM_Handler,
+		},
+		{
+			MethodName: "ResumeVM",
+			Handler:    _VMService_ResumeVM_Handler,
+		},
+		{
+			MethodName: "RebootVM",
+			Handler:    _VMService_RebootVM_Handler,
+		},
+		{
+			MethodName: "TerminateVM",
+			Handler:    _VMService_TerminateVM_Handler,
+		},
+		{
+			MethodName: "ChangeVMSpec",
+			Handler:    _VMService_ChangeVMSpec_Handler,
+		},
+		{
+			MethodName: "ListVMStatus",
+			Handler:    _VMService_ListVMStatus_Handler,
+		},
+		{
+			MethodName: "GetVMStatus",
+			Handler:    _VMService_GetVMStatus_Handler,
+		},
+		{
+			MethodName: "ListVMPage",
+			Handler:    _VMService_ListVMPage_Handler,
+		},
+		{
+			MethodName: "GetVM",
+			Handler:    _VMService_GetVM_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchVMStatus",
+			Handler:       _VMService_WatchVMStatus_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "spider.proto",
+}
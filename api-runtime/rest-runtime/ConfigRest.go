@@ -0,0 +1,181 @@
+// REST API Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the REST API of Credential, Region and Connection Config registration.
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+package restruntime
+
+import (
+	dim "github.com/cloud-barista/poc-cb-spider/cloud-driver-manager"
+	ccm "github.com/cloud-barista/poc-cb-spider/cloud-driver-manager/connection-config-manager"
+	cim "github.com/cloud-barista/poc-cb-spider/cloud-driver-manager/credential-info-manager"
+	rim "github.com/cloud-barista/poc-cb-spider/cloud-driver-manager/region-info-manager"
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+
+	"net/http"
+)
+
+func init() {
+	addRoute("POST", "/credential", registerCredential)
+	addRoute("GET", "/credential", listCredential)
+	addRoute("GET", "/credential/:credential", getCredential)
+	addRoute("DELETE", "/credential/:credential", unRegisterCredential)
+
+	addRoute("POST", "/region", registerRegion)
+	addRoute("GET", "/region", listRegion)
+	addRoute("GET", "/region/:region", getRegion)
+	addRoute("DELETE", "/region/:region", unRegisterRegion)
+
+	addRoute("POST", "/connectionconfig", registerConnectionConfig)
+	addRoute("GET", "/connectionconfig", listConnectionConfig)
+	addRoute("GET", "/connectionconfig/:config", getConnectionConfig)
+	addRoute("DELETE", "/connectionconfig/:config", unRegisterConnectionConfig)
+	addRoute("GET", "/connectionconfig/:config/test", testConnectionConfig)
+	addRoute("GET", "/connectionconfig/:config/circuitbreaker", getCircuitState)
+	addRoute("GET", "/circuitbreaker", listCircuitState)
+}
+
+//================ Credential: secrets are masked in responses
+
+func registerCredential(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var req cim.CredentialInfo
+	if err := decodeBody(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	crdInfo, err := cim.RegisterCredential(req.CredentialName, req.ProviderName, req.CredentialInfo)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, crdInfo.Masked())
+}
+
+func listCredential(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	crdInfoList := []*cim.CredentialInfo{}
+	for _, crdInfo := range cim.ListCredential() {
+		masked := crdInfo.Masked()
+		crdInfoList = append(crdInfoList, &masked)
+	}
+	writeJSON(w, http.StatusOK, crdInfoList)
+}
+
+func getCredential(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	crdInfo, err := cim.GetCredential(params["credential"])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, crdInfo.Masked())
+}
+
+func unRegisterCredential(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if !cim.UnRegisterCredential(params["credential"]) {
+		writeError(w, irs.NotFound("%s: unregistered credential", params["credential"]))
+		return
+	}
+	writeJSON(w, http.StatusOK, resultInfo{true})
+}
+
+//================ Region
+
+func registerRegion(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var req rim.RegionInfo
+	if err := decodeBody(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	rgnInfo, err := rim.RegisterRegion(req.RegionName, req.ProviderName, req.RegionInfo)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, rgnInfo)
+}
+
+func listRegion(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	writeJSON(w, http.StatusOK, rim.ListRegion())
+}
+
+func getRegion(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	rgnInfo, err := rim.GetRegion(params["region"])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, rgnInfo)
+}
+
+func unRegisterRegion(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if !rim.UnRegisterRegion(params["region"]) {
+		writeError(w, irs.NotFound("%s: unregistered region", params["region"]))
+		return
+	}
+	writeJSON(w, http.StatusOK, resultInfo{true})
+}
+
+//================ Connection Config
+
+func registerConnectionConfig(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var req ccm.ConnectionConfigInfo
+	if err := decodeBody(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	connConfig, err := ccm.RegisterConnectionConfig(req.ConfigName, req.ProviderName, req.DriverName, req.CredentialName, req.RegionName)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, connConfig)
+}
+
+func listConnectionConfig(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	writeJSON(w, http.StatusOK, ccm.ListConnectionConfig())
+}
+
+func getConnectionConfig(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	connConfig, err := ccm.GetConnectionConfig(params["config"])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, connConfig)
+}
+
+func unRegisterConnectionConfig(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if !ccm.UnRegisterConnectionConfig(params["config"]) {
+		writeError(w, irs.NotFound("%s: unregistered connection config", params["config"]))
+		return
+	}
+	writeJSON(w, http.StatusOK, resultInfo{true})
+}
+
+// 연결 실패도 결과(Connected: false, ErrorCode)로 응답 하고, 등록되지 않은 connection config만 오류로 응답 함.
+func testConnectionConfig(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	testInfo, err := dim.TestCloudConnectionContext(r.Context(), params["config"])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, testInfo)
+}
+
+//================ Circuit Breaker
+
+func listCircuitState(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	writeJSON(w, http.StatusOK, dim.ListCircuitState())
+}
+
+func getCircuitState(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if _, err := ccm.GetConnectionConfig(params["config"]); err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dim.GetCircuitState(params["config"]))
+}
@@ -0,0 +1,81 @@
+// REST API Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the REST API of Cloud Driver registration.
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+package restruntime
+
+import (
+	dim "github.com/cloud-barista/poc-cb-spider/cloud-driver-manager"
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+
+	"net/http"
+)
+
+func init() {
+	addRoute("POST", "/driver", registerCloudDriver)
+	addRoute("GET", "/driver", listCloudDriver)
+	addRoute("GET", "/driver/:driver", getCloudDriver)
+	addRoute("DELETE", "/driver/:driver", unRegisterCloudDriver)
+	addRoute("GET", "/driver/:driver/capability", getCloudDriverCapability)
+}
+
+func registerCloudDriver(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var req dim.CloudDriverInfo
+	if err := decodeBody(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	if req.DriverName == "" || req.DriverPath == "" {
+		writeError(w, irs.InvalidArgument("DriverName and DriverPath are required"))
+		return
+	}
+
+	var cldDrvInfo dim.CloudDriverInfo
+	switch req.DriverType {
+	case "", dim.PluginDriver:
+		cldDrvInfo = dim.RegisterCloudDriver(req.ProviderName, req.DriverName, req.DriverPath)
+	case dim.GRPCDriver:
+		cldDrvInfo = dim.RegisterRemoteCloudDriver(req.ProviderName, req.DriverName, req.DriverPath)
+	default:
+		writeError(w, irs.InvalidArgument("DriverType must be %s or %s, %s drivers are linked at build time",
+			dim.PluginDriver, dim.GRPCDriver, dim.StaticDriver))
+		return
+	}
+	writeJSON(w, http.StatusCreated, cldDrvInfo)
+}
+
+func listCloudDriver(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	writeJSON(w, http.StatusOK, dim.ListCloudDriver())
+}
+
+func getCloudDriver(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	cldDrvInfo, err := dim.GetCloudDriver(params["driver"])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, cldDrvInfo)
+}
+
+func unRegisterCloudDriver(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if !dim.UnRegisterCloudDriver(params["driver"]) {
+		writeError(w, irs.NotFound("%s: unregistered cloud driver", params["driver"]))
+		return
+	}
+	writeJSON(w, http.StatusOK, resultInfo{true})
+}
+
+func getCloudDriverCapability(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	drvCapabilityInfo, err := dim.GetCloudDriverCapability(params["driver"])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, drvCapabilityInfo)
+}
@@ -0,0 +1,529 @@
+// REST API Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the REST API of Cloud Resources(Image, VNetwork, Security, KeyPair, VNic, PublicIP).
+// Resources are addressed by connection config name, ex) /spider/connection/aws-seoul-config/vm
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+package restruntime
+
+import (
+	dim "github.com/cloud-barista/poc-cb-spider/cloud-driver-manager"
+	icon "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/connect"
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+	mw "github.com/cloud-barista/poc-cb-spider/cloud-driver/middleware"
+
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+func init() {
+	addRoute("POST", "/connection/:connection/image", createImage)
+	addRoute("GET", "/connection/:connection/image", listImage)
+	addRoute("GET", "/connection/:connection/image/:id", getImage)
+	addRoute("DELETE", "/connection/:connection/image/:id", deleteImage)
+
+	addRoute("POST", "/connection/:connection/vnetwork", createVNetwork)
+	addRoute("GET", "/connection/:connection/vnetwork", listVNetwork)
+	addRoute("GET", "/connection/:connection/vnetwork/:id", getVNetwork)
+	addRoute("DELETE", "/connection/:connection/vnetwork/:id", deleteVNetwork)
+
+	addRoute("POST", "/connection/:connection/security", createSecurity)
+	addRoute("GET", "/connection/:connection/security", listSecurity)
+	addRoute("GET", "/connection/:connection/security/:id", getSecurity)
+	addRoute("DELETE", "/connection/:connection/security/:id", deleteSecurity)
+
+	addRoute("POST", "/connection/:connection/keypair", createKeyPair)
+	addRoute("GET", "/connection/:connection/keypair", listKeyPair)
+	addRoute("GET", "/connection/:connection/keypair/:id", getKeyPair)
+	addRoute("DELETE", "/connection/:connection/keypair/:id", deleteKeyPair)
+
+	addRoute("POST", "/connection/:connection/vnic", createVNic)
+	addRoute("GET", "/connection/:connection/vnic", listVNic)
+	addRoute("GET", "/connection/:connection/vnic/:id", getVNic)
+	addRoute("DELETE", "/connection/:connection/vnic/:id", deleteVNic)
+
+	addRoute("POST", "/connection/:connection/publicip", createPublicIP)
+	addRoute("GET", "/connection/:connection/publicip", listPublicIP)
+	addRoute("GET", "/connection/:connection/publicip/:id", getPublicIP)
+	addRoute("DELETE", "/connection/:connection/publicip/:id", deletePublicIP)
+
+	addRoute("PUT", "/connection/:connection/publicip/:id/associate", associatePublicIP)
+	addRoute("PUT", "/connection/:connection/publicip/:id/disassociate", disassociatePublicIP)
+}
+
+// DryRunResponse is the response of a request with ?dry_run=true, what the request would create or change.
+type DryRunResponse struct {
+	DryRun  bool
+	Results []*mw.DryRunResult
+}
+
+// connectionCall connects with the connection config of the path, and writes the result of call as JSON.
+// With ?dry_run=true, the calls that change resources are validated only, and the response is a DryRunResponse.
+func connectionCall(w http.ResponseWriter, r *http.Request, params map[string]string, status int, call func(cloudConnection icon.CloudConnection) (interface{}, error)) {
+	ctx := r.Context()
+	var plan *mw.DryRunPlan
+	if r.Method != http.MethodGet {
+		dryRun, err := dryRunParam(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if dryRun {
+			ctx, plan = mw.WithDryRun(ctx)
+		}
+	}
+
+	cloudConnection, err := dim.GetCloudConnectionContext(ctx, params["connection"])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	defer cloudConnection.Close()
+
+	result, err := call(cloudConnection)
+	if err == nil && plan != nil {
+		err = plan.Err()
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if plan != nil {
+		writeJSON(w, http.StatusOK, DryRunResponse{DryRun: true, Results: plan.Results()})
+		return
+	}
+	writeJSON(w, status, result)
+}
+
+// dryRunParam reads ?dry_run=true
+func dryRunParam(r *http.Request) (bool, error) {
+	dryRun := r.URL.Query().Get("dry_run")
+	if dryRun == "" {
+		return false, nil
+	}
+	value, err := strconv.ParseBool(dryRun)
+	if err != nil {
+		return false, irs.InvalidArgument("invalid dry_run: %s", dryRun)
+	}
+	return value, nil
+}
+
+// listReqInfo reads ?page_size=10&next_token=...&name_filter=web-*&tag=key:value
+func listReqInfo(r *http.Request) (irs.ListReqInfo, error) {
+	query := r.URL.Query()
+	listReqInfo := irs.ListReqInfo{
+		NextToken:  query.Get("next_token"),
+		NameFilter: query.Get("name_filter"),
+	}
+	if pageSize := query.Get("page_size"); pageSize != "" {
+		size, err := strconv.Atoi(pageSize)
+		if err != nil || size < 0 {
+			return irs.ListReqInfo{}, irs.InvalidArgument("invalid page_size: %s", pageSize)
+		}
+		listReqInfo.PageSize = size
+	}
+	for _, tag := range query["tag"] {
+		tagArr := strings.SplitN(tag, ":", 2)
+		if len(tagArr) != 2 {
+			return irs.ListReqInfo{}, irs.InvalidArgument("invalid tag: %s, key:value is required", tag)
+		}
+		if listReqInfo.TagFilter == nil {
+			listReqInfo.TagFilter = map[string]string{}
+		}
+		listReqInfo.TagFilter[tagArr[0]] = tagArr[1]
+	}
+	return listReqInfo, nil
+}
+
+//================ Image
+
+func imageHandler(cloudConnection icon.CloudConnection) (irs.ImageHandler, error) {
+	handler, err := cloudConnection.CreateImageHandler()
+	return handler, checkHandler("ImageHandler", handler, err)
+}
+
+func createImage(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var req irs.ImageReqInfo
+	if err := decodeBody(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	connectionCall(w, r, params, http.StatusCreated, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := imageHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		return handler.CreateImage(req)
+	})
+}
+
+// ?owner=&guest_os=&architecture=&name_pattern=&visibility= and the list options of listReqInfo()
+func listImage(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	listReq, err := listReqInfo(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	query := r.URL.Query()
+	imageFilterInfo := irs.ImageFilterInfo{
+		Owner:        query.Get("owner"),
+		GuestOS:      query.Get("guest_os"),
+		Architecture: query.Get("architecture"),
+		NamePattern:  query.Get("name_pattern"),
+		Visibility:   irs.ImageVisibility(strings.ToUpper(query.Get("visibility"))),
+	}
+	connectionCall(w, r, params, http.StatusOK, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := imageHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		return handler.ListImagePage(imageFilterInfo, listReq)
+	})
+}
+
+func getImage(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	connectionCall(w, r, params, http.StatusOK, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := imageHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		return handler.GetImage(params["id"])
+	})
+}
+
+func deleteImage(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	connectionCall(w, r, params, http.StatusOK, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := imageHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		result, err := handler.DeleteImage(params["id"])
+		return resultInfo{result}, err
+	})
+}
+
+//================ VNetwork
+
+func vNetworkHandler(cloudConnection icon.CloudConnection) (irs.VNetworkHandler, error) {
+	handler, err := cloudConnection.CreateVNetworkHandler()
+	return handler, checkHandler("VNetworkHandler", handler, err)
+}
+
+func createVNetwork(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var req irs.VNetworkReqInfo
+	if err := decodeBody(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	connectionCall(w, r, params, http.StatusCreated, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := vNetworkHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		return handler.CreateVNetwork(req)
+	})
+}
+
+func listVNetwork(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	listReq, err := listReqInfo(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	connectionCall(w, r, params, http.StatusOK, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := vNetworkHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		return handler.ListVNetworkPage(listReq)
+	})
+}
+
+func getVNetwork(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	connectionCall(w, r, params, http.StatusOK, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := vNetworkHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		return handler.GetVNetwork(params["id"])
+	})
+}
+
+func deleteVNetwork(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	connectionCall(w, r, params, http.StatusOK, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := vNetworkHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		result, err := handler.DeleteVNetwork(params["id"])
+		return resultInfo{result}, err
+	})
+}
+
+//================ Security
+
+func securityHandler(cloudConnection icon.CloudConnection) (irs.SecurityHandler, error) {
+	handler, err := cloudConnection.CreateSecurityHandler()
+	return handler, checkHandler("SecurityHandler", handler, err)
+}
+
+func createSecurity(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var req irs.SecurityReqInfo
+	if err := decodeBody(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	connectionCall(w, r, params, http.StatusCreated, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := securityHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		return handler.CreateSecurity(req)
+	})
+}
+
+func listSecurity(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	listReq, err := listReqInfo(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	connectionCall(w, r, params, http.StatusOK, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := securityHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		return handler.ListSecurityPage(listReq)
+	})
+}
+
+func getSecurity(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	connectionCall(w, r, params, http.StatusOK, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := securityHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		return handler.GetSecurity(params["id"])
+	})
+}
+
+func deleteSecurity(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	connectionCall(w, r, params, http.StatusOK, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := securityHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		result, err := handler.DeleteSecurity(params["id"])
+		return resultInfo{result}, err
+	})
+}
+
+//================ KeyPair
+
+func keyPairHandler(cloudConnection icon.CloudConnection) (irs.KeyPairHandler, error) {
+	handler, err := cloudConnection.CreateKeyPairHandler()
+	return handler, checkHandler("KeyPairHandler", handler, err)
+}
+
+func createKeyPair(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var req irs.KeyPairReqInfo
+	if err := decodeBody(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	connectionCall(w, r, params, http.StatusCreated, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := keyPairHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		return handler.CreateKey(req)
+	})
+}
+
+func listKeyPair(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	listReq, err := listReqInfo(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	connectionCall(w, r, params, http.StatusOK, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := keyPairHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		return handler.ListKeyPage(listReq)
+	})
+}
+
+func getKeyPair(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	connectionCall(w, r, params, http.StatusOK, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := keyPairHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		return handler.GetKey(params["id"])
+	})
+}
+
+func deleteKeyPair(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	connectionCall(w, r, params, http.StatusOK, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := keyPairHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		result, err := handler.DeleteKey(params["id"])
+		return resultInfo{result}, err
+	})
+}
+
+//================ VNic
+
+func vNicHandler(cloudConnection icon.CloudConnection) (irs.VNicHandler, error) {
+	handler, err := cloudConnection.CreateVNicHandler()
+	return handler, checkHandler("VNicHandler", handler, err)
+}
+
+func createVNic(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var req irs.VNicReqInfo
+	if err := decodeBody(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	connectionCall(w, r, params, http.StatusCreated, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := vNicHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		return handler.CreateVNic(req)
+	})
+}
+
+func listVNic(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	listReq, err := listReqInfo(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	connectionCall(w, r, params, http.StatusOK, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := vNicHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		return handler.ListVNicPage(listReq)
+	})
+}
+
+func getVNic(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	connectionCall(w, r, params, http.StatusOK, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := vNicHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		return handler.GetVNic(params["id"])
+	})
+}
+
+func deleteVNic(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	connectionCall(w, r, params, http.StatusOK, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := vNicHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		result, err := handler.DeleteVNic(params["id"])
+		return resultInfo{result}, err
+	})
+}
+
+//================ PublicIP
+
+func publicIPHandler(cloudConnection icon.CloudConnection) (irs.PublicIPHandler, error) {
+	handler, err := cloudConnection.CreatePublicIPHandler()
+	return handler, checkHandler("PublicIPHandler", handler, err)
+}
+
+func createPublicIP(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var req irs.PublicIPReqInfo
+	if err := decodeBody(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	connectionCall(w, r, params, http.StatusCreated, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := publicIPHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		return handler.CreatePublicIP(req)
+	})
+}
+
+func listPublicIP(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	listReq, err := listReqInfo(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	connectionCall(w, r, params, http.StatusOK, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := publicIPHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		return handler.ListPublicIPPage(listReq)
+	})
+}
+
+func getPublicIP(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	connectionCall(w, r, params, http.StatusOK, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := publicIPHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		return handler.GetPublicIP(params["id"])
+	})
+}
+
+func deletePublicIP(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	connectionCall(w, r, params, http.StatusOK, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := publicIPHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		result, err := handler.DeletePublicIP(params["id"])
+		return resultInfo{result}, err
+	})
+}
+
+// body: {"VMID": "..."}, VM ID or NIC ID, see irs.PublicIPHandler
+func associatePublicIP(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var req struct {
+		VMID string
+	}
+	if err := decodeBody(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	connectionCall(w, r, params, http.StatusOK, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := publicIPHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		result, err := handler.AssociatePublicIP(params["id"], req.VMID)
+		return resultInfo{result}, err
+	})
+}
+
+func disassociatePublicIP(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	connectionCall(w, r, params, http.StatusOK, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := publicIPHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		result, err := handler.DisassociatePublicIP(params["id"])
+		return resultInfo{result}, err
+	})
+}
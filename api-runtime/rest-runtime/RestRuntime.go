@@ -0,0 +1,262 @@
+// REST API Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the HTTP/JSON server of CB-Spider. API document: openapi.yaml
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+package restruntime
+
+import (
+	dim "github.com/cloud-barista/poc-cb-spider/cloud-driver-manager"
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+	"github.com/cloud-barista/poc-cb-spider/cloud-driver/logger"
+	mw "github.com/cloud-barista/poc-cb-spider/cloud-driver/middleware"
+
+	cblog "github.com/cloud-barista/cb-log"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/trace"
+
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+)
+
+var cblogger *logrus.Logger
+
+func init() {
+	cblogger = cblog.GetLogger("CB-SPIDER REST")
+}
+
+const basePath = "/spider"
+
+// Header of the user of a request, recorded in audit records. The REST API has no authentication yet.
+const UserHeader = "X-Spider-User"
+
+// Header of the ID of a request, logged by drivers as request_id. Generated if a request has none, and set in the response.
+const RequestIDHeader = "X-Request-ID"
+
+// Header of the idempotency key of a create request. A retried request with the same key returns the resource of the first request.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// Prometheus metrics of the driver calls, served outside of basePath.
+const MetricsPath = "/metrics"
+
+type handlerFunc func(w http.ResponseWriter, r *http.Request, params map[string]string)
+
+type route struct {
+	method   string
+	segments []string // ":name" matches any segment and is passed as params["name"]
+	handler  handlerFunc
+}
+
+var routes []route
+
+func addRoute(method string, path string, handler handlerFunc) {
+	routes = append(routes, route{method, strings.Split(strings.Trim(path, "/"), "/"), handler})
+}
+
+// NewHandler returns the http.Handler of every API under /spider.
+// It is also used by servers that serve other paths, ex) /metrics
+// A request is traced as a child of its W3C traceparent header, see dim.StartTracing().
+func NewHandler() http.Handler {
+	return otelhttp.NewHandler(http.HandlerFunc(serveHTTP), "CB-Spider REST")
+}
+
+// RunServer serves the REST API on addr, ex) ":1024", and the metrics on MetricsPath.
+func RunServer(addr string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/", NewHandler())
+	mux.Handle(MetricsPath, dim.MetricsHandler())
+
+	cblogger.Infof("CB-Spider REST API server is listening on %s%s", addr, basePath)
+	return http.ListenAndServe(addr, mux)
+}
+
+// RunMetricsServer serves only the metrics on addr, ex) to be scraped from another network than the REST API.
+func RunMetricsServer(addr string) error {
+	mux := http.NewServeMux()
+	mux.Handle(MetricsPath, dim.MetricsHandler())
+
+	cblogger.Infof("CB-Spider metrics server is listening on %s%s", addr, MetricsPath)
+	return http.ListenAndServe(addr, mux)
+}
+
+func serveHTTP(w http.ResponseWriter, r *http.Request) {
+	requestID := r.Header.Get(RequestIDHeader)
+	if requestID == "" {
+		requestID = logger.NewRequestID()
+	}
+	w.Header().Set(RequestIDHeader, requestID)
+
+	// 드라이버의 panic이 서버를 종료하지 않도록 500으로 응답 함.
+	defer func() {
+		if rec := recover(); rec != nil {
+			cblogger.WithField(logger.RequestIDField, requestID).Errorf("%s %s: panic: %v", r.Method, r.URL.Path, rec)
+			writeError(w, fmt.Errorf("internal error: %v", rec))
+		}
+	}()
+
+	ctx := mw.WithCaller(r.Context(), requestCaller(r))
+	if key := r.Header.Get(IdempotencyKeyHeader); key != "" {
+		ctx = mw.WithIdempotencyKey(ctx, key)
+	}
+	r = r.WithContext(logger.WithFields(ctx, logger.Fields{logger.RequestIDField: requestID}))
+
+	escapedPath := r.URL.EscapedPath()
+	if !strings.HasPrefix(escapedPath, basePath+"/") {
+		writeError(w, irs.NotFound("%s: no such API", r.URL.Path))
+		return
+	}
+
+	// ID는 '/'를 포함할 수 있으므로(ex: GCP image URL) escape된 경로로 나눈 후 unescape 함.
+	var segments []string
+	for _, segment := range strings.Split(strings.Trim(strings.TrimPrefix(escapedPath, basePath), "/"), "/") {
+		unescaped, err := url.PathUnescape(segment)
+		if err != nil {
+			writeError(w, irs.InvalidArgument("%s: %v", r.URL.Path, err))
+			return
+		}
+		segments = append(segments, unescaped)
+	}
+
+	pathMatched := false
+	for _, rt := range routes {
+		params, ok := rt.match(segments)
+		if !ok {
+			continue
+		}
+		pathMatched = true
+		if rt.method == r.Method {
+			// ID 대신 경로 템플릿을 span 이름으로 사용 함. ex) "GET /connection/:connection/vm/:id"
+			trace.SpanFromContext(r.Context()).SetName(rt.method + " /" + strings.Join(rt.segments, "/"))
+			rt.handler(w, r, params)
+			return
+		}
+	}
+
+	if pathMatched {
+		writeJSON(w, http.StatusMethodNotAllowed, errorInfo{"MethodNotAllowed", r.Method + " " + r.URL.Path})
+		return
+	}
+	writeError(w, irs.NotFound("%s: no such API", r.URL.Path))
+}
+
+// User of Basic authentication(ex. of a proxy in front of the server), or UserHeader.
+func requestCaller(r *http.Request) mw.Caller {
+	user, _, ok := r.BasicAuth()
+	if !ok {
+		user = r.Header.Get(UserHeader)
+	}
+	return mw.Caller{User: user, Address: r.RemoteAddr, API: "REST"}
+}
+
+func (rt route) match(segments []string) (map[string]string, bool) {
+	if len(rt.segments) != len(segments) {
+		return nil, false
+	}
+	params := map[string]string{}
+	for i, segment := range rt.segments {
+		if strings.HasPrefix(segment, ":") {
+			params[segment[1:]] = segments[i]
+		} else if segment != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+// Error response body
+type errorInfo struct {
+	Code    string
+	Message string
+}
+
+// HTTPStatus maps the common error types of drivers to HTTP status codes.
+func HTTPStatus(err error) int {
+	switch irs.ErrorCodeOf(err) {
+	case irs.InvalidArgumentError:
+		return http.StatusBadRequest
+	case irs.UnauthenticatedError:
+		return http.StatusUnauthorized
+	case irs.PermissionDeniedError:
+		return http.StatusForbidden
+	case irs.NotFoundError:
+		return http.StatusNotFound
+	case irs.AlreadyExistsError:
+		return http.StatusConflict
+	case irs.ThrottledError:
+		return http.StatusTooManyRequests
+	case irs.NotSupportedError:
+		return http.StatusNotImplemented
+	case irs.UnavailableError:
+		return http.StatusServiceUnavailable
+	case irs.TimeoutError:
+		return http.StatusGatewayTimeout
+	}
+	return http.StatusInternalServerError
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	status := HTTPStatus(err)
+	if status == http.StatusInternalServerError {
+		cblogger.Error(err)
+	}
+	writeJSON(w, status, errorInfo{string(irs.ErrorCodeOf(err)), err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		cblogger.Error(err)
+	}
+}
+
+func decodeBody(r *http.Request, v interface{}) error {
+	defer r.Body.Close()
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return irs.InvalidArgument("invalid request body: %v", err)
+	}
+	return nil
+}
+
+// Result of Delete and UnRegister APIs
+type resultInfo struct {
+	Result bool
+}
+
+// checkHandler returns NotSupported for drivers that return (nil, nil) from Create*Handler().
+func checkHandler(handlerName string, handler interface{}, err error) error {
+	if err != nil {
+		return err
+	}
+	if handler == nil || (reflect.ValueOf(handler).Kind() == reflect.Ptr && reflect.ValueOf(handler).IsNil()) {
+		return irs.NotSupported("the driver does not support %s", handlerName)
+	}
+	return nil
+}
+
+func init() {
+	addRoute("GET", "/openapi.yaml", getOpenAPI)
+}
+
+// openapi.yaml is read from $CBSPIDER_PATH/api-runtime/rest-runtime.
+func getOpenAPI(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	path := filepath.Join(os.Getenv("CBSPIDER_PATH"), "api-runtime", "rest-runtime", "openapi.yaml")
+	if _, err := os.Stat(path); err != nil {
+		writeError(w, irs.NotFound("%s: %v", path, err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/yaml")
+	http.ServeFile(w, r, path)
+}
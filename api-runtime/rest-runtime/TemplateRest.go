@@ -0,0 +1,149 @@
+// REST API Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the REST API of Infrastructure Templates(plan, apply, destroy).
+// The body is a template in JSON or YAML, ex) cloud-driver/drivers/aws/main/template.yaml
+// Workflows are the journal of applies and destroys, to resume or roll back after a failure or a crash.
+// Apply, destroy, resume and rollback run in the background, so a disconnected client does not cancel them:
+// they return 202 with the plan and its WorkflowId, and the result is polled by GET /template/workflow/:id.
+//
+// by powerkim@etri.re.kr, 2019.08.
+
+package restruntime
+
+import (
+	tpm "github.com/cloud-barista/poc-cb-spider/cloud-driver-manager/template-manager"
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+	"github.com/cloud-barista/poc-cb-spider/cloud-driver/logger"
+
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+)
+
+func init() {
+	addRoute("POST", "/template/plan", planTemplate)
+	addRoute("POST", "/template/apply", applyTemplate)
+	addRoute("POST", "/template/destroy", destroyTemplate)
+
+	addRoute("GET", "/template/workflow", listWorkflow)
+	addRoute("GET", "/template/workflow/:id", getWorkflow)
+	addRoute("POST", "/template/workflow/:id/resume", resumeWorkflow)
+	addRoute("POST", "/template/workflow/:id/rollback", rollbackWorkflow)
+}
+
+// Error response of apply and destroy, with the plan whose steps have the status.
+type templateErrorInfo struct {
+	Code    string
+	Message string
+	Plan    *tpm.Plan `json:",omitempty"`
+}
+
+func readTemplate(r *http.Request) (*tpm.Template, error) {
+	defer r.Body.Close()
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, irs.InvalidArgument("invalid request body: %v", err)
+	}
+	return tpm.ParseTemplate(data)
+}
+
+// ?destroy=true: the plan of destroy
+func planTemplate(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	destroy := false
+	if value := r.URL.Query().Get("destroy"); value != "" {
+		var err error
+		if destroy, err = strconv.ParseBool(value); err != nil {
+			writeError(w, irs.InvalidArgument("invalid destroy: %s", value))
+			return
+		}
+	}
+	template, err := readTemplate(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	makePlan := tpm.MakePlan
+	if destroy {
+		makePlan = tpm.MakeDestroyPlan
+	}
+	plan, err := makePlan(r.Context(), template)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, plan)
+}
+
+func applyTemplate(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	templateCall(w, r, tpm.StartApply)
+}
+
+func destroyTemplate(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	templateCall(w, r, tpm.StartDestroy)
+}
+
+func templateCall(w http.ResponseWriter, r *http.Request, start func(ctx context.Context, template *tpm.Template) (*tpm.Plan, error)) {
+	template, err := readTemplate(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	plan, err := start(r.Context(), template)
+	writePlan(w, plan, err)
+}
+
+func writePlan(w http.ResponseWriter, plan *tpm.Plan, err error) {
+	if err != nil {
+		status := HTTPStatus(err)
+		if status == http.StatusInternalServerError {
+			cblogger.Error(err)
+		}
+		writeJSON(w, status, templateErrorInfo{string(irs.ErrorCodeOf(err)), err.Error(), plan})
+		return
+	}
+	// 변경 사항이 없는 plan은 워크플로우가 없음.
+	if plan.WorkflowId == "" {
+		writeJSON(w, http.StatusOK, plan)
+		return
+	}
+	writeJSON(w, http.StatusAccepted, plan)
+}
+
+// The templates of workflows have secrets(ex. LoginInfo).
+func listWorkflow(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	workflows, err := tpm.ListWorkflows()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if workflows == nil {
+		workflows = []*tpm.Workflow{}
+	}
+	writeJSON(w, http.StatusOK, logger.Redact(workflows))
+}
+
+func getWorkflow(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	workflow, err := tpm.GetWorkflow(params["id"])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, logger.Redact(workflow))
+}
+
+func resumeWorkflow(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	plan, err := tpm.StartResumeWorkflow(r.Context(), params["id"])
+	writePlan(w, plan, err)
+}
+
+func rollbackWorkflow(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	plan, err := tpm.StartRollbackWorkflow(r.Context(), params["id"])
+	writePlan(w, plan, err)
+}
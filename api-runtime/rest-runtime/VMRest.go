@@ -0,0 +1,172 @@
+// REST API Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the REST API of VM.
+// Resources are addressed by connection config name, ex) /spider/connection/aws-seoul-config/vm
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+package restruntime
+
+import (
+	icon "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/connect"
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+
+	"net/http"
+)
+
+func init() {
+	addRoute("POST", "/connection/:connection/vm", startVM)
+	addRoute("POST", "/connection/:connection/vm/batch", startVMs)
+	addRoute("GET", "/connection/:connection/vm", listVM)
+	addRoute("GET", "/connection/:connection/vm/:id", getVM)
+	addRoute("DELETE", "/connection/:connection/vm/:id", terminateVM)
+
+	addRoute("GET", "/connection/:connection/vmstatus", listVMStatus)
+	addRoute("GET", "/connection/:connection/vm/:id/status", getVMStatus)
+	addRoute("PUT", "/connection/:connection/vm/:id/suspend", suspendVM)
+	addRoute("PUT", "/connection/:connection/vm/:id/resume", resumeVM)
+	addRoute("PUT", "/connection/:connection/vm/:id/reboot", rebootVM)
+	addRoute("PUT", "/connection/:connection/vm/:id/spec", changeVMSpec)
+}
+
+func vmHandler(cloudConnection icon.CloudConnection) (irs.VMHandler, error) {
+	handler, err := cloudConnection.CreateVMHandler()
+	return handler, checkHandler("VMHandler", handler, err)
+}
+
+func startVM(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var req irs.VMReqInfo
+	if err := decodeBody(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	connectionCall(w, r, params, http.StatusCreated, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := vmHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		return handler.StartVM(req)
+	})
+}
+
+// body: {"VMReqInfo": {...}, "Count": 3, "MinCount": 2}
+// Results are returned with the error, so the response of a partial failure is the error only.
+func startVMs(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var req struct {
+		VMReqInfo irs.VMReqInfo
+		Count     int
+		MinCount  int
+	}
+	if err := decodeBody(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	connectionCall(w, r, params, http.StatusCreated, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := vmHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		return handler.StartVMs(req.VMReqInfo, req.Count, req.MinCount)
+	})
+}
+
+func listVM(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	listReq, err := listReqInfo(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	connectionCall(w, r, params, http.StatusOK, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := vmHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		return handler.ListVMPage(listReq)
+	})
+}
+
+// VMHandler.GetVM()은 error를 반환하지 않으므로 Id가 없으면 NotFound로 응답 함.
+func getVM(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	connectionCall(w, r, params, http.StatusOK, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := vmHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		vmInfo := handler.GetVM(params["id"])
+		if vmInfo.Id == "" {
+			return nil, irs.NotFound("%s: VM not found", params["id"])
+		}
+		return vmInfo, nil
+	})
+}
+
+func listVMStatus(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	connectionCall(w, r, params, http.StatusOK, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := vmHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		return handler.ListVMStatus(), nil
+	})
+}
+
+func getVMStatus(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	vmControl(w, r, params, nil)
+}
+
+func suspendVM(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	vmControl(w, r, params, irs.VMHandler.SuspendVM)
+}
+
+func resumeVM(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	vmControl(w, r, params, irs.VMHandler.ResumeVM)
+}
+
+func rebootVM(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	vmControl(w, r, params, irs.VMHandler.RebootVM)
+}
+
+func terminateVM(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	vmControl(w, r, params, irs.VMHandler.TerminateVM)
+}
+
+// vmControl calls control(ex: SuspendVM) and responds the VM status after the call.
+// The control methods do not return errors, so the status tells the result.
+func vmControl(w http.ResponseWriter, r *http.Request, params map[string]string, control func(irs.VMHandler, string)) {
+	connectionCall(w, r, params, http.StatusOK, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := vmHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		if control != nil {
+			control(handler, params["id"])
+		}
+		return irs.VMStatusInfo{VmId: params["id"], VmStatus: handler.GetVMStatus(params["id"])}, nil
+	})
+}
+
+// body: {"SpecID": "t2.small"}
+func changeVMSpec(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var req struct {
+		SpecID string
+	}
+	if err := decodeBody(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	if req.SpecID == "" {
+		writeError(w, irs.InvalidArgument("SpecID is required"))
+		return
+	}
+	connectionCall(w, r, params, http.StatusOK, func(cloudConnection icon.CloudConnection) (interface{}, error) {
+		handler, err := vmHandler(cloudConnection)
+		if err != nil {
+			return nil, err
+		}
+		return handler.ChangeVMSpec(params["id"], req.SpecID)
+	})
+}
@@ -0,0 +1,160 @@
+// Proof of Concepts of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is PoC of Cloud Connection with a Connection Config.
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+package drivermanager
+
+import (
+	ccm "github.com/cloud-barista/poc-cb-spider/cloud-driver-manager/connection-config-manager"
+	cim "github.com/cloud-barista/poc-cb-spider/cloud-driver-manager/credential-info-manager"
+	rim "github.com/cloud-barista/poc-cb-spider/cloud-driver-manager/region-info-manager"
+	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
+	icon "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/connect"
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+	mw "github.com/cloud-barista/poc-cb-spider/cloud-driver/middleware"
+	"go.opentelemetry.io/otel/trace"
+
+	"context"
+	"time"
+)
+
+// GetCloudConnection returns the connection of a connection config.
+// Connections are cached per connection config and shared by concurrent callers,
+// and reconnected when the connection config, credential, region or driver has changed.
+// Handlers of the connection retry transient errors, are rate limited and fail fast while the CSP is failing,
+// see HandlerMiddleware.go.
+// The caller must Close() the connection: it releases the connection to the cache.
+func GetCloudConnection(connectionName string) (icon.CloudConnection, error) {
+	return GetCloudConnectionContext(context.Background(), connectionName)
+}
+
+// GetCloudConnectionContext is GetCloudConnection whose spans, and the spans of the handler calls, are children of ctx.
+// The caller of the handler calls in audit records is mw.CallerFrom(ctx).
+func GetCloudConnectionContext(ctx context.Context, connectionName string) (icon.CloudConnection, error) {
+	spanCtx, span := tracer.Start(ctx, "GetCloudConnection", trace.WithAttributes(mw.ConnectionKey.String(connectionName)))
+	defer span.End()
+
+	cloudConnection, target, err := getCloudConnection(spanCtx, connectionName)
+	span.SetAttributes(mw.TargetAttributes(target)...)
+	mw.SetSpanError(span, err)
+	if err != nil {
+		return nil, err
+	}
+	return mw.WrapConnection(ctx, cloudConnection, target, handlerInterceptors()...), nil
+}
+
+func getCloudConnection(ctx context.Context, connectionName string) (icon.CloudConnection, mw.Target, error) {
+	target := mw.Target{ConnectionName: connectionName}
+	connConfig, err := ccm.GetConnectionConfig(connectionName)
+	if err != nil {
+		return nil, target, err
+	}
+	crdInfo, err := cim.GetCredential(connConfig.CredentialName)
+	if err != nil {
+		return nil, target, err
+	}
+	rgnInfo, err := rim.GetRegion(connConfig.RegionName)
+	if err != nil {
+		return nil, target, err
+	}
+	target.DriverName, target.Region = connConfig.DriverName, rgnInfo.RegionInfo.Region
+
+	cldDrvInfo, err := GetCloudDriver(connConfig.DriverName)
+	if err != nil {
+		return nil, target, err
+	}
+
+	cloudDriver, err := LoadCloudDriver(connConfig.DriverName)
+	if err != nil {
+		return nil, target, err
+	}
+
+	connectionInfo := idrv.ConnectionInfo{
+		CredentialInfo: crdInfo.CredentialInfo,
+		RegionInfo:     rgnInfo.RegionInfo,
+	}
+	// 캐시된 connection을 사용하면 ConnectCloud span이 없음.
+	connect := func() (icon.CloudConnection, error) {
+		_, span := tracer.Start(ctx, "ConnectCloud", trace.WithAttributes(mw.TargetAttributes(target)...))
+		defer span.End()
+
+		conn, err := cloudDriver.ConnectCloud(connectionInfo)
+		mw.SetSpanError(span, err)
+		return conn, err
+	}
+
+	var cloudConnection icon.CloudConnection
+	if ConnectionIdleTimeout <= 0 {
+		cloudConnection, err = connect()
+	} else {
+		var fingerprint string
+		fingerprint, err = connectionFingerprint(connConfig, crdInfo, rgnInfo, cldDrvInfo)
+		if err != nil {
+			return nil, target, err
+		}
+		cloudConnection, err = getCachedConnection(connectionName, connConfig.DriverName, fingerprint, cloudDriver, connect)
+	}
+	return cloudConnection, target, err
+}
+
+// Result of TestCloudConnection.
+type ConnectionTestInfo struct {
+	ConnectionName string
+	Connected      bool
+	ErrorCode      irs.ErrorCode `json:",omitempty"` // UnauthenticatedError, PermissionDeniedError, UnavailableError, ...
+	Message        string        `json:",omitempty"`
+	ElapsedMs      int64         // milliseconds of connecting and checking
+}
+
+// TestCloudConnection connects with a connection config and checks the connection with IsConnected().
+// A failed connection is removed from the cache, so the next GetCloudConnection connects again.
+func TestCloudConnection(connectionName string) (ConnectionTestInfo, error) {
+	return TestCloudConnectionContext(context.Background(), connectionName)
+}
+
+func TestCloudConnectionContext(ctx context.Context, connectionName string) (ConnectionTestInfo, error) {
+	if _, err := ccm.GetConnectionConfig(connectionName); err != nil {
+		return ConnectionTestInfo{}, err
+	}
+
+	ctx, span := tracer.Start(ctx, "TestCloudConnection", trace.WithAttributes(mw.ConnectionKey.String(connectionName)))
+	defer span.End()
+
+	testInfo := ConnectionTestInfo{ConnectionName: connectionName}
+	start := time.Now()
+	err := testCloudConnection(ctx, connectionName)
+	mw.SetSpanError(span, err)
+	testInfo.ElapsedMs = int64(time.Since(start) / time.Millisecond)
+
+	if err != nil {
+		InvalidateCloudConnection(connectionName)
+		testInfo.ErrorCode = irs.ErrorCodeOf(err)
+		testInfo.Message = err.Error()
+		return testInfo, nil
+	}
+	testInfo.Connected = true
+	return testInfo, nil
+}
+
+func testCloudConnection(ctx context.Context, connectionName string) error {
+	cloudConnection, err := GetCloudConnectionContext(ctx, connectionName)
+	if err != nil {
+		return irs.ConnectionError(err)
+	}
+	defer cloudConnection.Close()
+
+	connected, err := cloudConnection.IsConnected()
+	if err != nil {
+		return irs.ConnectionError(err)
+	}
+	if !connected {
+		return irs.NewCloudError(irs.UnavailableError, "%s: not connected", connectionName)
+	}
+	return nil
+}
@@ -8,32 +8,61 @@
 //
 // by powerkim@etri.re.kr, 2019.07.
 
-
-//package drivermanager
-package main
+package drivermanager
 
 import (
+	registry "github.com/cloud-barista/poc-cb-spider/cloud-driver/driver-registry"
+	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
 	cbs "github.com/cloud-barista/poc-cb-store"
 
-	"fmt"
+	"sync"
+)
+
+// DriverType of CloudDriverInfo
+const (
+	PluginDriver = "plugin" // Go plugin(.so), loaded into the host process
+	GRPCDriver   = "grpc"   // driver server binary, run as a subprocess and called over gRPC
+	StaticDriver = "static" // linked into the host binary with build tags, see StaticDriver*.go
 )
 
 type CloudDriverInfo struct {
-	providerName string
-	driverName string
-	driverPath string	
+	ProviderName string
+	DriverName   string
+	DriverPath   string // plugin(.so) file path or driver server binary path, "": StaticDriver
+	DriverType   string // PluginDriver, GRPCDriver, StaticDriver
 }
 
+// @todo storage에서 조회하기 전까지 등록 정보는 메모리에 유지 함.
+var cldDrvInfoMap = map[string]CloudDriverInfo{}
+var cldDrvInfoMutex sync.RWMutex
+
 func RegisterCloudDriver(providerName string, driverName string, driverPath string) CloudDriverInfo {
-	cldDrvInfo := CloudDriverInfo{providerName, driverName, driverPath}
-	
+	return registerCloudDriver(CloudDriverInfo{providerName, driverName, driverPath, PluginDriver})
+}
+
+// RegisterRemoteCloudDriver registers a driver server binary, ex) /tmp/AwsDriverServer
+// A panic of the driver does not kill the host, and the driver can be built with any Go toolchain.
+func RegisterRemoteCloudDriver(providerName string, driverName string, serverPath string) CloudDriverInfo {
+	return registerCloudDriver(CloudDriverInfo{providerName, driverName, serverPath, GRPCDriver})
+}
+
+func registerCloudDriver(cldDrvInfo CloudDriverInfo) CloudDriverInfo {
+	driverName := cldDrvInfo.DriverName
+	stopRemoteDriver(driverName)
+	invalidateDriverConnections(driverName)
+
 	// @todo save into storage
-	writer := cbs.GetWriter()	
-	err := writer.PutKV("key1", "value1")
+	writer := cbs.GetWriter()
+	err := writer.PutKV("/cloud-driver/"+driverName, cldDrvInfo.DriverType+":"+cldDrvInfo.DriverPath)
 	if err != nil {
 		panic(err)
 	}
 
+	cldDrvInfoMutex.Lock()
+	cldDrvInfoMap[driverName] = cldDrvInfo
+	cldDrvInfoMutex.Unlock()
+
 	return cldDrvInfo
 }
 
@@ -41,89 +70,86 @@ func ListCloudDriver() []*CloudDriverInfo {
 	var cldDrvInfoList []*CloudDriverInfo
 
 	// @todo get list from storage
+	cldDrvInfoMutex.RLock()
+	defer cldDrvInfoMutex.RUnlock()
+	for _, cldDrvInfo := range cldDrvInfoMap {
+		info := cldDrvInfo
+		cldDrvInfoList = append(cldDrvInfoList, &info)
+	}
+
+	// 같은 이름으로 등록된 드라이버가 없는 static 드라이버도 포함 함.
+	for _, driverName := range registry.List() {
+		if _, ok := cldDrvInfoMap[driverName]; !ok {
+			info := staticDriverInfo(driverName)
+			cldDrvInfoList = append(cldDrvInfoList, &info)
+		}
+	}
 
 	return cldDrvInfoList
 }
 
-func GetCloudDriver(driverName string) CloudDriverInfo {
-	var cldDrvInfo CloudDriverInfo
+func GetCloudDriver(driverName string) (CloudDriverInfo, error) {
+	cldDrvInfoMutex.RLock()
+	defer cldDrvInfoMutex.RUnlock()
 
-	// @todo
+	// 등록된 드라이버(plugin, grpc)가 같은 이름의 static 드라이버보다 우선 함.
+	if cldDrvInfo, ok := cldDrvInfoMap[driverName]; ok {
+		return cldDrvInfo, nil
+	}
+	if _, err := registry.Get(driverName); err == nil {
+		return staticDriverInfo(driverName), nil
+	}
+	return CloudDriverInfo{}, irs.NotFound("%s: unregistered cloud driver", driverName)
+}
 
-	return cldDrvInfo
+func staticDriverInfo(driverName string) CloudDriverInfo {
+	return CloudDriverInfo{ProviderName: driverName, DriverName: driverName, DriverType: StaticDriver}
 }
 
 func UnRegisterCloudDriver(driverName string) bool {
-	var result bool
-
-	// @todo
+	cldDrvInfoMutex.Lock()
+	defer cldDrvInfoMutex.Unlock()
 
-	return result
-}
-
-func main() {
-	cldDrvInfo := RegisterCloudDriver("csp1", "csp1_driver", "csp1_driver_path")
-	fmt.Printf(">>> %#v\n", cldDrvInfo);
+	if _, ok := cldDrvInfoMap[driverName]; !ok {
+		return false
+	}
+	delete(cldDrvInfoMap, driverName)
+	stopRemoteDriver(driverName)
+	invalidateDriverConnections(driverName)
+	return true
 }
 
-
-/* only to refer by powerkim
-func main() {
-
-	var plug *plugin.Plugin
-	var err error
-        if *driverPath == "none" {
-		fmt.Println("Usage: CloudDriverManager -driver=/tmp/TestADriver.so")
-		return
-        }
-
-	//fmt.Println("######### driver path:" + *driverPath)
-	plug, err = plugin.Open(*driverPath)
-
-	// fmt.Printf("plug: %#v\n\n", plug)	
+// LoadCloudDriver returns the CloudDriver of a registered driver.
+// PluginDriver: opens the plugin and returns its exported TestDriver.
+// GRPCDriver: starts the driver server(once) and returns its host-side adapter.
+// StaticDriver: returns the driver linked into the host binary.
+// The driver is rejected if its DriverManifest is not compatible with the host.
+func LoadCloudDriver(driverName string) (idrv.CloudDriver, error) {
+	cldDrvInfo, err := GetCloudDriver(driverName)
 	if err != nil {
-		log.Fatalf("plugin.Open: %v\n", err)	
-		return
+		return nil, err
 	}
 
-
-	testDriver, err := plug.Lookup("TestDriver")	
-	if err != nil {
-		log.Fatalf("plug.Lookup: %v\n", err)	
-		return
+	switch cldDrvInfo.DriverType {
+	case StaticDriver:
+		return registry.Get(driverName)
+	case GRPCDriver:
+		remoteDriver, err := startRemoteDriver(cldDrvInfo)
+		if err != nil {
+			return nil, err
+		}
+		return remoteDriver, nil
 	}
+	cloudDriver, _, err := OpenDriverPlugin(cldDrvInfo.DriverPath)
+	return cloudDriver, err
+}
 
-	cloudDriver, ok := testDriver.(idrv.CloudDriver)
-	if !ok {
-		log.Fatalf("Not CloudDriver interface!!")
-		return
+// GetCloudDriverCapability returns the per-operation capability reported by the driver itself.
+// Callers can check it before calling, ex) capability.VMHandler.SupportsOperation("ChangeVMSpec")
+func GetCloudDriverCapability(driverName string) (idrv.DriverCapabilityInfo, error) {
+	cloudDriver, err := LoadCloudDriver(driverName)
+	if err != nil {
+		return idrv.DriverCapabilityInfo{}, err
 	}
-
-	fmt.Printf("%s: %s\n", *driverPath, cloudDriver.GetDriverVersion())
-
-///* in CloudDriver.go
-//	type CredentialInfo struct {
-		// @todo TBD
-		// key-value pairs
-//	}
-
-//	type RegionInfo struct {
-//		Region string
-//		Zone string
-//	}
-
-//	type ConnectionInfo struct {
-//		CredentialInfo CredentialInfo
-//		RegionInfo RegionInfo
-//	}
-
-//	credentialInfo := idrv.CredentialInfo{}
-//	regionInfo := idrv.RegionInfo{"testRegion", "TestZone"}
-//	connectionInfo := idrv.ConnectionInfo{credentialInfo, regionInfo}
-	
-	
-//	cloudConnection, _ := cloudDriver.ConnectCloud(connectionInfo)
-//	cloudConnection.CreateVNetworkHandler()
-
+	return cloudDriver.GetDriverCapability(), nil
 }
-*/
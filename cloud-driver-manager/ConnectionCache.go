@@ -0,0 +1,230 @@
+// Proof of Concepts of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is PoC of Cloud Connection cache.
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+package drivermanager
+
+import (
+	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
+	icon "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/connect"
+
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// A cached connection not used for ConnectionIdleTimeout is closed.
+// <= 0: no cache, GetCloudConnection connects on every call. Set before the first GetCloudConnection.
+var ConnectionIdleTimeout = 10 * time.Minute
+
+const connJanitorInterval = time.Minute
+
+// 하나의 connection config에 대한 캐시 항목.
+// fingerprint: connection config, credential, region, driver 정보의 hash. 값이 바뀌면(ex. credential rotation) 다시 연결 함.
+type connEntry struct {
+	connectionName string
+	driverName     string
+	fingerprint    string
+	cloudDriver    idrv.CloudDriver
+
+	ready chan struct{} // closed when ConnectCloud() returned
+	conn  icon.CloudConnection
+	err   error
+
+	refs     int       // leases not closed yet
+	lastUsed time.Time // last time refs became 0
+	stale    bool      // removed from the cache, closed when refs becomes 0
+}
+
+// key: connection config name
+var connCacheMap = map[string]*connEntry{}
+var connCacheMutex sync.Mutex
+var connJanitorOnce sync.Once
+
+// 캐시된 connection을 빌려주는 wrapper. Close()는 빌린 것만 반납 하고, 실제 Close()는 캐시가 함.
+type cachedConnection struct {
+	icon.CloudConnection
+	entry     *connEntry
+	closeOnce sync.Once
+}
+
+func (conn *cachedConnection) Close() error {
+	conn.closeOnce.Do(func() {
+		releaseConnEntry(conn.entry)
+	})
+	return nil
+}
+
+// getCachedConnection returns a lease of the cached connection of connectionName.
+// A new connection is made with connect() if there is none, or if fingerprint or cloudDriver has changed.
+// Concurrent callers of the same connection config wait for one connect().
+func getCachedConnection(connectionName string, driverName string, fingerprint string, cloudDriver idrv.CloudDriver,
+	connect func() (icon.CloudConnection, error)) (icon.CloudConnection, error) {
+	connJanitorOnce.Do(func() {
+		go connJanitor()
+	})
+
+	connCacheMutex.Lock()
+	entry, ok := connCacheMap[connectionName]
+	if ok && (entry.fingerprint != fingerprint || !sameCloudDriver(entry.cloudDriver, cloudDriver)) {
+		cblogger.Infof("%s: connection config, credential, region or driver has changed, reconnecting", connectionName)
+		evictConnEntry(entry)
+		ok = false
+	}
+	if !ok {
+		entry = &connEntry{
+			connectionName: connectionName,
+			driverName:     driverName,
+			fingerprint:    fingerprint,
+			cloudDriver:    cloudDriver,
+			ready:          make(chan struct{}),
+		}
+		connCacheMap[connectionName] = entry
+	}
+	entry.refs++
+	connCacheMutex.Unlock()
+
+	if !ok {
+		// ConnectCloud()는 오래 걸릴 수 있으므로 lock 밖에서 호출 함.
+		conn, err := connect()
+
+		connCacheMutex.Lock()
+		entry.conn, entry.err = conn, err
+		if err != nil && connCacheMap[connectionName] == entry {
+			delete(connCacheMap, connectionName)
+		}
+		close(entry.ready)
+		connCacheMutex.Unlock()
+	}
+
+	<-entry.ready
+	if entry.err != nil {
+		releaseConnEntry(entry)
+		return nil, entry.err
+	}
+	return &cachedConnection{CloudConnection: entry.conn, entry: entry}, nil
+}
+
+func releaseConnEntry(entry *connEntry) {
+	connCacheMutex.Lock()
+	defer connCacheMutex.Unlock()
+
+	entry.refs--
+	entry.lastUsed = time.Now()
+	if entry.stale && entry.refs == 0 {
+		closeConnEntry(entry)
+	}
+}
+
+// evictConnEntry removes entry from the cache, and closes it if it is not used.
+// connCacheMutex must be held.
+func evictConnEntry(entry *connEntry) {
+	if connCacheMap[entry.connectionName] == entry {
+		delete(connCacheMap, entry.connectionName)
+	}
+	if entry.stale {
+		return
+	}
+	entry.stale = true
+	if entry.refs == 0 {
+		closeConnEntry(entry)
+	}
+}
+
+// connCacheMutex must be held. Close() of a GRPCDriver connection is a remote call, so it runs without the lock.
+func closeConnEntry(entry *connEntry) {
+	if entry.conn == nil {
+		return
+	}
+	go func(connectionName string, conn icon.CloudConnection) {
+		if err := conn.Close(); err != nil {
+			cblogger.Errorf("%s: failed to close the connection: %v", connectionName, err)
+		}
+	}(entry.connectionName, entry.conn)
+}
+
+func connJanitor() {
+	ticker := time.NewTicker(connJanitorInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		evictIdleConnections()
+	}
+}
+
+func evictIdleConnections() {
+	connCacheMutex.Lock()
+	defer connCacheMutex.Unlock()
+
+	for _, entry := range connCacheMap {
+		if entry.refs == 0 && entry.conn != nil && time.Since(entry.lastUsed) > ConnectionIdleTimeout {
+			cblogger.Infof("%s: closing the idle connection", entry.connectionName)
+			evictConnEntry(entry)
+		}
+	}
+}
+
+// InvalidateCloudConnection closes the cached connection of a connection config.
+// Connections in use are closed when their last user calls Close().
+func InvalidateCloudConnection(connectionName string) bool {
+	connCacheMutex.Lock()
+	defer connCacheMutex.Unlock()
+
+	entry, ok := connCacheMap[connectionName]
+	if ok {
+		evictConnEntry(entry)
+	}
+	return ok
+}
+
+// CloseCloudConnections closes all cached connections, ex) at server shutdown.
+func CloseCloudConnections() {
+	connCacheMutex.Lock()
+	defer connCacheMutex.Unlock()
+
+	for _, entry := range connCacheMap {
+		evictConnEntry(entry)
+	}
+}
+
+// 드라이버가 다시 등록되거나 해제되면 그 드라이버로 연결된 connection을 모두 닫음.
+func invalidateDriverConnections(driverName string) {
+	connCacheMutex.Lock()
+	defer connCacheMutex.Unlock()
+
+	for _, entry := range connCacheMap {
+		if entry.driverName == driverName {
+			evictConnEntry(entry)
+		}
+	}
+}
+
+// CredentialInfo 등 비밀 정보는 hash로만 보관 함.
+func connectionFingerprint(values ...interface{}) (string, error) {
+	data, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// GRPCDriver는 드라이버 프로세스가 다시 시작되면 다른 CloudDriver가 됨.
+// 비교할 수 없는 타입의 드라이버는 같은 드라이버로 간주 함.
+func sameCloudDriver(a idrv.CloudDriver, b idrv.CloudDriver) bool {
+	if reflect.TypeOf(a) != reflect.TypeOf(b) {
+		return false
+	}
+	if a == nil || !reflect.TypeOf(a).Comparable() {
+		return true
+	}
+	return a == b
+}
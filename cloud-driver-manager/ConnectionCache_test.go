@@ -0,0 +1,232 @@
+// Proof of Concepts of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the test of the Cloud Connection cache.
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+package drivermanager
+
+import (
+	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
+	icon "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/connect"
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// Close()만 사용 함.
+type testConnection struct {
+	icon.CloudConnection
+	closed int32
+}
+
+func (conn *testConnection) Close() error {
+	atomic.AddInt32(&conn.closed, 1)
+	return nil
+}
+
+// closeConnEntry()는 goroutine에서 닫으므로 잠시 기다림.
+func (conn *testConnection) waitClosed(t *testing.T, want int32) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for atomic.LoadInt32(&conn.closed) != want && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if closed := atomic.LoadInt32(&conn.closed); closed != want {
+		t.Fatalf("closed %d times, want %d", closed, want)
+	}
+}
+
+type testDriver struct {
+	idrv.CloudDriver
+}
+
+type testConnector struct {
+	mutex    sync.Mutex
+	conns    []*testConnection
+	err      error
+	duration time.Duration
+}
+
+func (connector *testConnector) connect() (icon.CloudConnection, error) {
+	time.Sleep(connector.duration)
+	connector.mutex.Lock()
+	defer connector.mutex.Unlock()
+	if connector.err != nil {
+		return nil, connector.err
+	}
+	conn := &testConnection{}
+	connector.conns = append(connector.conns, conn)
+	return conn, nil
+}
+
+func (connector *testConnector) count() int {
+	connector.mutex.Lock()
+	defer connector.mutex.Unlock()
+	return len(connector.conns)
+}
+
+func resetConnCache() {
+	connCacheMutex.Lock()
+	defer connCacheMutex.Unlock()
+	connCacheMap = map[string]*connEntry{}
+}
+
+func TestConnectionCache(t *testing.T) {
+	resetConnCache()
+	connector := &testConnector{}
+
+	lease1, err := getCachedConnection("aws-seoul", "aws", "fp-1", nil, connector.connect)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lease2, _ := getCachedConnection("aws-seoul", "aws", "fp-1", nil, connector.connect)
+	if connector.count() != 1 {
+		t.Fatalf("connected %d times, want 1", connector.count())
+	}
+	conn := connector.conns[0]
+
+	// 두 번 Close() 해도 한 번만 반납 함.
+	lease1.Close()
+	lease1.Close()
+	if entry := connCacheMap["aws-seoul"]; entry.refs != 1 {
+		t.Errorf("refs: %d, want 1", entry.refs)
+	}
+	lease2.Close()
+	if atomic.LoadInt32(&conn.closed) != 0 {
+		t.Errorf("the cached connection is closed by the lease")
+	}
+
+	// connection config, credential 등이 바뀌면 다시 연결 함.
+	lease3, _ := getCachedConnection("aws-seoul", "aws", "fp-2", nil, connector.connect)
+	if connector.count() != 2 {
+		t.Fatalf("connected %d times after the change, want 2", connector.count())
+	}
+	conn.waitClosed(t, 1)
+	lease3.Close()
+
+	// 드라이버가 바뀌어도 다시 연결 함.
+	lease4, _ := getCachedConnection("aws-seoul", "aws", "fp-2", &testDriver{}, connector.connect)
+	defer lease4.Close()
+	if connector.count() != 3 {
+		t.Fatalf("connected %d times after the driver change, want 3", connector.count())
+	}
+}
+
+func TestConnectionCacheConcurrent(t *testing.T) {
+	resetConnCache()
+	connector := &testConnector{duration: 20 * time.Millisecond}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			lease, err := getCachedConnection("aws-seoul", "aws", "fp-1", nil, connector.connect)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			lease.Close()
+		}()
+	}
+	wg.Wait()
+	if connector.count() != 1 {
+		t.Errorf("connected %d times, want 1", connector.count())
+	}
+}
+
+func TestConnectionCacheError(t *testing.T) {
+	resetConnCache()
+	connector := &testConnector{err: irs.Unavailable("503")}
+
+	if _, err := getCachedConnection("aws-seoul", "aws", "fp-1", nil, connector.connect); irs.ErrorCodeOf(err) != irs.UnavailableError {
+		t.Fatalf("connect error: %v", err)
+	}
+	// 실패한 연결은 캐시하지 않음.
+	connector.err = nil
+	lease, err := getCachedConnection("aws-seoul", "aws", "fp-1", nil, connector.connect)
+	if err != nil || connector.count() != 1 {
+		t.Fatalf("after the failed connect: %v, connected %d times", err, connector.count())
+	}
+	lease.Close()
+}
+
+func TestConnectionCacheInvalidate(t *testing.T) {
+	resetConnCache()
+	connector := &testConnector{}
+
+	lease, _ := getCachedConnection("aws-seoul", "aws", "fp-1", nil, connector.connect)
+	conn := connector.conns[0]
+	if !InvalidateCloudConnection("aws-seoul") || InvalidateCloudConnection("aws-seoul") {
+		t.Fatal("InvalidateCloudConnection of the cached connection")
+	}
+
+	// 사용 중인 connection은 마지막 사용자가 Close() 할 때 닫힘.
+	time.Sleep(10 * time.Millisecond)
+	if atomic.LoadInt32(&conn.closed) != 0 {
+		t.Fatal("the connection in use is closed")
+	}
+	lease.Close()
+	conn.waitClosed(t, 1)
+
+	lease, _ = getCachedConnection("aws-seoul", "aws", "fp-1", nil, connector.connect)
+	lease.Close()
+	if connector.count() != 2 {
+		t.Errorf("connected %d times after the invalidate, want 2", connector.count())
+	}
+
+	lease, _ = getCachedConnection("gcp-seoul", "gcp", "fp-1", nil, connector.connect)
+	lease.Close()
+	invalidateDriverConnections("aws")
+	connector.conns[1].waitClosed(t, 1)
+	if _, ok := connCacheMap["gcp-seoul"]; !ok {
+		t.Errorf("the connection of other driver is closed")
+	}
+	CloseCloudConnections()
+	connector.conns[2].waitClosed(t, 1)
+}
+
+func TestConnectionCacheIdle(t *testing.T) {
+	resetConnCache()
+	defer func(timeout time.Duration) { ConnectionIdleTimeout = timeout }(ConnectionIdleTimeout)
+	ConnectionIdleTimeout = 10 * time.Millisecond
+	connector := &testConnector{}
+
+	idle, _ := getCachedConnection("aws-seoul", "aws", "fp-1", nil, connector.connect)
+	idle.Close()
+	used, _ := getCachedConnection("gcp-seoul", "gcp", "fp-1", nil, connector.connect)
+	defer used.Close()
+
+	time.Sleep(20 * time.Millisecond)
+	evictIdleConnections()
+	connector.conns[0].waitClosed(t, 1)
+	if atomic.LoadInt32(&connector.conns[1].closed) != 0 {
+		t.Errorf("the connection in use is closed as idle")
+	}
+}
+
+func TestSameCloudDriver(t *testing.T) {
+	driver := &testDriver{}
+	cases := []struct {
+		a, b idrv.CloudDriver
+		same bool
+	}{
+		{nil, nil, true},
+		{driver, driver, true},
+		{driver, &testDriver{}, false},
+		{driver, nil, false},
+	}
+	for _, c := range cases {
+		if same := sameCloudDriver(c.a, c.b); same != c.same {
+			t.Errorf("sameCloudDriver(%v, %v): %v, want %v", c.a, c.b, same, c.same)
+		}
+	}
+}
@@ -0,0 +1,253 @@
+// Proof of Concepts of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is PoC of Cloud Driver discovery from a plugin directory.
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+package drivermanager
+
+import (
+	cblog "github.com/cloud-barista/cb-log"
+	"github.com/sirupsen/logrus"
+
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+var cblogger *logrus.Logger
+
+func init() {
+	cblogger = cblog.GetLogger("CloudDriverManager")
+}
+
+// Plugin directory, default: $CBSPIDER_PATH/cloud-driver-libs
+const DriverDirEnv = "CBSPIDER_DRIVER_DIR"
+
+// Result of loading a plugin file of the plugin directory.
+type DriverLoadResult struct {
+	FilePath      string
+	DriverName    string // file name without .so, ex) AwsDriver
+	DriverVersion string
+	Handlers      []string // supported handlers of DriverManifest
+	Removed       bool     // the file was removed and the driver was unregistered
+	Err           error    // nil: registered
+}
+
+type loadedPlugin struct {
+	modTime    time.Time
+	driverName string // "": failed to load
+}
+
+// key: plugin file path in the plugin directory
+var loadedPluginMap = map[string]loadedPlugin{}
+var loadedPluginMutex sync.Mutex
+
+func DriverDir() string {
+	if dir := os.Getenv(DriverDirEnv); dir != "" {
+		return dir
+	}
+	return filepath.Join(os.Getenv("CBSPIDER_PATH"), "cloud-driver-libs")
+}
+
+// StartDriverDiscovery registers every plugin(.so) of dir and watches dir every interval.
+// Load failures are logged per file, and do not stop other drivers from loading.
+func StartDriverDiscovery(dir string, interval time.Duration) (stop func(), err error) {
+	results, err := ScanDriverDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, result := range results {
+		logLoadResult(result)
+	}
+	return WatchDriverDir(dir, interval, logLoadResult), nil
+}
+
+// ScanDriverDir registers new or updated plugins of dir, and unregisters removed ones.
+// Unchanged files are skipped, so only changes are returned.
+// An updated plugin is loaded only if it shares no changed package with the host or the loaded plugins:
+// the Go runtime loads a package once, so plugin.Open fails with "plugin was built with a different version of package",
+// ex) an update that changed cloud-driver/interfaces or a dependency needs a restart of the host.
+func ScanDriverDir(dir string) ([]DriverLoadResult, error) {
+	if _, err := os.Stat(dir); err != nil {
+		return nil, err
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*.so"))
+	if err != nil {
+		return nil, err
+	}
+
+	loadedPluginMutex.Lock()
+	defer loadedPluginMutex.Unlock()
+
+	var results []DriverLoadResult
+	found := map[string]bool{}
+	for _, filePath := range files {
+		found[filePath] = true
+		fileInfo, err := os.Stat(filePath)
+		if err != nil {
+			results = append(results, DriverLoadResult{FilePath: filePath, Err: err})
+			continue
+		}
+		if loaded, ok := loadedPluginMap[filePath]; ok && loaded.modTime.Equal(fileInfo.ModTime()) {
+			continue
+		}
+		results = append(results, loadDriverFile(filePath, fileInfo.ModTime()))
+	}
+
+	for filePath, loaded := range loadedPluginMap {
+		if filepath.Dir(filePath) != filepath.Clean(dir) || found[filePath] {
+			continue
+		}
+		delete(loadedPluginMap, filePath)
+		if loaded.driverName != "" {
+			UnRegisterCloudDriver(loaded.driverName)
+			results = append(results, DriverLoadResult{FilePath: filePath, DriverName: loaded.driverName, Removed: true})
+		}
+	}
+
+	return results, nil
+}
+
+// WatchDriverDir scans dir every interval until stop is called, and reports changed files.
+func WatchDriverDir(dir string, interval time.Duration, report func(DriverLoadResult)) (stop func()) {
+	done := make(chan struct{})
+	ticker := time.NewTicker(interval)
+
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				results, err := ScanDriverDir(dir)
+				if err != nil {
+					report(DriverLoadResult{FilePath: dir, Err: err})
+					continue
+				}
+				for _, result := range results {
+					report(result)
+				}
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() { once.Do(func() { close(done) }) }
+}
+
+func loadDriverFile(filePath string, modTime time.Time) DriverLoadResult {
+	driverName := strings.TrimSuffix(filepath.Base(filePath), ".so")
+	result := DriverLoadResult{FilePath: filePath, DriverName: driverName}
+
+	// Go plugin은 언로드할 수 없고 같은 경로는 다시 열리지 않으므로, 갱신된 파일은 복사본을 엶.
+	// 이전 버전은 프로세스 메모리에 남음.
+	openPath := filePath
+	if _, ok := loadedPluginMap[filePath]; ok {
+		var err error
+		if openPath, err = copyPlugin(filePath, driverName, modTime); err != nil {
+			result.Err = err
+			loadedPluginMap[filePath] = loadedPlugin{modTime: modTime}
+			return result
+		}
+	}
+
+	cloudDriver, manifest, err := OpenDriverPlugin(openPath)
+	if err != nil {
+		result.Err = err
+		loadedPluginMap[filePath] = loadedPlugin{modTime: modTime}
+		return result
+	}
+
+	registerCloudDriver(CloudDriverInfo{manifest.DriverName, driverName, openPath, PluginDriver})
+	loadedPluginMap[filePath] = loadedPlugin{modTime: modTime, driverName: driverName}
+
+	result.DriverVersion = cloudDriver.GetDriverVersion()
+	result.Handlers = manifest.Handlers
+	return result
+}
+
+// Directory of the copies of updated plugins, only the user of the host can write it.
+var pluginCopyDir string
+
+// copyPlugin copies an updated plugin to a new file of pluginCopyDir, and checks the copy against the source.
+func copyPlugin(filePath string, driverName string, modTime time.Time) (string, error) {
+	if pluginCopyDir == "" {
+		dir, err := os.MkdirTemp("", "cb-spider-plugins-")
+		if err != nil {
+			return "", err
+		}
+		pluginCopyDir = dir
+	}
+
+	src, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+
+	// 예측할 수 없는 이름으로 생성 함(0600).
+	dst, err := os.CreateTemp(pluginCopyDir, driverName+"-*.so")
+	if err != nil {
+		return "", err
+	}
+	copyPath := dst.Name()
+	srcHash := sha256.New()
+	_, err = io.Copy(dst, io.TeeReader(src, srcHash))
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = verifyPluginCopy(filePath, modTime, copyPath, srcHash.Sum(nil))
+	}
+	if err != nil {
+		os.Remove(copyPath)
+		return "", err
+	}
+	return copyPath, nil
+}
+
+// The source must not be changed during the copy, and the copy must have the content of the source.
+func verifyPluginCopy(filePath string, modTime time.Time, copyPath string, srcSum []byte) error {
+	if fileInfo, err := os.Stat(filePath); err != nil {
+		return err
+	} else if !fileInfo.ModTime().Equal(modTime) {
+		return fmt.Errorf("%s was changed during the copy, retry at the next scan", filePath)
+	}
+
+	copied, err := os.Open(copyPath)
+	if err != nil {
+		return err
+	}
+	defer copied.Close()
+	copyHash := sha256.New()
+	if _, err := io.Copy(copyHash, copied); err != nil {
+		return err
+	}
+	if !bytes.Equal(copyHash.Sum(nil), srcSum) {
+		return fmt.Errorf("the copy %s of %s is different from the source", copyPath, filePath)
+	}
+	return nil
+}
+
+func logLoadResult(result DriverLoadResult) {
+	switch {
+	case result.Err != nil:
+		cblogger.Errorf("failed to load driver %s: %v", result.FilePath, result.Err)
+	case result.Removed:
+		cblogger.Infof("unregistered driver %s: %s was removed", result.DriverName, result.FilePath)
+	default:
+		cblogger.Infof("registered driver %s(%s) from %s, handlers: %v", result.DriverName, result.DriverVersion, result.FilePath, result.Handlers)
+	}
+}
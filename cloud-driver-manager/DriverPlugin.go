@@ -0,0 +1,106 @@
+// Proof of Concepts of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is PoC of Cloud Driver Plugin Loader.
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+package drivermanager
+
+import (
+	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
+
+	"fmt"
+	"plugin"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+// OpenDriverPlugin opens a driver plugin(.so) and checks its DriverManifest
+// against the host before type-asserting the TestDriver symbol.
+func OpenDriverPlugin(driverPath string) (idrv.CloudDriver, idrv.DriverManifest, error) {
+	plug, err := plugin.Open(driverPath)
+	if err != nil {
+		return nil, idrv.DriverManifest{}, pluginOpenError(driverPath, err)
+	}
+
+	manifestSymbol, err := plug.Lookup("DriverManifest")
+	if err != nil {
+		return nil, idrv.DriverManifest{}, fmt.Errorf("%s does not export DriverManifest: rebuild the driver with interface version %s ~ %s",
+			driverPath, idrv.MinInterfaceVersion, idrv.InterfaceVersion)
+	}
+	manifest, ok := manifestSymbol.(*idrv.DriverManifest)
+	if !ok {
+		return nil, idrv.DriverManifest{}, fmt.Errorf("%s: DriverManifest is %T, not idrv.DriverManifest", driverPath, manifestSymbol)
+	}
+
+	if err := CheckPluginManifest(*manifest); err != nil {
+		return nil, *manifest, fmt.Errorf("%s: %v", driverPath, err)
+	}
+
+	testDriver, err := plug.Lookup("TestDriver")
+	if err != nil {
+		return nil, *manifest, fmt.Errorf("plug.Lookup(%s): %v", driverPath, err)
+	}
+	cloudDriver, ok := testDriver.(idrv.CloudDriver)
+	if !ok {
+		return nil, *manifest, fmt.Errorf("%s: TestDriver(%T) does not implement idrv.CloudDriver of interface version %s",
+			driverPath, testDriver, idrv.InterfaceVersion)
+	}
+
+	return cloudDriver, *manifest, nil
+}
+
+// CheckPluginManifest checks the interface version, Go toolchain and shared module versions.
+// Go plugins must be built with the same toolchain and dependency versions as the host.
+func CheckPluginManifest(manifest idrv.DriverManifest) error {
+	if err := manifest.CheckInterfaceVersion(); err != nil {
+		return err
+	}
+	// 플러그인은 호스트와 interfaces 패키지를 공유하므로 이전 버전의 필드(ex. SubnetReqInfoList)를 무시할 수 없음.
+	if manifest.InterfaceVersion != idrv.InterfaceVersion {
+		return fmt.Errorf("driver %s is built with interface version %s, but a plugin must be built with %s: rebuild the driver with the current cloud-driver/interfaces",
+			manifest.DriverName, manifest.InterfaceVersion, idrv.InterfaceVersion)
+	}
+
+	if manifest.GoVersion != runtime.Version() {
+		return fmt.Errorf("driver %s is built with %s, but the host is built with %s: rebuild the driver with %s",
+			manifest.DriverName, manifest.GoVersion, runtime.Version(), runtime.Version())
+	}
+
+	var mismatches []string
+	for path, hostVersion := range idrv.ModuleVersions() {
+		if driverVersion, ok := manifest.ModuleVersions[path]; ok && driverVersion != hostVersion {
+			mismatches = append(mismatches, fmt.Sprintf("%s(driver: %s, host: %s)", path, driverVersion, hostVersion))
+		}
+	}
+	if len(mismatches) > 0 {
+		sort.Strings(mismatches)
+		return fmt.Errorf("driver %s is built with different module versions: %s: align go.mod of the driver with the host",
+			manifest.DriverName, strings.Join(mismatches, ", "))
+	}
+
+	return nil
+}
+
+// plugin.Open은 버전 불일치 시 패키지 이름만 알려주므로 원인과 조치 방법을 추가 함.
+func pluginOpenError(driverPath string, err error) error {
+	msg := err.Error()
+	idx := strings.Index(msg, "different version of package ")
+	if idx < 0 {
+		// plugin.Open 에러는 이미 경로를 포함 함.
+		return err
+	}
+
+	pkg := strings.TrimSpace(msg[idx+len("different version of package "):])
+	if strings.HasPrefix(pkg, "runtime") || !strings.Contains(strings.SplitN(pkg, "/", 2)[0], ".") {
+		return fmt.Errorf("%s is built with a different Go toolchain(package %s): rebuild the driver with %s",
+			driverPath, pkg, runtime.Version())
+	}
+	return fmt.Errorf("%s is built with a different version of package %s: rebuild the driver against the same source of %s as the host",
+		driverPath, pkg, pkg)
+}
@@ -0,0 +1,94 @@
+// Proof of Concepts of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is PoC of the handler middleware applied to every Cloud Connection.
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+package drivermanager
+
+import (
+	mw "github.com/cloud-barista/poc-cb-spider/cloud-driver/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"net/http"
+	"sync"
+)
+
+var middlewareMutex sync.RWMutex
+var retryInterceptor = mw.Retry(mw.DefaultRetryConfig)
+var rateLimiter = mw.NewRateLimiter(mw.DefaultRateLimitConfig)
+var circuitBreaker = mw.NewCircuitBreaker(mw.DefaultCircuitBreakerConfig)
+var metrics = mw.NewMetrics(prometheus.DefaultRegisterer, mw.DefaultLatencyBuckets)
+var auditor = mw.NewAuditor()
+var idempotency = mw.NewIdempotency(nil)
+var dryRunInterceptor = mw.DryRun()
+
+// SetRetryConfig changes the retry of transient errors(throttling, unreachable endpoint) of handler calls.
+func SetRetryConfig(config mw.RetryConfig) {
+	middlewareMutex.Lock()
+	defer middlewareMutex.Unlock()
+	retryInterceptor = mw.Retry(config)
+}
+
+// SetRateLimitConfig changes the rate limit of handler calls per connection config.
+func SetRateLimitConfig(config mw.RateLimitConfig) {
+	rateLimiter.SetConfig(config)
+}
+
+// SetCircuitBreakerConfig changes the circuit breaker of handler calls per connection config. Circuits are closed.
+func SetCircuitBreakerConfig(config mw.CircuitBreakerConfig) {
+	circuitBreaker.SetConfig(config)
+}
+
+// ListCircuitState returns the circuit breaker states of called connection configs, for dashboards.
+func ListCircuitState() []*mw.CircuitState {
+	return circuitBreaker.States()
+}
+
+func GetCircuitState(connectionName string) mw.CircuitState {
+	return circuitBreaker.State(connectionName)
+}
+
+// SetAuditSinks replaces the sinks of the audit records of calls that change CSP resources(Create*, Delete*, StartVM, ...).
+// The old sinks are closed. No sinks: no audit.
+func SetAuditSinks(sinks ...mw.AuditSink) {
+	for _, sink := range auditor.SetSinks(sinks...) {
+		if err := sink.Close(); err != nil {
+			cblogger.Error(err)
+		}
+	}
+}
+
+// AddAuditSink adds a sink of the audit records, ex) to forward them to a log server in addition to the audit log file.
+func AddAuditSink(sink mw.AuditSink) {
+	auditor.AddSink(sink)
+}
+
+// CloseAuditSinks closes the sinks at shutdown.
+func CloseAuditSinks() error {
+	return auditor.Close()
+}
+
+// SetIdempotencyStore replaces the store of the idempotency keys of create calls, ex) a FileIdempotencyStore kept between restarts.
+func SetIdempotencyStore(store mw.IdempotencyStore) {
+	idempotency.SetStore(store)
+}
+
+// MetricsHandler serves the metrics of handler calls and the Go runtime in the Prometheus format, ex) on /metrics
+func MetricsHandler() http.Handler {
+	return promhttp.Handler()
+}
+
+// 순서: span과 audit은 재시도를 포함 하고, 재시도 할 때마다 rate limit을 적용 하고, circuit breaker와 metrics는 드라이버 호출 하나 하나를 셈.
+// idempotency key로 반환된 자원은 생성된 것이 아니므로 audit 바깥에 둠. dry run은 드라이버 호출 대신 실행 되므로 가장 안쪽에 두며,
+// circuit breaker와 metrics는 dry run을 세지 않음.
+func handlerInterceptors() []mw.Interceptor {
+	middlewareMutex.RLock()
+	defer middlewareMutex.RUnlock()
+	return []mw.Interceptor{tracingInterceptor, idempotency.Intercept, auditor.Intercept, retryInterceptor, rateLimiter.Intercept, circuitBreaker.Intercept, metrics.Intercept, dryRunInterceptor}
+}
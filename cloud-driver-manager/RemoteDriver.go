@@ -0,0 +1,51 @@
+// Proof of Concepts of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is PoC of out-of-process(gRPC) Cloud Driver management.
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+package drivermanager
+
+import (
+	grpcdriver "github.com/cloud-barista/poc-cb-spider/cloud-driver/grpc-driver"
+
+	"sync"
+)
+
+// running driver servers, key: driver name
+var remoteDriverMap = map[string]*grpcdriver.RemoteDriver{}
+var remoteDriverMutex sync.Mutex
+
+// 드라이버 프로세스가 종료된 경우 다시 시작 함.
+func startRemoteDriver(cldDrvInfo CloudDriverInfo) (*grpcdriver.RemoteDriver, error) {
+	remoteDriverMutex.Lock()
+	defer remoteDriverMutex.Unlock()
+
+	if remoteDriver, ok := remoteDriverMap[cldDrvInfo.DriverName]; ok {
+		if remoteDriver.Exited() == nil {
+			return remoteDriver, nil
+		}
+		remoteDriver.Close()
+	}
+
+	remoteDriver, err := grpcdriver.StartDriver(cldDrvInfo.DriverPath)
+	if err != nil {
+		return nil, err
+	}
+	remoteDriverMap[cldDrvInfo.DriverName] = remoteDriver
+	return remoteDriver, nil
+}
+
+func stopRemoteDriver(driverName string) {
+	remoteDriverMutex.Lock()
+	defer remoteDriverMutex.Unlock()
+
+	if remoteDriver, ok := remoteDriverMap[driverName]; ok {
+		remoteDriver.Close()
+		delete(remoteDriverMap, driverName)
+	}
+}
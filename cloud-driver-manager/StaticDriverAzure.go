@@ -0,0 +1,19 @@
+//go:build static_azure || static_all
+// +build static_azure static_all
+
+// Proof of Concepts of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// Links the Azure Driver statically, ex) go build -tags static_azure
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+package drivermanager
+
+import (
+	// registers "azure" in init()
+	_ "github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/azure"
+)
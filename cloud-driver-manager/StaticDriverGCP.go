@@ -0,0 +1,19 @@
+//go:build static_gcp || static_all
+// +build static_gcp static_all
+
+// Proof of Concepts of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// Links the GCP Driver statically, ex) go build -tags static_gcp
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+package drivermanager
+
+import (
+	// registers "gcp" in init()
+	_ "github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/gcp"
+)
@@ -0,0 +1,117 @@
+// Proof of Concepts of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is PoC of the OpenTelemetry tracing of driver manager operations and handler calls.
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+package drivermanager
+
+import (
+	mw "github.com/cloud-barista/poc-cb-spider/cloud-driver/middleware"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Exporters of TracingConfig
+const (
+	TraceExporterOTLP   = "otlp"   // OTLP/gRPC to a collector, ex) Jaeger, OpenTelemetry Collector
+	TraceExporterStdout = "stdout" // JSON to stdout, for debugging
+)
+
+type TracingConfig struct {
+	Exporter    string  // TraceExporterOTLP, TraceExporterStdout
+	Endpoint    string  // OTLP collector, ex) "localhost:4317". "": $OTEL_EXPORTER_OTLP_ENDPOINT or localhost:4317
+	Insecure    bool    // OTLP without TLS
+	ServiceName string  // "": "cb-spider"
+	SampleRatio float64 // ratio of traced requests, <= 0 or >= 1: all
+}
+
+// 드라이버 매니저의 span. StartTracing() 전에는 아무 것도 하지 않음.
+var tracer = otel.Tracer(mw.TracerName)
+var tracingInterceptor = mw.Tracing()
+
+var tracingMutex sync.Mutex
+var tracerProvider *sdktrace.TracerProvider
+
+// StartTracing exports the spans of GetCloudConnection, TestCloudConnection and handler calls.
+// The spans of a request are children of the W3C traceparent of the request, and it is propagated to the CSP requests
+// of drivers that support it, ex) Cloudit. Call it once at server start, and StopTracing() at shutdown.
+func StartTracing(config TracingConfig) error {
+	tracingMutex.Lock()
+	defer tracingMutex.Unlock()
+
+	if tracerProvider != nil {
+		return fmt.Errorf("tracing is already started")
+	}
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch config.Exporter {
+	case TraceExporterOTLP:
+		var options []otlptracegrpc.Option
+		if config.Endpoint != "" {
+			options = append(options, otlptracegrpc.WithEndpoint(config.Endpoint))
+		}
+		if config.Insecure {
+			options = append(options, otlptracegrpc.WithInsecure())
+		}
+		// 연결은 비동기로 맺으므로 collector가 없어도 실패하지 않음.
+		exporter, err = otlptracegrpc.New(context.Background(), options...)
+	case TraceExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	default:
+		return fmt.Errorf("unknown trace exporter: %s", config.Exporter)
+	}
+	if err != nil {
+		return err
+	}
+
+	serviceName := config.ServiceName
+	if serviceName == "" {
+		serviceName = "cb-spider"
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(attribute.String("service.name", serviceName)))
+	if err != nil {
+		return err
+	}
+
+	sampler := sdktrace.AlwaysSample()
+	if config.SampleRatio > 0 && config.SampleRatio < 1 {
+		sampler = sdktrace.TraceIDRatioBased(config.SampleRatio)
+	}
+
+	tracerProvider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sampler)),
+	)
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	cblogger.Infof("tracing is started, exporter: %s %s", config.Exporter, config.Endpoint)
+	return nil
+}
+
+// StopTracing exports the remaining spans.
+func StopTracing(ctx context.Context) error {
+	tracingMutex.Lock()
+	defer tracingMutex.Unlock()
+
+	if tracerProvider == nil {
+		return nil
+	}
+	return tracerProvider.Shutdown(ctx)
+}
@@ -0,0 +1,99 @@
+// Proof of Concepts of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is PoC of Cloud Connection Config Manager.
+// A connection config names a (driver, credential, region) set, ex) aws-seoul-config
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+package connectionconfigmanager
+
+import (
+	cim "github.com/cloud-barista/poc-cb-spider/cloud-driver-manager/credential-info-manager"
+	rim "github.com/cloud-barista/poc-cb-spider/cloud-driver-manager/region-info-manager"
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+
+	"sync"
+)
+
+type ConnectionConfigInfo struct {
+	ConfigName     string
+	ProviderName   string
+	DriverName     string
+	CredentialName string
+	RegionName     string
+}
+
+// @todo save into storage
+var connectionConfigMap = map[string]ConnectionConfigInfo{}
+var connectionConfigMutex sync.RWMutex
+
+// RegisterConnectionConfig checks the credential and region exist and belong to the provider.
+// The driver is checked when connecting, because it can be registered later.
+func RegisterConnectionConfig(configName string, providerName string, driverName string, credentialName string, regionName string) (ConnectionConfigInfo, error) {
+	if configName == "" || providerName == "" || driverName == "" {
+		return ConnectionConfigInfo{}, irs.InvalidArgument("ConfigName, ProviderName and DriverName are required")
+	}
+
+	crdInfo, err := cim.GetCredential(credentialName)
+	if err != nil {
+		return ConnectionConfigInfo{}, err
+	}
+	if crdInfo.ProviderName != providerName {
+		return ConnectionConfigInfo{}, irs.InvalidArgument("credential %s is for %s, not %s", credentialName, crdInfo.ProviderName, providerName)
+	}
+
+	rgnInfo, err := rim.GetRegion(regionName)
+	if err != nil {
+		return ConnectionConfigInfo{}, err
+	}
+	if rgnInfo.ProviderName != providerName {
+		return ConnectionConfigInfo{}, irs.InvalidArgument("region %s is for %s, not %s", regionName, rgnInfo.ProviderName, providerName)
+	}
+
+	connConfig := ConnectionConfigInfo{configName, providerName, driverName, credentialName, regionName}
+
+	connectionConfigMutex.Lock()
+	connectionConfigMap[configName] = connConfig
+	connectionConfigMutex.Unlock()
+
+	return connConfig, nil
+}
+
+func ListConnectionConfig() []*ConnectionConfigInfo {
+	var connConfigList []*ConnectionConfigInfo
+
+	connectionConfigMutex.RLock()
+	defer connectionConfigMutex.RUnlock()
+	for _, connConfig := range connectionConfigMap {
+		info := connConfig
+		connConfigList = append(connConfigList, &info)
+	}
+
+	return connConfigList
+}
+
+func GetConnectionConfig(configName string) (ConnectionConfigInfo, error) {
+	connectionConfigMutex.RLock()
+	defer connectionConfigMutex.RUnlock()
+
+	connConfig, ok := connectionConfigMap[configName]
+	if !ok {
+		return ConnectionConfigInfo{}, irs.NotFound("%s: unregistered connection config", configName)
+	}
+	return connConfig, nil
+}
+
+func UnRegisterConnectionConfig(configName string) bool {
+	connectionConfigMutex.Lock()
+	defer connectionConfigMutex.Unlock()
+
+	if _, ok := connectionConfigMap[configName]; !ok {
+		return false
+	}
+	delete(connectionConfigMap, configName)
+	return true
+}
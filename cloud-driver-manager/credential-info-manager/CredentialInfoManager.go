@@ -4,10 +4,88 @@
 //
 //      * Cloud-Barista: https://github.com/cloud-barista
 //
-// This is PoC of Cloud Driver Manager.
+// This is PoC of Cloud Credential Info Manager.
 //
 // by powerkim@etri.re.kr, 2019.06.
 
+package credentialinfomanager
 
-//package drivermanager
-package main
\ No newline at end of file
+import (
+	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+
+	"sync"
+)
+
+type CredentialInfo struct {
+	CredentialName string
+	ProviderName   string // ex) AWS, AZURE, GCP, OPENSTACK, CLOUDIT
+	CredentialInfo idrv.CredentialInfo
+}
+
+// @todo save into storage
+var credentialInfoMap = map[string]CredentialInfo{}
+var credentialInfoMutex sync.RWMutex
+
+// RegisterCredential registers or replaces(ex: key rotation) a credential.
+func RegisterCredential(credentialName string, providerName string, credentialInfo idrv.CredentialInfo) (CredentialInfo, error) {
+	if credentialName == "" || providerName == "" {
+		return CredentialInfo{}, irs.InvalidArgument("CredentialName and ProviderName are required")
+	}
+
+	crdInfo := CredentialInfo{credentialName, providerName, credentialInfo}
+
+	credentialInfoMutex.Lock()
+	credentialInfoMap[credentialName] = crdInfo
+	credentialInfoMutex.Unlock()
+
+	return crdInfo, nil
+}
+
+func ListCredential() []*CredentialInfo {
+	var crdInfoList []*CredentialInfo
+
+	credentialInfoMutex.RLock()
+	defer credentialInfoMutex.RUnlock()
+	for _, crdInfo := range credentialInfoMap {
+		info := crdInfo
+		crdInfoList = append(crdInfoList, &info)
+	}
+
+	return crdInfoList
+}
+
+func GetCredential(credentialName string) (CredentialInfo, error) {
+	credentialInfoMutex.RLock()
+	defer credentialInfoMutex.RUnlock()
+
+	crdInfo, ok := credentialInfoMap[credentialName]
+	if !ok {
+		return CredentialInfo{}, irs.NotFound("%s: unregistered credential", credentialName)
+	}
+	return crdInfo, nil
+}
+
+func UnRegisterCredential(credentialName string) bool {
+	credentialInfoMutex.Lock()
+	defer credentialInfoMutex.Unlock()
+
+	if _, ok := credentialInfoMap[credentialName]; !ok {
+		return false
+	}
+	delete(credentialInfoMap, credentialName)
+	return true
+}
+
+// Masked returns a copy without secrets, for API responses and logs.
+func (crdInfo CredentialInfo) Masked() CredentialInfo {
+	mask := func(secret *string) {
+		if *secret != "" {
+			*secret = "****"
+		}
+	}
+	mask(&crdInfo.CredentialInfo.ClientSecret)
+	mask(&crdInfo.CredentialInfo.Password)
+	mask(&crdInfo.CredentialInfo.AuthToken)
+	return crdInfo
+}
@@ -0,0 +1,77 @@
+// Proof of Concepts of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is PoC of Cloud Region Info Manager.
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+package regioninfomanager
+
+import (
+	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+
+	"sync"
+)
+
+type RegionInfo struct {
+	RegionName   string
+	ProviderName string
+	RegionInfo   idrv.RegionInfo // ex) {ap-northeast-2, ap-northeast-2a, ""}
+}
+
+// @todo save into storage
+var regionInfoMap = map[string]RegionInfo{}
+var regionInfoMutex sync.RWMutex
+
+func RegisterRegion(regionName string, providerName string, regionInfo idrv.RegionInfo) (RegionInfo, error) {
+	if regionName == "" || providerName == "" {
+		return RegionInfo{}, irs.InvalidArgument("RegionName and ProviderName are required")
+	}
+
+	rgnInfo := RegionInfo{regionName, providerName, regionInfo}
+
+	regionInfoMutex.Lock()
+	regionInfoMap[regionName] = rgnInfo
+	regionInfoMutex.Unlock()
+
+	return rgnInfo, nil
+}
+
+func ListRegion() []*RegionInfo {
+	var rgnInfoList []*RegionInfo
+
+	regionInfoMutex.RLock()
+	defer regionInfoMutex.RUnlock()
+	for _, rgnInfo := range regionInfoMap {
+		info := rgnInfo
+		rgnInfoList = append(rgnInfoList, &info)
+	}
+
+	return rgnInfoList
+}
+
+func GetRegion(regionName string) (RegionInfo, error) {
+	regionInfoMutex.RLock()
+	defer regionInfoMutex.RUnlock()
+
+	rgnInfo, ok := regionInfoMap[regionName]
+	if !ok {
+		return RegionInfo{}, irs.NotFound("%s: unregistered region", regionName)
+	}
+	return rgnInfo, nil
+}
+
+func UnRegisterRegion(regionName string) bool {
+	regionInfoMutex.Lock()
+	defer regionInfoMutex.Unlock()
+
+	if _, ok := regionInfoMap[regionName]; !ok {
+		return false
+	}
+	delete(regionInfoMap, regionName)
+	return true
+}
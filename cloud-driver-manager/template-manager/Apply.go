@@ -0,0 +1,387 @@
+// Proof of Concepts of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is PoC of the Apply of Infrastructure Templates.
+// The steps of a plan are applied through the handlers of the connections, so they are retried, rate limited, audited, ...
+// and create calls are idempotent with the idempotency key of ctx, see cloud-driver-manager/HandlerMiddleware.go.
+//
+// by powerkim@etri.re.kr, 2019.08.
+
+package templatemanager
+
+import (
+	cblog "github.com/cloud-barista/cb-log"
+	dim "github.com/cloud-barista/poc-cb-spider/cloud-driver-manager"
+	icon "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/connect"
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+	"github.com/sirupsen/logrus"
+
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+var cblogger *logrus.Logger
+
+func init() {
+	cblogger = cblog.GetLogger("TemplateManager")
+}
+
+// Apply creates and updates the resources of template, and returns the applied plan.
+// It stops at the first failed step, and deletes the resources created before in the reverse order(plan.Rollback).
+// Updates and deletes are not reverted. If the rollback fails, the workflow(plan.WorkflowId) is resumed or rolled back later.
+func Apply(ctx context.Context, template *Template) (*Plan, error) {
+	plan, run, err := prepareApply(ctx, template, false)
+	return runWorkflow(ctx, plan, run, err)
+}
+
+// Destroy deletes the resources of template, and returns the applied plan.
+func Destroy(ctx context.Context, template *Template) (*Plan, error) {
+	plan, run, err := prepareApply(ctx, template, true)
+	return runWorkflow(ctx, plan, run, err)
+}
+
+// StartApply is Apply in the background, it returns the plan when the steps start, see startWorkflow().
+func StartApply(ctx context.Context, template *Template) (*Plan, error) {
+	plan, run, err := prepareApply(ctx, template, false)
+	return startWorkflow(ctx, plan, run, err)
+}
+
+// StartDestroy is Destroy in the background, it returns the plan when the steps start, see startWorkflow().
+func StartDestroy(ctx context.Context, template *Template) (*Plan, error) {
+	plan, run, err := prepareApply(ctx, template, true)
+	return startWorkflow(ctx, plan, run, err)
+}
+
+// prepareApply makes the plan and the workflow of an apply or destroy, run is nil if the plan has no changes.
+func prepareApply(ctx context.Context, template *Template, destroy bool) (*Plan, workflowFunc, error) {
+	if err := lockTemplate(template.Name); err != nil {
+		return nil, nil, err
+	}
+
+	plan, err := makePlan(ctx, template, destroy)
+	if err != nil {
+		unlockTemplate(template.Name)
+		return nil, nil, err
+	}
+	if !plan.HasChanges() {
+		unlockTemplate(template.Name)
+		return plan, nil, nil
+	}
+
+	run, err := newWorkflowRun(template, destroy)
+	if err != nil {
+		unlockTemplate(template.Name)
+		return nil, nil, err
+	}
+	plan.WorkflowId = run.workflow.Id
+	return plan, func(ctx context.Context) (err error) {
+		defer unlockTemplate(template.Name)
+		defer run.recoverPanic(&err)
+		return run.run(ctx, plan)
+	}, nil
+}
+
+var templateMutex sync.Mutex
+var templatesInProgress = map[string]bool{}
+
+// lockTemplate returns Unavailable if template is being applied, to be retried later.
+func lockTemplate(templateName string) error {
+	templateMutex.Lock()
+	defer templateMutex.Unlock()
+	if templatesInProgress[templateName] {
+		return irs.NewCloudError(irs.UnavailableError, "template %s is being applied", templateName)
+	}
+	templatesInProgress[templateName] = true
+	return nil
+}
+
+func unlockTemplate(templateName string) {
+	templateMutex.Lock()
+	defer templateMutex.Unlock()
+	delete(templatesInProgress, templateName)
+}
+
+func (plan *Plan) apply(ctx context.Context, run *workflowRun) error {
+	var applyErr error
+	for _, connTemplate := range plan.template.Connections {
+		var steps []*Step
+		for _, step := range plan.Steps {
+			if step.ConnectionName == connTemplate.ConnectionName && step.Action != NoChangeAction {
+				steps = append(steps, step)
+			}
+		}
+		if len(steps) == 0 {
+			continue
+		}
+		if applyErr != nil {
+			skipSteps(steps)
+			continue
+		}
+		applyErr = plan.applyConnection(ctx, run, connTemplate, steps)
+	}
+	return applyErr
+}
+
+func skipSteps(steps []*Step) {
+	for _, step := range steps {
+		step.Status = StepSkipped
+	}
+}
+
+func failStep(steps []*Step, i int, err error) error {
+	step := steps[i]
+	step.Status, step.Error = StepFailed, err.Error()
+	skipSteps(steps[i+1:])
+	cblogger.Errorf("%s %s %s %s: %v", step.ConnectionName, step.Kind, step.Name, step.Action, err)
+	return irs.NewCloudError(irs.ErrorCodeOf(err), "%s %s %s: %s: %v", step.ConnectionName, step.Kind, step.Name, step.Action, err)
+}
+
+func (plan *Plan) applyConnection(ctx context.Context, run *workflowRun, connTemplate *ConnectionTemplate, steps []*Step) error {
+	cloudConnection, err := dim.GetCloudConnectionContext(ctx, connTemplate.ConnectionName)
+	if err != nil {
+		return failStep(steps, 0, err)
+	}
+	defer cloudConnection.Close()
+
+	h, err := newHandlers(cloudConnection, connTemplate)
+	if err != nil {
+		return failStep(steps, 0, err)
+	}
+
+	a := &applier{cloudConnection: cloudConnection, handlers: h, template: connTemplate,
+		resources: plan.resources[connTemplate.ConnectionName], run: run}
+	for i, step := range steps {
+		if err := a.applyStep(ctx, step); err != nil {
+			return failStep(steps, i, err)
+		}
+		step.Status = StepDone
+		cblogger.Infof("%s %s %s %s: %s", step.ConnectionName, step.Kind, step.Name, step.Action, step.Id)
+	}
+	return nil
+}
+
+// applier applies the steps of a connection, resources are updated with the created resources to resolve references.
+type applier struct {
+	cloudConnection icon.CloudConnection
+	handlers        *handlers
+	template        *ConnectionTemplate
+	resources       map[string]interface{}
+	run             *workflowRun     // records the created resources in the journal
+	state           *connectionState // loaded by lookup() of a rollback
+}
+
+func (a *applier) applyStep(ctx context.Context, step *Step) error {
+	switch step.Action {
+	case CreateAction:
+		if err := a.run.creating(step); err != nil {
+			return err
+		}
+		result, err := a.create(step)
+		if err != nil {
+			a.run.createFailed(step, err)
+			return err
+		}
+		a.run.created(step)
+		step.Result = result
+		a.resources[resourceKey(step.Kind, step.Name)] = result
+	case UpdateAction:
+		result, err := a.updateVM(step)
+		if err != nil {
+			return err
+		}
+		step.Result = result
+		a.resources[resourceKey(step.Kind, step.Name)] = result
+	case DeleteAction:
+		if err := a.delete(ctx, step); err != nil {
+			return err
+		}
+		delete(a.resources, resourceKey(step.Kind, step.Name))
+	}
+	return nil
+}
+
+func (a *applier) create(step *Step) (interface{}, error) {
+	switch template := step.template.(type) {
+	case *VNetworkTemplate:
+		vNetworkInfo, err := a.handlers.vNetwork.CreateVNetwork(irs.VNetworkReqInfo{Name: template.Name, Id: template.Id,
+			SubnetReqInfoList: template.Subnets})
+		step.Id = vNetworkInfo.Id
+		return vNetworkInfo, err
+	case *SecurityTemplate:
+		securityInfo, err := a.handlers.security.CreateSecurity(irs.SecurityReqInfo{
+			Name:                template.Name,
+			Id:                  template.Id,
+			GroupName:           template.Name,
+			Description:         template.Description,
+			VpcId:               a.vNetworkInfo(template.VNetwork).Id,
+			IPPermissions:       template.Inbound,
+			IPPermissionsEgress: template.Outbound,
+		})
+		step.Id = securityInfo.Id
+		return securityInfo, err
+	case *KeyPairTemplate:
+		keyPairInfo, err := a.handlers.keyPair.CreateKey(irs.KeyPairReqInfo{Name: template.Name, Id: template.Id})
+		step.Id = keyPairInfo.Id
+		return keyPairInfo, err
+	case *PublicIPTemplate:
+		publicIPInfo, err := a.handlers.publicIP.CreatePublicIP(irs.PublicIPReqInfo{Name: template.Name, Id: template.Id})
+		step.Id = publicIPInfo.Id
+		return publicIPInfo, err
+	case *VMTemplate:
+		return a.startVM(step, template)
+	}
+	return nil, irs.NotSupported("%s %s: no create", step.Kind, step.Name)
+}
+
+func (a *applier) startVM(step *Step, vmTemplate *VMTemplate) (interface{}, error) {
+	publicIPInfo := a.publicIPInfo(vmTemplate.PublicIP)
+	vmInfo, err := a.handlers.vm.StartVM(irs.VMReqInfo{
+		Name:         step.Name,
+		ImageInfo:    irs.ImageInfo{Id: vmTemplate.ImageID},
+		VNetworkInfo: a.vNetworkInfo(vmTemplate.VNetwork),
+		SecurityInfo: a.securityInfo(vmTemplate.SecurityGroup),
+		KeyPairInfo:  a.keyPairInfo(vmTemplate.KeyPair),
+		SpecID:       vmTemplate.SpecID,
+		PublicIPInfo: publicIPInfo,
+		LoginInfo:    vmTemplate.LoginInfo,
+	})
+	if err != nil {
+		return nil, err
+	}
+	step.Id = vmInfo.Id
+
+	// StartVM에서 PublicIPInfo를 연결하지 않는 드라이버는 생성 후 연결 함.
+	if templateHas(a.template, PublicIPKind, vmTemplate.PublicIP) && !associated(publicIPInfo, vmInfo) {
+		if err := a.associatePublicIP(publicIPInfo, vmInfo.Id); err != nil {
+			return vmInfo, err
+		}
+		vmInfo = a.handlers.vm.GetVM(vmInfo.Id)
+	}
+	return vmInfo, nil
+}
+
+func (a *applier) updateVM(step *Step) (interface{}, error) {
+	vmTemplate := step.template.(*VMTemplate)
+	if step.changeSpec {
+		if _, err := a.handlers.vm.ChangeVMSpec(step.Id, vmTemplate.SpecID); err != nil {
+			return nil, err
+		}
+	}
+	if step.associateIP {
+		if err := a.associatePublicIP(a.publicIPInfo(vmTemplate.PublicIP), step.Id); err != nil {
+			return nil, err
+		}
+	}
+	return a.handlers.vm.GetVM(step.Id), nil
+}
+
+func (a *applier) associatePublicIP(publicIPInfo irs.PublicIPInfo, vmID string) error {
+	associated, err := a.handlers.publicIP.AssociatePublicIP(publicIPInfo.Id, vmID)
+	if err == nil && !associated {
+		err = irs.NewCloudError(irs.UnknownError, "public IP %s is not associated with %s", publicIPInfo.Id, vmID)
+	}
+	return err
+}
+
+// delete deletes the resource of step. A VM is deleted when it is terminated,
+// so the resources used by the VM are deleted after it.
+func (a *applier) delete(ctx context.Context, step *Step) error {
+	var deleted bool
+	var err error
+	switch step.Kind {
+	case VMKind:
+		// TerminateVM()은 에러를 반환하지 않음.
+		a.handlers.vm.TerminateVM(step.Id)
+		return a.waitVMTerminated(ctx, step.Id)
+	case PublicIPKind:
+		deleted, err = a.handlers.publicIP.DeletePublicIP(step.Id)
+	case KeyPairKind:
+		deleted, err = a.handlers.keyPair.DeleteKey(step.Id)
+	case SecurityKind:
+		deleted, err = a.handlers.security.DeleteSecurity(step.Id)
+	case VNetworkKind:
+		deleted, err = a.handlers.vNetwork.DeleteVNetwork(step.Id)
+	}
+	if err == nil && !deleted {
+		err = irs.NewCloudError(irs.UnknownError, "%s %s is not deleted", step.Kind, step.Id)
+	}
+	return err
+}
+
+// VMTerminateTimeout is the max wait time of a VM to be terminated.
+var VMTerminateTimeout = 10 * time.Minute
+
+// VMStatusPollInterval is the interval of GetVMStatus() while waiting for a VM to be terminated.
+var VMStatusPollInterval = 5 * time.Second
+
+const vmTerminated irs.VMStatus = "TERMINATED"
+
+// waitVMTerminated polls the status of the VM until it is terminated or does not exist.
+func (a *applier) waitVMTerminated(ctx context.Context, vmID string) error {
+	ctx, cancel := context.WithTimeout(ctx, VMTerminateTimeout)
+	defer cancel()
+	for {
+		vmStatus, err := a.vmStatus(vmID)
+		if err != nil || vmStatus == "" || vmStatus == vmTerminated {
+			// 조회 실패: 삭제된 VM
+			return nil
+		}
+		select {
+		case <-time.After(VMStatusPollInterval):
+		case <-ctx.Done():
+			if ctx.Err() == context.DeadlineExceeded {
+				return irs.NewCloudError(irs.TimeoutError, "VM %s is not terminated in %v: %s", vmID, VMTerminateTimeout, vmStatus)
+			}
+			return ctx.Err()
+		}
+	}
+}
+
+// vmStatus returns the status of the VM, some drivers panic if the VM does not exist.
+func (a *applier) vmStatus(vmID string) (vmStatus irs.VMStatus, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("GetVMStatus(%s): %v", vmID, r)
+		}
+	}()
+	return a.handlers.vm.GetVMStatus(vmID), nil
+}
+
+// A reference is the name of a resource of the template, or the ID of an existing resource.
+
+func (a *applier) vNetworkInfo(ref string) irs.VNetworkInfo {
+	if vNetworkInfo, ok := a.resources[resourceKey(VNetworkKind, ref)].(irs.VNetworkInfo); ok && templateHas(a.template, VNetworkKind, ref) {
+		return vNetworkInfo
+	}
+	return irs.VNetworkInfo{Id: ref}
+}
+
+func (a *applier) securityInfo(ref string) irs.SecurityInfo {
+	if securityInfo, ok := a.resources[resourceKey(SecurityKind, ref)].(irs.SecurityInfo); ok && templateHas(a.template, SecurityKind, ref) {
+		return securityInfo
+	}
+	return irs.SecurityInfo{Id: ref}
+}
+
+// AWS는 키 페어 이름으로 VM을 생성 함.
+func (a *applier) keyPairInfo(ref string) irs.KeyPairInfo {
+	if keyPairInfo, ok := a.resources[resourceKey(KeyPairKind, ref)].(irs.KeyPairInfo); ok && templateHas(a.template, KeyPairKind, ref) {
+		if keyPairInfo.Name == "" {
+			keyPairInfo.Name = ref
+		}
+		return keyPairInfo
+	}
+	return irs.KeyPairInfo{Name: ref, Id: ref}
+}
+
+func (a *applier) publicIPInfo(ref string) irs.PublicIPInfo {
+	if publicIPInfo, ok := a.resources[resourceKey(PublicIPKind, ref)].(irs.PublicIPInfo); ok && templateHas(a.template, PublicIPKind, ref) {
+		return publicIPInfo
+	}
+	return irs.PublicIPInfo{Id: ref}
+}
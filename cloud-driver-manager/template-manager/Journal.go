@@ -0,0 +1,194 @@
+// Proof of Concepts of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is PoC of the Journal of Provisioning Workflows.
+// A workflow is an apply or destroy of a template. The journal records the resources created by each workflow,
+// so they are deleted in the reverse order when a step fails, or after a crash of the server.
+//
+// by powerkim@etri.re.kr, 2019.08.
+
+package templatemanager
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// States of workflows.
+const (
+	WorkflowRunning     = "Running"
+	WorkflowDone        = "Done"
+	WorkflowFailed      = "Failed"      // a step or the rollback failed, to be resumed or rolled back
+	WorkflowRolledBack  = "RolledBack"  // a step failed and the created resources are deleted
+	WorkflowInterrupted = "Interrupted" // the server stopped while Running, to be resumed or rolled back
+)
+
+// States of journal entries.
+const (
+	ResourceCreating = "Creating" // the create is in progress, or its outcome is unknown(ex. timeout)
+	ResourceCreated  = "Created"
+	ResourceDeleted  = "Deleted" // rolled back
+)
+
+// JournalEntry is a resource created by a workflow.
+type JournalEntry struct {
+	ConnectionName string
+	Kind           string
+	Name           string
+	Id             string `json:",omitempty"` // "" while Creating
+	State          string
+}
+
+// Workflow is an apply or destroy of a template, and the resources it created in the order of creation.
+type Workflow struct {
+	Id           string
+	TemplateName string
+	Destroy      bool
+	Template     *Template // to resume, secrets(ex. LoginInfo) are only in the journal
+	State        string
+	Error        string `json:",omitempty"`
+	Resources    []*JournalEntry
+	StartTime    time.Time
+	UpdateTime   time.Time
+}
+
+// Finished reports whether the workflow has nothing to resume or roll back.
+func (workflow *Workflow) Finished() bool {
+	return workflow.State == WorkflowDone || workflow.State == WorkflowRolledBack
+}
+
+// Journal stores workflows by ID, ex) FileJournal.
+type Journal interface {
+	Get(id string) (*Workflow, error) // nil, nil if none
+	List() ([]*Workflow, error)       // in the order of start
+	Put(workflow *Workflow) error
+}
+
+// DefaultJournalTTL is how long finished workflows are kept, unfinished ones are kept until they are resumed or rolled back.
+const DefaultJournalTTL = 7 * 24 * time.Hour
+
+// FileJournal keeps workflows in memory, and in a JSON file if it has a path, so they survive crashes of the server.
+type FileJournal struct {
+	mutex     sync.Mutex
+	path      string // "": memory only
+	ttl       time.Duration
+	workflows map[string]*Workflow
+}
+
+// NewFileJournal loads the workflows of path, the workflows Running when the server stopped are Interrupted.
+// The file and its directory are created for the owner only, templates have secrets.
+func NewFileJournal(path string, ttl time.Duration) (*FileJournal, error) {
+	journal := &FileJournal{path: path, ttl: ttl, workflows: map[string]*Workflow{}}
+	if path == "" {
+		return journal, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return journal, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var workflows []*Workflow
+	if err := json.Unmarshal(data, &workflows); err != nil {
+		return nil, err
+	}
+	for _, workflow := range workflows {
+		if workflow.State == WorkflowRunning {
+			workflow.State = WorkflowInterrupted
+		}
+		journal.workflows[workflow.Id] = workflow
+	}
+	journal.expire()
+	return journal, nil
+}
+
+func (journal *FileJournal) Get(id string) (*Workflow, error) {
+	journal.mutex.Lock()
+	defer journal.mutex.Unlock()
+
+	workflow, ok := journal.workflows[id]
+	if !ok {
+		return nil, nil
+	}
+	return copyWorkflow(workflow)
+}
+
+func (journal *FileJournal) List() ([]*Workflow, error) {
+	journal.mutex.Lock()
+	defer journal.mutex.Unlock()
+
+	journal.expire()
+	var workflows []*Workflow
+	for _, workflow := range journal.workflows {
+		copied, err := copyWorkflow(workflow)
+		if err != nil {
+			return nil, err
+		}
+		workflows = append(workflows, copied)
+	}
+	sort.Slice(workflows, func(i, j int) bool { return workflows[i].StartTime.Before(workflows[j].StartTime) })
+	return workflows, nil
+}
+
+func (journal *FileJournal) Put(workflow *Workflow) error {
+	journal.mutex.Lock()
+	defer journal.mutex.Unlock()
+
+	copied, err := copyWorkflow(workflow)
+	if err != nil {
+		return err
+	}
+	journal.workflows[workflow.Id] = copied
+	journal.expire()
+	return journal.save()
+}
+
+// copyWorkflow returns a deep copy, the caller keeps changing the entries of its workflow.
+func copyWorkflow(workflow *Workflow) (*Workflow, error) {
+	data, err := json.Marshal(workflow)
+	if err != nil {
+		return nil, err
+	}
+	copied := &Workflow{}
+	return copied, json.Unmarshal(data, copied)
+}
+
+func (journal *FileJournal) expire() {
+	for id, workflow := range journal.workflows {
+		if journal.ttl > 0 && workflow.Finished() && time.Since(workflow.UpdateTime) > journal.ttl {
+			delete(journal.workflows, id)
+		}
+	}
+}
+
+// save writes a temporary file and renames it, so the file is never partially written.
+func (journal *FileJournal) save() error {
+	if journal.path == "" {
+		return nil
+	}
+	workflows := make([]*Workflow, 0, len(journal.workflows))
+	for _, workflow := range journal.workflows {
+		workflows = append(workflows, workflow)
+	}
+	data, err := json.Marshal(workflows)
+	if err != nil {
+		return err
+	}
+	tmpPath := journal.path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, journal.path)
+}
@@ -0,0 +1,140 @@
+// Proof of Concepts of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the test of the journal of provisioning workflows.
+//
+// by powerkim@etri.re.kr, 2019.08.
+
+package templatemanager
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func testWorkflow(id string, state string, start time.Time) *Workflow {
+	return &Workflow{Id: id, TemplateName: "web", Template: testTemplate(), State: state,
+		Resources:  []*JournalEntry{{ConnectionName: "aws-seoul", Kind: VNetworkKind, Name: "web-vnet", Id: "vpc-1", State: ResourceCreated}},
+		StartTime:  start,
+		UpdateTime: start,
+	}
+}
+
+func TestFileJournal(t *testing.T) {
+	journal, err := NewFileJournal("", DefaultJournalTTL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if workflow, err := journal.Get("wf-1"); workflow != nil || err != nil {
+		t.Fatalf("Get of an empty journal: %v, %v", workflow, err)
+	}
+
+	now := time.Now()
+	workflow := testWorkflow("wf-2", WorkflowRunning, now)
+	if err := journal.Put(workflow); err != nil {
+		t.Fatal(err)
+	}
+	journal.Put(testWorkflow("wf-1", WorkflowDone, now.Add(-time.Minute)))
+
+	// 저장된 workflow는 복사본 임.
+	workflow.Resources[0].State = ResourceDeleted
+	workflow.Resources = append(workflow.Resources, &JournalEntry{Kind: VMKind, Name: "web-1", State: ResourceCreating})
+	got, err := journal.Get("wf-2")
+	if err != nil || got == nil || len(got.Resources) != 1 || got.Resources[0].State != ResourceCreated {
+		t.Fatalf("Get: %+v, %v", got, err)
+	}
+	got.State = WorkflowFailed
+	if again, _ := journal.Get("wf-2"); again.State != WorkflowRunning {
+		t.Errorf("the workflow in the journal is modified by the caller: %+v", again)
+	}
+
+	workflows, err := journal.List()
+	if err != nil || len(workflows) != 2 || workflows[0].Id != "wf-1" || workflows[1].Id != "wf-2" {
+		t.Fatalf("List: %v, %v, want in the order of start", workflows, err)
+	}
+}
+
+func TestFileJournalExpire(t *testing.T) {
+	journal, _ := NewFileJournal("", time.Hour)
+	old := time.Now().Add(-2 * time.Hour)
+
+	// 끝난 workflow만 TTL 후 제거 됨.
+	for _, workflow := range []*Workflow{
+		testWorkflow("done", WorkflowDone, old),
+		testWorkflow("rolled-back", WorkflowRolledBack, old),
+		testWorkflow("failed", WorkflowFailed, old),
+		testWorkflow("interrupted", WorkflowInterrupted, old),
+		testWorkflow("recent", WorkflowDone, time.Now()),
+	} {
+		journal.Put(workflow)
+	}
+	workflows, _ := journal.List()
+	ids := map[string]bool{}
+	for _, workflow := range workflows {
+		ids[workflow.Id] = true
+	}
+	if len(ids) != 3 || !ids["failed"] || !ids["interrupted"] || !ids["recent"] {
+		t.Errorf("after expire: %v, want failed, interrupted and recent", ids)
+	}
+}
+
+func TestFileJournalFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "journal-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "config", "workflows.json")
+
+	journal, err := NewFileJournal(path, DefaultJournalTTL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	journal.Put(testWorkflow("wf-1", WorkflowDone, now.Add(-time.Minute)))
+	running := testWorkflow("wf-2", WorkflowRunning, now)
+	running.Template.Connections[0].VMs[0].LoginInfo.AdminPassword = "cb-password!"
+	if err := journal.Put(running); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, mode := range map[string]os.FileMode{path: 0600, filepath.Dir(path): 0700 | os.ModeDir} {
+		if info, err := os.Stat(name); err != nil {
+			t.Error(err)
+		} else if info.Mode() != mode {
+			t.Errorf("%s: %v, want %v", name, info.Mode(), mode)
+		}
+	}
+	if _, err := os.Stat(path + ".tmp"); !os.IsNotExist(err) {
+		t.Errorf("temporary file is left: %v", err)
+	}
+
+	// 서버가 멈출 때 Running 이었던 workflow는 Interrupted 임.
+	reloaded, err := NewFileJournal(path, DefaultJournalTTL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	workflow, _ := reloaded.Get("wf-2")
+	if workflow == nil || workflow.State != WorkflowInterrupted || len(workflow.Resources) != 1 || workflow.Resources[0].Id != "vpc-1" {
+		t.Fatalf("reloaded running workflow: %+v", workflow)
+	}
+	if workflow.Template.Connections[0].VMs[0].LoginInfo.AdminPassword != "cb-password!" {
+		t.Errorf("the template to resume is not reloaded: %+v", workflow.Template)
+	}
+	if workflow, _ := reloaded.Get("wf-1"); workflow == nil || workflow.State != WorkflowDone {
+		t.Errorf("reloaded done workflow: %+v", workflow)
+	}
+
+	if err := ioutil.WriteFile(path, []byte("["), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewFileJournal(path, DefaultJournalTTL); err == nil {
+		t.Errorf("a broken file is loaded")
+	}
+}
@@ -0,0 +1,438 @@
+// Proof of Concepts of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is PoC of the Plan of Infrastructure Templates.
+// A plan is the steps(create, update, delete) from the current resources of the CSPs(List* of the handlers) to a template.
+//
+// by powerkim@etri.re.kr, 2019.08.
+
+package templatemanager
+
+import (
+	dim "github.com/cloud-barista/poc-cb-spider/cloud-driver-manager"
+	icon "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/connect"
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Actions of plan steps.
+const (
+	CreateAction   = "Create"
+	UpdateAction   = "Update" // VM: ChangeVMSpec, AssociatePublicIP
+	DeleteAction   = "Delete"
+	NoChangeAction = "NoChange"
+)
+
+// Status of applied steps.
+const (
+	StepDone    = "Done"
+	StepFailed  = "Failed"
+	StepSkipped = "Skipped" // a previous step failed
+)
+
+// Step is an action on a resource of a template.
+type Step struct {
+	ConnectionName string
+	Kind           string
+	Name           string
+	Action         string
+	Id             string      `json:",omitempty"` // ID of the current resource, or of the created one after apply
+	Changes        []string    `json:",omitempty"` // ex) SpecID: t2.micro -> t2.small
+	Warnings       []string    `json:",omitempty"` // differences the handlers can not update, ex) the rules of a security group
+	Status         string      `json:",omitempty"` // after apply
+	Error          string      `json:",omitempty"`
+	Result         interface{} `json:",omitempty"` // the created or updated resource, ex) KeyPairInfo with the private key
+
+	template    interface{} // *VNetworkTemplate, *SecurityTemplate, ...
+	changeSpec  bool
+	associateIP bool
+}
+
+// Plan is the steps of a template in the order to apply, steps of a connection are in the order of dependency.
+// Apply: delete VMs beyond the Count of their templates, then create and update. Destroy: delete in the reverse order.
+type Plan struct {
+	TemplateName string
+	Destroy      bool
+	Steps        []*Step
+	WorkflowId   string  `json:",omitempty"` // the journal of the apply, see Journal.go
+	Rollback     []*Step `json:",omitempty"` // the created resources deleted after a failed step
+
+	template  *Template
+	resources map[string]map[string]interface{} // connection name -> kind/name -> {Resource}Info
+}
+
+// HasChanges reports whether the plan has a step to apply.
+func (plan *Plan) HasChanges() bool {
+	for _, step := range plan.Steps {
+		if step.Action != NoChangeAction {
+			return true
+		}
+	}
+	return false
+}
+
+// copy returns a copy of the plan and its steps, to be read while the plan is applied.
+func (plan *Plan) copy() *Plan {
+	copied := *plan
+	copied.Steps = nil
+	for _, step := range plan.Steps {
+		copiedStep := *step
+		copied.Steps = append(copied.Steps, &copiedStep)
+	}
+	return &copied
+}
+
+// MakePlan returns the plan to create and update the resources of template.
+func MakePlan(ctx context.Context, template *Template) (*Plan, error) {
+	return makePlan(ctx, template, false)
+}
+
+// MakeDestroyPlan returns the plan to delete the resources of template.
+func MakeDestroyPlan(ctx context.Context, template *Template) (*Plan, error) {
+	return makePlan(ctx, template, true)
+}
+
+func makePlan(ctx context.Context, template *Template, destroy bool) (*Plan, error) {
+	if err := template.Validate(); err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{TemplateName: template.Name, Destroy: destroy, template: template, resources: map[string]map[string]interface{}{}}
+	for _, connTemplate := range template.Connections {
+		state, err := loadState(ctx, connTemplate)
+		if err != nil {
+			return nil, err
+		}
+		plan.resources[connTemplate.ConnectionName] = state.resources
+		if destroy {
+			plan.Steps = append(plan.Steps, state.destroySteps()...)
+		} else {
+			plan.Steps = append(plan.Steps, state.applySteps()...)
+		}
+	}
+	return plan, nil
+}
+
+func resourceKey(kind string, name string) string {
+	return kind + "/" + name
+}
+
+// handlers of the kinds of a connection template, nil if the template has none.
+type handlers struct {
+	vNetwork irs.VNetworkHandler
+	security irs.SecurityHandler
+	keyPair  irs.KeyPairHandler
+	publicIP irs.PublicIPHandler
+	vm       irs.VMHandler
+}
+
+func newHandlers(cloudConnection icon.CloudConnection, connTemplate *ConnectionTemplate) (*handlers, error) {
+	h := &handlers{}
+	var err error
+	if len(connTemplate.VNetworks) > 0 {
+		if h.vNetwork, err = cloudConnection.CreateVNetworkHandler(); err != nil || h.vNetwork == nil {
+			return nil, checkHandler("VNetworkHandler", err)
+		}
+	}
+	if len(connTemplate.SecurityGroups) > 0 {
+		if h.security, err = cloudConnection.CreateSecurityHandler(); err != nil || h.security == nil {
+			return nil, checkHandler("SecurityHandler", err)
+		}
+	}
+	if len(connTemplate.KeyPairs) > 0 {
+		if h.keyPair, err = cloudConnection.CreateKeyPairHandler(); err != nil || h.keyPair == nil {
+			return nil, checkHandler("KeyPairHandler", err)
+		}
+	}
+	if len(connTemplate.PublicIPs) > 0 {
+		if h.publicIP, err = cloudConnection.CreatePublicIPHandler(); err != nil || h.publicIP == nil {
+			return nil, checkHandler("PublicIPHandler", err)
+		}
+	}
+	if len(connTemplate.VMs) > 0 {
+		if h.vm, err = cloudConnection.CreateVMHandler(); err != nil || h.vm == nil {
+			return nil, checkHandler("VMHandler", err)
+		}
+	}
+	return h, nil
+}
+
+// checkHandler returns NotSupported for drivers that return (nil, nil) from Create*Handler().
+func checkHandler(handlerName string, err error) error {
+	if err != nil {
+		return err
+	}
+	return irs.NotSupported("the driver does not support %s", handlerName)
+}
+
+// connectionState is the current resources of a connection template.
+type connectionState struct {
+	template  *ConnectionTemplate
+	resources map[string]interface{} // kind/name -> {Resource}Info
+	vmList    []*irs.VMInfo
+}
+
+func loadState(ctx context.Context, connTemplate *ConnectionTemplate) (*connectionState, error) {
+	cloudConnection, err := dim.GetCloudConnectionContext(ctx, connTemplate.ConnectionName)
+	if err != nil {
+		return nil, err
+	}
+	defer cloudConnection.Close()
+
+	h, err := newHandlers(cloudConnection, connTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &connectionState{template: connTemplate, resources: map[string]interface{}{}}
+	if h.vNetwork != nil {
+		vNetworkInfoList, err := h.vNetwork.ListVNetwork()
+		if err != nil {
+			return nil, err
+		}
+		for _, vNetworkInfo := range vNetworkInfoList {
+			state.resources[resourceKey(VNetworkKind, vNetworkInfo.Name)] = *vNetworkInfo
+		}
+	}
+	if h.security != nil {
+		securityInfoList, err := h.security.ListSecurity()
+		if err != nil {
+			return nil, err
+		}
+		for _, securityInfo := range securityInfoList {
+			// AWS는 Name 대신 GroupName으로 찾음.
+			name := securityInfo.Name
+			if name == "" {
+				name = securityInfo.GroupName
+			}
+			state.resources[resourceKey(SecurityKind, name)] = *securityInfo
+		}
+	}
+	if h.keyPair != nil {
+		keyPairInfoList, err := h.keyPair.ListKey()
+		if err != nil {
+			return nil, err
+		}
+		for _, keyPairInfo := range keyPairInfoList {
+			state.resources[resourceKey(KeyPairKind, keyPairInfo.Name)] = *keyPairInfo
+		}
+	}
+	if h.publicIP != nil {
+		publicIPInfoList, err := h.publicIP.ListPublicIP()
+		if err != nil {
+			return nil, err
+		}
+		for _, publicIPInfo := range publicIPInfoList {
+			state.resources[resourceKey(PublicIPKind, publicIPInfo.Name)] = *publicIPInfo
+		}
+	}
+	if h.vm != nil {
+		// ListVM()은 에러를 반환하지 않으며, 종료된 VM은 Id가 없음.
+		for _, vmInfo := range h.vm.ListVM() {
+			if vmInfo != nil && vmInfo.Id != "" {
+				state.resources[resourceKey(VMKind, vmInfo.Name)] = *vmInfo
+				state.vmList = append(state.vmList, vmInfo)
+			}
+		}
+	}
+	return state, nil
+}
+
+func (state *connectionState) step(kind string, name string, template interface{}) *Step {
+	step := &Step{ConnectionName: state.template.ConnectionName, Kind: kind, Name: name, Action: CreateAction, template: template}
+	if current, ok := state.resources[resourceKey(kind, name)]; ok {
+		step.Action = NoChangeAction
+		step.Id = reflect.ValueOf(current).FieldByName("Id").String()
+	}
+	return step
+}
+
+// surplusVMs returns the VMs named {Name}-{n} with n > Count of vmTemplate,
+// all of them if Count is 0(a VM named Name).
+func (state *connectionState) surplusVMs(vmTemplate *VMTemplate) []*irs.VMInfo {
+	var vmInfoList []*irs.VMInfo
+	for _, vmInfo := range state.vmList {
+		if !strings.HasPrefix(vmInfo.Name, vmTemplate.Name+"-") {
+			continue
+		}
+		n, err := strconv.Atoi(strings.TrimPrefix(vmInfo.Name, vmTemplate.Name+"-"))
+		if err == nil && n > vmTemplate.Count {
+			vmInfoList = append(vmInfoList, vmInfo)
+		}
+	}
+	return vmInfoList
+}
+
+func (state *connectionState) applySteps() []*Step {
+	var steps []*Step
+	connTemplate := state.template
+
+	// Count가 줄어든 VM들은 다른 자원을 생성하기 전에 삭제 함.
+	for _, vmTemplate := range connTemplate.VMs {
+		for _, vmInfo := range state.surplusVMs(vmTemplate) {
+			steps = append(steps, &Step{ConnectionName: connTemplate.ConnectionName, Kind: VMKind, Name: vmInfo.Name,
+				Action: DeleteAction, Id: vmInfo.Id, template: vmTemplate})
+		}
+	}
+
+	for _, vNetworkTemplate := range connTemplate.VNetworks {
+		steps = append(steps, state.step(VNetworkKind, vNetworkTemplate.Name, vNetworkTemplate))
+	}
+	for _, securityTemplate := range connTemplate.SecurityGroups {
+		step := state.step(SecurityKind, securityTemplate.Name, securityTemplate)
+		if step.Action == NoChangeAction {
+			securityInfo := state.resources[resourceKey(SecurityKind, securityTemplate.Name)].(irs.SecurityInfo)
+			step.Warnings = securityWarnings(securityTemplate, securityInfo)
+		}
+		steps = append(steps, step)
+	}
+	for _, keyPairTemplate := range connTemplate.KeyPairs {
+		steps = append(steps, state.step(KeyPairKind, keyPairTemplate.Name, keyPairTemplate))
+	}
+	for _, publicIPTemplate := range connTemplate.PublicIPs {
+		steps = append(steps, state.step(PublicIPKind, publicIPTemplate.Name, publicIPTemplate))
+	}
+	for _, vmTemplate := range connTemplate.VMs {
+		for _, vmName := range vmTemplate.VMNames() {
+			step := state.step(VMKind, vmName, vmTemplate)
+			if step.Action == NoChangeAction {
+				state.diffVM(step, vmTemplate, state.resources[resourceKey(VMKind, vmName)].(irs.VMInfo))
+			}
+			steps = append(steps, step)
+		}
+	}
+	return steps
+}
+
+// diffVM sets the changes of the VM to update, and warns of the changes that require a new VM.
+func (state *connectionState) diffVM(step *Step, vmTemplate *VMTemplate, vmInfo irs.VMInfo) {
+	if vmInfo.SpecID != vmTemplate.SpecID {
+		step.changeSpec = true
+		step.Changes = append(step.Changes, fmt.Sprintf("SpecID: %s -> %s", vmInfo.SpecID, vmTemplate.SpecID))
+	}
+	// 템플릿에 없는 Public IP(ID 참조)의 연결은 변경하지 않음.
+	if vmTemplate.PublicIP != "" && state.templateHas(PublicIPKind, vmTemplate.PublicIP) {
+		publicIPInfo, ok := state.resources[resourceKey(PublicIPKind, vmTemplate.PublicIP)].(irs.PublicIPInfo)
+		if !ok || !associated(publicIPInfo, vmInfo) {
+			step.associateIP = true
+			step.Changes = append(step.Changes, fmt.Sprintf("PublicIP: %s -> %s", vmInfo.PublicIP, vmTemplate.PublicIP))
+		}
+	}
+	if vmInfo.ImageID != "" && vmInfo.ImageID != vmTemplate.ImageID {
+		step.Warnings = append(step.Warnings, fmt.Sprintf("ImageID: %s -> %s, not updated: destroy the VM and apply again", vmInfo.ImageID, vmTemplate.ImageID))
+	}
+	if len(step.Changes) > 0 {
+		step.Action = UpdateAction
+	}
+}
+
+func associated(publicIPInfo irs.PublicIPInfo, vmInfo irs.VMInfo) bool {
+	return publicIPInfo.InstanceId == vmInfo.Id || (publicIPInfo.PublicIp != "" && publicIPInfo.PublicIp == vmInfo.PublicIP)
+}
+
+func (state *connectionState) templateHas(kind string, name string) bool {
+	return templateHas(state.template, kind, name)
+}
+
+func templateHas(connTemplate *ConnectionTemplate, kind string, name string) bool {
+	switch kind {
+	case VNetworkKind:
+		for _, vNetworkTemplate := range connTemplate.VNetworks {
+			if vNetworkTemplate.Name == name {
+				return true
+			}
+		}
+	case SecurityKind:
+		for _, securityTemplate := range connTemplate.SecurityGroups {
+			if securityTemplate.Name == name {
+				return true
+			}
+		}
+	case KeyPairKind:
+		for _, keyPairTemplate := range connTemplate.KeyPairs {
+			if keyPairTemplate.Name == name {
+				return true
+			}
+		}
+	case PublicIPKind:
+		for _, publicIPTemplate := range connTemplate.PublicIPs {
+			if publicIPTemplate.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// securityWarnings compares the rules of drivers that return them. SecurityHandler has no update of rules.
+func securityWarnings(securityTemplate *SecurityTemplate, securityInfo irs.SecurityInfo) []string {
+	var warnings []string
+	if len(securityInfo.IPPermissions) > 0 && ruleSet(securityInfo.IPPermissions) != ruleSet(securityTemplate.Inbound) {
+		warnings = append(warnings, fmt.Sprintf("Inbound: %s -> %s, not updated", ruleSet(securityInfo.IPPermissions), ruleSet(securityTemplate.Inbound)))
+	}
+	if len(securityInfo.IPPermissionsEgress) > 0 && ruleSet(securityInfo.IPPermissionsEgress) != ruleSet(securityTemplate.Outbound) {
+		warnings = append(warnings, fmt.Sprintf("Outbound: %s -> %s, not updated", ruleSet(securityInfo.IPPermissionsEgress), ruleSet(securityTemplate.Outbound)))
+	}
+	return warnings
+}
+
+// ruleSet returns the sorted rules, ex) [tcp:22-22:0.0.0.0/0 tcp:80-80:0.0.0.0/0]
+func ruleSet(rules []*irs.SecurityRuleInfo) string {
+	var ruleList []string
+	for _, rule := range rules {
+		if rule != nil {
+			ruleList = append(ruleList, fmt.Sprintf("%s:%d-%d:%s", strings.ToLower(rule.IPProtocol), rule.FromPort, rule.ToPort, rule.Cidr))
+		}
+	}
+	sort.Strings(ruleList)
+	return "[" + strings.Join(ruleList, " ") + "]"
+}
+
+// destroySteps deletes the resources of the template in the reverse order of dependency.
+func (state *connectionState) destroySteps() []*Step {
+	var steps []*Step
+	connTemplate := state.template
+
+	deleteStep := func(kind string, name string, template interface{}) {
+		step := state.step(kind, name, template)
+		if step.Action == CreateAction {
+			step.Action = NoChangeAction // 이미 없음.
+		} else {
+			step.Action = DeleteAction
+		}
+		steps = append(steps, step)
+	}
+
+	for i := len(connTemplate.VMs) - 1; i >= 0; i-- {
+		vmTemplate := connTemplate.VMs[i]
+		for _, vmInfo := range state.surplusVMs(vmTemplate) {
+			deleteStep(VMKind, vmInfo.Name, vmTemplate)
+		}
+		vmNames := vmTemplate.VMNames()
+		for j := len(vmNames) - 1; j >= 0; j-- {
+			deleteStep(VMKind, vmNames[j], vmTemplate)
+		}
+	}
+	for i := len(connTemplate.PublicIPs) - 1; i >= 0; i-- {
+		deleteStep(PublicIPKind, connTemplate.PublicIPs[i].Name, connTemplate.PublicIPs[i])
+	}
+	for i := len(connTemplate.KeyPairs) - 1; i >= 0; i-- {
+		deleteStep(KeyPairKind, connTemplate.KeyPairs[i].Name, connTemplate.KeyPairs[i])
+	}
+	for i := len(connTemplate.SecurityGroups) - 1; i >= 0; i-- {
+		deleteStep(SecurityKind, connTemplate.SecurityGroups[i].Name, connTemplate.SecurityGroups[i])
+	}
+	for i := len(connTemplate.VNetworks) - 1; i >= 0; i-- {
+		deleteStep(VNetworkKind, connTemplate.VNetworks[i].Name, connTemplate.VNetworks[i])
+	}
+	return steps
+}
@@ -0,0 +1,200 @@
+// Proof of Concepts of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is PoC of Infrastructure Templates.
+// A template describes the resources of one or more connection configs in a YAML or JSON file,
+// ex) cloud-driver/drivers/aws/main/template.yaml
+//
+// by powerkim@etri.re.kr, 2019.08.
+
+package templatemanager
+
+import (
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+	"gopkg.in/yaml.v3"
+
+	"bytes"
+	"encoding/json"
+)
+
+// Kinds of template resources, in the order of dependency.
+const (
+	VNetworkKind = "VNetwork"
+	SecurityKind = "Security"
+	KeyPairKind  = "KeyPair"
+	PublicIPKind = "PublicIP"
+	VMKind       = "VM"
+)
+
+// Template is the resources of a topology. Resources are identified by name, a resource of the CSP with the name is the resource of the template.
+type Template struct {
+	Name        string
+	Connections []*ConnectionTemplate
+}
+
+// ConnectionTemplate is the resources of a connection config.
+// A reference(ex. VMTemplate.VNetwork) is the name of a resource of the connection template, or the ID of an existing resource.
+type ConnectionTemplate struct {
+	ConnectionName string
+	VNetworks      []*VNetworkTemplate
+	SecurityGroups []*SecurityTemplate
+	KeyPairs       []*KeyPairTemplate
+	PublicIPs      []*PublicIPTemplate
+	VMs            []*VMTemplate
+}
+
+// Id of templates is the Id of the {Resource}ReqInfo, for drivers that require it, ex) Azure {resource group}:{name}
+
+type VNetworkTemplate struct {
+	Name    string
+	Id      string `json:",omitempty"`
+	Subnets []irs.SubnetReqInfo
+}
+
+type SecurityTemplate struct {
+	Name        string
+	Id          string `json:",omitempty"`
+	VNetwork    string // reference of the vNetwork, AWS: VPC
+	Description string
+	Inbound     []*irs.SecurityRuleInfo
+	Outbound    []*irs.SecurityRuleInfo
+}
+
+type KeyPairTemplate struct {
+	Name string
+	Id   string `json:",omitempty"`
+}
+
+type PublicIPTemplate struct {
+	Name string
+	Id   string `json:",omitempty"`
+}
+
+type VMTemplate struct {
+	Name          string
+	Count         int // Count VMs named {Name}-{1..Count}, 0: a VM named Name
+	ImageID       string
+	SpecID        string
+	VNetwork      string // reference of the vNetwork
+	SecurityGroup string // reference of the security group
+	KeyPair       string // reference of the key pair
+	PublicIP      string // reference of the public IP, only for a VM
+	LoginInfo     irs.LoginInfo
+}
+
+// VMNames returns the names of the VMs of the template.
+func (vmTemplate *VMTemplate) VMNames() []string {
+	if vmTemplate.Count == 0 {
+		return []string{vmTemplate.Name}
+	}
+	var names []string
+	for i := 0; i < vmTemplate.Count; i++ {
+		names = append(names, irs.BatchVMName(vmTemplate.Name, i))
+	}
+	return names
+}
+
+// ParseTemplate reads a template from YAML or JSON. Field names are case insensitive, ex) connectionName, ConnectionName
+func ParseTemplate(data []byte) (*Template, error) {
+	// YAML은 JSON을 포함하므로 YAML로 읽은 후 JSON으로 변환 함.
+	var value interface{}
+	if err := yaml.Unmarshal(data, &value); err != nil {
+		return nil, irs.InvalidArgument("invalid template: %v", err)
+	}
+	jsonData, err := json.Marshal(value)
+	if err != nil {
+		return nil, irs.InvalidArgument("invalid template: %v", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.DisallowUnknownFields()
+	template := &Template{}
+	if err := decoder.Decode(template); err != nil {
+		return nil, irs.InvalidArgument("invalid template: %v", err)
+	}
+	return template, template.Validate()
+}
+
+// Validate checks the required fields and the names of the template.
+func (template *Template) Validate() error {
+	if template.Name == "" {
+		return irs.InvalidArgument("template: Name is required")
+	}
+	if len(template.Connections) == 0 {
+		return irs.InvalidArgument("template %s: Connections is required", template.Name)
+	}
+
+	connectionNames := map[string]bool{}
+	for _, connTemplate := range template.Connections {
+		if connTemplate == nil || connTemplate.ConnectionName == "" {
+			return irs.InvalidArgument("template %s: ConnectionName is required", template.Name)
+		}
+		if connectionNames[connTemplate.ConnectionName] {
+			return irs.InvalidArgument("template %s: duplicate connection %s", template.Name, connTemplate.ConnectionName)
+		}
+		connectionNames[connTemplate.ConnectionName] = true
+		if err := connTemplate.validate(); err != nil {
+			return irs.InvalidArgument("template %s, connection %s: %v", template.Name, connTemplate.ConnectionName, err)
+		}
+	}
+	return nil
+}
+
+func (connTemplate *ConnectionTemplate) validate() error {
+	names := map[string]bool{}
+	checkName := func(kind string, name string) error {
+		if name == "" {
+			return irs.InvalidArgument("%s: Name is required", kind)
+		}
+		if names[kind+"/"+name] {
+			return irs.InvalidArgument("%s %s: duplicate name", kind, name)
+		}
+		names[kind+"/"+name] = true
+		return nil
+	}
+
+	for _, vNetworkTemplate := range connTemplate.VNetworks {
+		if err := checkName(VNetworkKind, vNetworkTemplate.Name); err != nil {
+			return err
+		}
+	}
+	for _, securityTemplate := range connTemplate.SecurityGroups {
+		if err := checkName(SecurityKind, securityTemplate.Name); err != nil {
+			return err
+		}
+	}
+	for _, keyPairTemplate := range connTemplate.KeyPairs {
+		if err := checkName(KeyPairKind, keyPairTemplate.Name); err != nil {
+			return err
+		}
+	}
+	for _, publicIPTemplate := range connTemplate.PublicIPs {
+		if err := checkName(PublicIPKind, publicIPTemplate.Name); err != nil {
+			return err
+		}
+	}
+	for _, vmTemplate := range connTemplate.VMs {
+		switch {
+		case vmTemplate.Name == "":
+			return irs.InvalidArgument("%s: Name is required", VMKind)
+		case vmTemplate.ImageID == "":
+			return irs.InvalidArgument("VM %s: ImageID is required", vmTemplate.Name)
+		case vmTemplate.SpecID == "":
+			return irs.InvalidArgument("VM %s: SpecID is required", vmTemplate.Name)
+		case vmTemplate.Count < 0:
+			return irs.InvalidArgument("VM %s: invalid Count %d", vmTemplate.Name, vmTemplate.Count)
+		case vmTemplate.PublicIP != "" && vmTemplate.Count > 1:
+			return irs.InvalidArgument("VM %s: a PublicIP is only for a VM, Count: %d", vmTemplate.Name, vmTemplate.Count)
+		}
+		// {Name}-{n} VM이 다른 VM 템플릿의 이름과 겹치지 않도록 모든 VM 이름을 확인 함.
+		for _, vmName := range vmTemplate.VMNames() {
+			if err := checkName(VMKind, vmName); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
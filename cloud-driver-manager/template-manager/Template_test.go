@@ -0,0 +1,152 @@
+// Proof of Concepts of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the test of the parsing and the validation of templates.
+//
+// by powerkim@etri.re.kr, 2019.08.
+
+package templatemanager
+
+import (
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+
+	"reflect"
+	"testing"
+)
+
+const testTemplateYAML = `
+name: web
+connections:
+- connectionName: aws-seoul
+  vNetworks:
+  - name: web-vnet
+    subnets:
+    - name: web-subnet
+      cidr: 10.0.1.0/24
+  keyPairs:
+  - name: web-key
+  vms:
+  - name: web
+    count: 2
+    imageID: ami-047f7b46bd6dd5d84
+    specID: t2.micro
+    vNetwork: web-vnet
+    keyPair: web-key
+`
+
+func TestParseTemplate(t *testing.T) {
+	template, err := ParseTemplate([]byte(testTemplateYAML))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if template.Name != "web" || len(template.Connections) != 1 {
+		t.Fatalf("template: %+v", template)
+	}
+	connTemplate := template.Connections[0]
+	if connTemplate.ConnectionName != "aws-seoul" || len(connTemplate.VNetworks) != 1 || len(connTemplate.KeyPairs) != 1 || len(connTemplate.VMs) != 1 {
+		t.Fatalf("connection template: %+v", connTemplate)
+	}
+	if subnets := connTemplate.VNetworks[0].Subnets; len(subnets) != 1 || subnets[0].CIDR != "10.0.1.0/24" {
+		t.Errorf("subnets: %+v", subnets)
+	}
+	vmTemplate := connTemplate.VMs[0]
+	if vmTemplate.Count != 2 || vmTemplate.VNetwork != "web-vnet" || vmTemplate.KeyPair != "web-key" {
+		t.Errorf("vm template: %+v", vmTemplate)
+	}
+
+	// JSON도 YAML 임.
+	jsonTemplate, err := ParseTemplate([]byte(`{"Name": "web", "Connections": [{"ConnectionName": "aws-seoul"}]}`))
+	if err != nil || jsonTemplate.Connections[0].ConnectionName != "aws-seoul" {
+		t.Errorf("JSON template: %+v, %v", jsonTemplate, err)
+	}
+
+	for name, data := range map[string]string{
+		"unknown field": "name: web\nconnections:\n- connectionName: aws-seoul\n  vpcs: []\n",
+		"invalid YAML":  "name: [web\n",
+		"invalid":       "name: web\n",
+	} {
+		if _, err := ParseTemplate([]byte(data)); irs.ErrorCodeOf(err) != irs.InvalidArgumentError {
+			t.Errorf("%s: %v, want InvalidArgument", name, err)
+		}
+	}
+}
+
+func testTemplate() *Template {
+	return &Template{Name: "web", Connections: []*ConnectionTemplate{{
+		ConnectionName: "aws-seoul",
+		VNetworks:      []*VNetworkTemplate{{Name: "web-vnet"}},
+		SecurityGroups: []*SecurityTemplate{{Name: "web-sg", VNetwork: "web-vnet"}},
+		KeyPairs:       []*KeyPairTemplate{{Name: "web-key"}},
+		PublicIPs:      []*PublicIPTemplate{{Name: "web-ip"}},
+		VMs: []*VMTemplate{
+			{Name: "web", Count: 2, ImageID: "ami-1", SpecID: "t2.micro"},
+			{Name: "db", ImageID: "ami-1", SpecID: "t2.micro", PublicIP: "web-ip"},
+		},
+	}}}
+}
+
+func TestTemplateValidate(t *testing.T) {
+	if err := testTemplate().Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	invalids := map[string]func(template *Template){
+		"no name":            func(template *Template) { template.Name = "" },
+		"no connections":     func(template *Template) { template.Connections = nil },
+		"nil connection":     func(template *Template) { template.Connections = append(template.Connections, nil) },
+		"no connection name": func(template *Template) { template.Connections[0].ConnectionName = "" },
+		"duplicate connection": func(template *Template) {
+			template.Connections = append(template.Connections, testTemplate().Connections[0])
+		},
+		"no vNetwork name": func(template *Template) { template.Connections[0].VNetworks[0].Name = "" },
+		"duplicate security group": func(template *Template) {
+			template.Connections[0].SecurityGroups = append(template.Connections[0].SecurityGroups, &SecurityTemplate{Name: "web-sg"})
+		},
+		"duplicate key pair": func(template *Template) {
+			template.Connections[0].KeyPairs = append(template.Connections[0].KeyPairs, &KeyPairTemplate{Name: "web-key"})
+		},
+		"no public IP name": func(template *Template) { template.Connections[0].PublicIPs[0].Name = "" },
+		"no VM name":        func(template *Template) { template.Connections[0].VMs[0].Name = "" },
+		"no ImageID":        func(template *Template) { template.Connections[0].VMs[0].ImageID = "" },
+		"no SpecID":         func(template *Template) { template.Connections[0].VMs[0].SpecID = "" },
+		"negative Count":    func(template *Template) { template.Connections[0].VMs[0].Count = -1 },
+		"PublicIP of VMs":   func(template *Template) { template.Connections[0].VMs[0].PublicIP = "web-ip" },
+		// web-1은 web 템플릿의 첫번째 VM 이름 임.
+		"duplicate VM name": func(template *Template) {
+			template.Connections[0].VMs = append(template.Connections[0].VMs, &VMTemplate{Name: "web-1", ImageID: "ami-1", SpecID: "t2.micro"})
+		},
+	}
+	for name, invalidate := range invalids {
+		template := testTemplate()
+		invalidate(template)
+		if err := template.Validate(); irs.ErrorCodeOf(err) != irs.InvalidArgumentError {
+			t.Errorf("%s: %v, want InvalidArgument", name, err)
+		}
+	}
+
+	// 다른 종류, 다른 connection의 같은 이름은 허용 함.
+	template := testTemplate()
+	template.Connections[0].KeyPairs[0].Name = "web"
+	other := testTemplate().Connections[0]
+	other.ConnectionName = "gcp-seoul"
+	template.Connections = append(template.Connections, other)
+	if err := template.Validate(); err != nil {
+		t.Errorf("the same names of other kinds and connections: %v", err)
+	}
+}
+
+func TestVMNames(t *testing.T) {
+	names := map[int][]string{
+		0: {"web"},
+		1: {irs.BatchVMName("web", 0)},
+		3: {irs.BatchVMName("web", 0), irs.BatchVMName("web", 1), irs.BatchVMName("web", 2)},
+	}
+	for count, want := range names {
+		if got := (&VMTemplate{Name: "web", Count: count}).VMNames(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Count %d: %v, want %v", count, got, want)
+		}
+	}
+}
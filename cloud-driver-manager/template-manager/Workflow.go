@@ -0,0 +1,450 @@
+// Proof of Concepts of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is PoC of Provisioning Workflows.
+// An apply whose step fails deletes the resources it created in the reverse order(rollback).
+// A workflow interrupted by a crash of the server is resumed or rolled back from the journal.
+//
+// by powerkim@etri.re.kr, 2019.08.
+
+package templatemanager
+
+import (
+	dim "github.com/cloud-barista/poc-cb-spider/cloud-driver-manager"
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"reflect"
+	"sync"
+	"time"
+)
+
+var journalMutex sync.RWMutex
+var workflowJournal Journal
+
+func init() {
+	workflowJournal, _ = NewFileJournal("", DefaultJournalTTL)
+}
+
+// SetJournal replaces the journal of workflows, ex) with a FileJournal at start.
+func SetJournal(journal Journal) {
+	journalMutex.Lock()
+	defer journalMutex.Unlock()
+	workflowJournal = journal
+}
+
+func getJournal() Journal {
+	journalMutex.RLock()
+	defer journalMutex.RUnlock()
+	return workflowJournal
+}
+
+// ListWorkflows returns the workflows of the journal, in the order of start.
+func ListWorkflows() ([]*Workflow, error) {
+	return getJournal().List()
+}
+
+// GetWorkflow returns the workflow of id.
+func GetWorkflow(id string) (*Workflow, error) {
+	workflow, err := getJournal().Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if workflow == nil {
+		return nil, irs.NotFound("workflow %s not found", id)
+	}
+	return workflow, nil
+}
+
+// ResumeWorkflow applies the template of an unfinished workflow again, ex) Interrupted by a crash of the server.
+// Resources created before are found by name, so only the remaining steps are applied.
+func ResumeWorkflow(ctx context.Context, id string) (*Plan, error) {
+	plan, run, err := prepareResume(ctx, id)
+	return runWorkflow(ctx, plan, run, err)
+}
+
+// RollbackWorkflow deletes the resources created by an unfinished apply workflow in the reverse order.
+// It returns a plan with only the Rollback steps.
+func RollbackWorkflow(ctx context.Context, id string) (*Plan, error) {
+	plan, run, err := prepareRollback(id)
+	return runWorkflow(ctx, plan, run, err)
+}
+
+// StartResumeWorkflow is ResumeWorkflow in the background, see startWorkflow().
+func StartResumeWorkflow(ctx context.Context, id string) (*Plan, error) {
+	plan, run, err := prepareResume(ctx, id)
+	return startWorkflow(ctx, plan, run, err)
+}
+
+// StartRollbackWorkflow is RollbackWorkflow in the background, see startWorkflow().
+func StartRollbackWorkflow(ctx context.Context, id string) (*Plan, error) {
+	plan, run, err := prepareRollback(id)
+	return startWorkflow(ctx, plan, run, err)
+}
+
+func prepareResume(ctx context.Context, id string) (*Plan, workflowFunc, error) {
+	workflow, err := unfinishedWorkflow(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := lockTemplate(workflow.TemplateName); err != nil {
+		return nil, nil, err
+	}
+
+	plan, err := makePlan(ctx, workflow.Template, workflow.Destroy)
+	if err != nil {
+		unlockTemplate(workflow.TemplateName)
+		return nil, nil, err
+	}
+	plan.WorkflowId = workflow.Id
+
+	run := &workflowRun{workflow: workflow, journal: getJournal()}
+	run.adopt(plan)
+	workflow.State, workflow.Error = WorkflowRunning, ""
+	if err := run.save(); err != nil {
+		unlockTemplate(workflow.TemplateName)
+		return nil, nil, err
+	}
+	return plan, func(ctx context.Context) (err error) {
+		defer unlockTemplate(workflow.TemplateName)
+		defer run.recoverPanic(&err)
+		return run.run(ctx, plan)
+	}, nil
+}
+
+func prepareRollback(id string) (*Plan, workflowFunc, error) {
+	workflow, err := unfinishedWorkflow(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if workflow.Destroy {
+		return nil, nil, irs.InvalidArgument("workflow %s is a destroy, resume it to delete the remaining resources", id)
+	}
+	if err := lockTemplate(workflow.TemplateName); err != nil {
+		return nil, nil, err
+	}
+
+	run := &workflowRun{workflow: workflow, journal: getJournal()}
+	workflow.State = WorkflowRunning
+	if err := run.save(); err != nil {
+		unlockTemplate(workflow.TemplateName)
+		return nil, nil, err
+	}
+	plan := &Plan{TemplateName: workflow.TemplateName, WorkflowId: workflow.Id}
+	return plan, func(ctx context.Context) (err error) {
+		defer unlockTemplate(workflow.TemplateName)
+		defer run.recoverPanic(&err)
+		if plan.Rollback, err = run.rollback(ctx); err != nil {
+			run.finish(WorkflowFailed, err)
+			return err
+		}
+		run.finish(WorkflowRolledBack, nil)
+		return nil
+	}, nil
+}
+
+// workflowFunc applies the steps of a prepared workflow and unlocks its template.
+type workflowFunc func(ctx context.Context) error
+
+// runWorkflow runs the prepared workflow with ctx of the caller.
+func runWorkflow(ctx context.Context, plan *Plan, run workflowFunc, err error) (*Plan, error) {
+	if err != nil || run == nil {
+		return plan, err
+	}
+	return plan, run(ctx)
+}
+
+// WorkflowTimeout is the max time of a workflow started in the background, 0: no timeout.
+var WorkflowTimeout = 2 * time.Hour
+
+// startWorkflow runs the prepared workflow in the background, and returns a copy of the plan with the ID of the workflow.
+// The workflow is not cancelled with ctx(ex. a disconnected REST client), it has the values of ctx and its own timeout.
+// The progress and the result are in the journal, see GetWorkflow().
+func startWorkflow(ctx context.Context, plan *Plan, run workflowFunc, err error) (*Plan, error) {
+	if err != nil || run == nil {
+		return plan, err
+	}
+	started := plan.copy()
+	go func() {
+		ctx, cancel := workflowContext(ctx)
+		defer cancel()
+		// 결과는 저널에 기록 됨.
+		run(ctx)
+	}()
+	return started, nil
+}
+
+func workflowContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx = detachedContext{ctx}
+	if WorkflowTimeout > 0 {
+		return context.WithTimeout(ctx, WorkflowTimeout)
+	}
+	return context.WithCancel(ctx)
+}
+
+// detachedContext has the values of its parent(ex. the idempotency key), but not its deadline and cancel.
+type detachedContext struct {
+	parent context.Context
+}
+
+func (ctx detachedContext) Deadline() (time.Time, bool)       { return time.Time{}, false }
+func (ctx detachedContext) Done() <-chan struct{}             { return nil }
+func (ctx detachedContext) Err() error                        { return nil }
+func (ctx detachedContext) Value(key interface{}) interface{} { return ctx.parent.Value(key) }
+
+func unfinishedWorkflow(id string) (*Workflow, error) {
+	workflow, err := GetWorkflow(id)
+	if err != nil {
+		return nil, err
+	}
+	if workflow.Finished() {
+		return nil, irs.InvalidArgument("workflow %s is %s", id, workflow.State)
+	}
+	if workflow.Template == nil {
+		return nil, irs.InvalidArgument("workflow %s has no template", id)
+	}
+	return workflow, nil
+}
+
+func newWorkflowID() string {
+	var id [8]byte
+	rand.Read(id[:])
+	return "wf-" + hex.EncodeToString(id[:])
+}
+
+// workflowRun records the progress of a workflow in the journal.
+type workflowRun struct {
+	workflow *Workflow
+	journal  Journal
+}
+
+func newWorkflowRun(template *Template, destroy bool) (*workflowRun, error) {
+	now := time.Now().UTC()
+	workflow := &Workflow{Id: newWorkflowID(), TemplateName: template.Name, Destroy: destroy, Template: template,
+		State: WorkflowRunning, StartTime: now}
+	run := &workflowRun{workflow: workflow, journal: getJournal()}
+	return run, run.save()
+}
+
+func (run *workflowRun) save() error {
+	run.workflow.UpdateTime = time.Now().UTC()
+	return run.journal.Put(run.workflow)
+}
+
+// finish records the final state, err is the error of the failed step if any.
+func (run *workflowRun) finish(state string, err error) {
+	run.workflow.State = state
+	if err != nil {
+		run.workflow.Error = err.Error()
+	}
+	if saveErr := run.save(); saveErr != nil {
+		cblogger.Error(saveErr)
+	}
+	cblogger.Infof("workflow %s of template %s: %s", run.workflow.Id, run.workflow.TemplateName, state)
+}
+
+// run applies plan, and rolls back an apply whose step failed. A destroy can not be rolled back.
+func (run *workflowRun) run(ctx context.Context, plan *Plan) error {
+	err := plan.apply(ctx, run)
+	if err == nil {
+		run.finish(WorkflowDone, nil)
+		return nil
+	}
+	if run.workflow.Destroy {
+		run.finish(WorkflowFailed, err)
+		return err
+	}
+
+	var rollbackErr error
+	plan.Rollback, rollbackErr = run.rollback(ctx)
+	if rollbackErr != nil {
+		err = irs.NewCloudError(irs.ErrorCodeOf(err), "%v, rollback failed: %v", err, rollbackErr)
+		run.finish(WorkflowFailed, err)
+		return err
+	}
+	run.finish(WorkflowRolledBack, err)
+	return err
+}
+
+// recoverPanic records a panic of a driver as the failure of the workflow, to be resumed or rolled back.
+func (run *workflowRun) recoverPanic(err *error) {
+	if r := recover(); r != nil {
+		*err = irs.NewCloudError(irs.UnknownError, "workflow %s: panic: %v", run.workflow.Id, r)
+		cblogger.Error(*err)
+		run.finish(WorkflowFailed, *err)
+	}
+}
+
+func (run *workflowRun) entry(connectionName string, kind string, name string) (int, *JournalEntry) {
+	for i, entry := range run.workflow.Resources {
+		if entry.ConnectionName == connectionName && entry.Kind == kind && entry.Name == name {
+			return i, entry
+		}
+	}
+	return -1, nil
+}
+
+// creating records the resource of step before it is created, so it is rolled back even if the server crashes during the create.
+func (run *workflowRun) creating(step *Step) error {
+	if run.workflow.Destroy {
+		return nil
+	}
+	run.workflow.Resources = append(run.workflow.Resources, &JournalEntry{ConnectionName: step.ConnectionName,
+		Kind: step.Kind, Name: step.Name, State: ResourceCreating})
+	return run.save()
+}
+
+func (run *workflowRun) created(step *Step) {
+	if _, entry := run.entry(step.ConnectionName, step.Kind, step.Name); entry != nil {
+		entry.Id, entry.State = step.Id, ResourceCreated
+		if err := run.save(); err != nil {
+			// 롤백 시에는 이름으로 찾음.
+			cblogger.Error(err)
+		}
+	}
+}
+
+// createFailed removes the entry of step, unless the resource was or may have been created,
+// ex) a VM whose public IP is not associated, a timeout
+func (run *workflowRun) createFailed(step *Step, err error) {
+	if step.Id != "" {
+		run.created(step)
+		return
+	}
+	i, entry := run.entry(step.ConnectionName, step.Kind, step.Name)
+	if entry == nil || outcomeUnknown(err) {
+		return
+	}
+	run.workflow.Resources = append(run.workflow.Resources[:i], run.workflow.Resources[i+1:]...)
+	if saveErr := run.save(); saveErr != nil {
+		cblogger.Error(saveErr)
+	}
+}
+
+func outcomeUnknown(err error) bool {
+	switch irs.ErrorCodeOf(err) {
+	case irs.TimeoutError, irs.UnavailableError, irs.UnknownError:
+		return true
+	}
+	return false
+}
+
+// adopt updates the entries still Creating by the plan of a resumed workflow:
+// a resource found by name was created by the workflow, otherwise the create did not happen.
+func (run *workflowRun) adopt(plan *Plan) {
+	var resources []*JournalEntry
+	for _, entry := range run.workflow.Resources {
+		if entry.State == ResourceCreating {
+			for _, step := range plan.Steps {
+				if step.ConnectionName == entry.ConnectionName && step.Kind == entry.Kind && step.Name == entry.Name && step.Id != "" {
+					entry.Id, entry.State = step.Id, ResourceCreated
+				}
+			}
+			if entry.State == ResourceCreating {
+				continue
+			}
+		}
+		resources = append(resources, entry)
+	}
+	run.workflow.Resources = resources
+}
+
+// rollback deletes the resources created by the workflow in the reverse order, and records each deletion in the journal,
+// so a failed or interrupted rollback continues from the failed resource.
+func (run *workflowRun) rollback(ctx context.Context) ([]*Step, error) {
+	var steps []*Step
+	for i := len(run.workflow.Resources) - 1; i >= 0; i-- {
+		if entry := run.workflow.Resources[i]; entry.State != ResourceDeleted {
+			steps = append(steps, &Step{ConnectionName: entry.ConnectionName, Kind: entry.Kind, Name: entry.Name,
+				Action: DeleteAction, Id: entry.Id})
+		}
+	}
+
+	appliers := map[string]*applier{}
+	defer func() {
+		for _, a := range appliers {
+			a.cloudConnection.Close()
+		}
+	}()
+
+	for i, step := range steps {
+		a, ok := appliers[step.ConnectionName]
+		if !ok {
+			var err error
+			if a, err = run.rollbackApplier(ctx, step.ConnectionName); err != nil {
+				return steps, failStep(steps, i, err)
+			}
+			appliers[step.ConnectionName] = a
+		}
+
+		// 생성 결과를 알 수 없는 자원은 이름으로 찾음.
+		if step.Id == "" {
+			id, err := a.lookup(ctx, step.Kind, step.Name)
+			if err != nil {
+				return steps, failStep(steps, i, err)
+			}
+			if id == "" {
+				step.Action = NoChangeAction
+			}
+			step.Id = id
+		}
+		if step.Action == DeleteAction {
+			if err := a.delete(ctx, step); err != nil {
+				return steps, failStep(steps, i, err)
+			}
+		}
+		step.Status = StepDone
+
+		_, entry := run.entry(step.ConnectionName, step.Kind, step.Name)
+		entry.State = ResourceDeleted
+		if err := run.save(); err != nil {
+			cblogger.Error(err)
+		}
+		cblogger.Infof("rollback %s %s %s: %s", step.ConnectionName, step.Kind, step.Name, step.Id)
+	}
+	return steps, nil
+}
+
+func (run *workflowRun) rollbackApplier(ctx context.Context, connectionName string) (*applier, error) {
+	var connTemplate *ConnectionTemplate
+	for _, c := range run.workflow.Template.Connections {
+		if c.ConnectionName == connectionName {
+			connTemplate = c
+		}
+	}
+	if connTemplate == nil {
+		return nil, irs.NotFound("connection %s is not in the template", connectionName)
+	}
+
+	cloudConnection, err := dim.GetCloudConnectionContext(ctx, connectionName)
+	if err != nil {
+		return nil, err
+	}
+	h, err := newHandlers(cloudConnection, connTemplate)
+	if err != nil {
+		cloudConnection.Close()
+		return nil, err
+	}
+	return &applier{cloudConnection: cloudConnection, handlers: h, template: connTemplate, resources: map[string]interface{}{}}, nil
+}
+
+// lookup returns the ID of the resource named name, "" if none.
+func (a *applier) lookup(ctx context.Context, kind string, name string) (string, error) {
+	if a.state == nil {
+		state, err := loadState(ctx, a.template)
+		if err != nil {
+			return "", err
+		}
+		a.state = state
+	}
+	current, ok := a.state.resources[resourceKey(kind, name)]
+	if !ok {
+		return "", nil
+	}
+	return reflect.ValueOf(current).FieldByName("Id").String(), nil
+}
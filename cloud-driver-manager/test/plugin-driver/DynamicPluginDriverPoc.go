@@ -16,17 +16,19 @@ package main
 import (
 	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
 	//icon "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/connect"
+	drivermanager "github.com/cloud-barista/poc-cb-spider/cloud-driver-manager"
 
 	"flag"
-	"plugin"
 	"fmt"
 	"log"
 )
 
 
 var driverPath *string
+var driverDir *string
 func init() {
         driverPath = flag.String("driver", "none", "select driver: -driver=/tmp/TestADriver.so")
+        driverDir = flag.String("dir", "none", "load all drivers of a directory: -dir=/tmp")
         flag.Parse()
 }
 
@@ -36,37 +38,40 @@ func init() {
 
 func main() {
 
-	var plug *plugin.Plugin
-	var err error
-        if *driverPath == "none" {
-		fmt.Println("Usage: CloudDriverManager -driver=/tmp/TestADriver.so")
-		return
-        }
-
-	//fmt.Println("######### driver path:" + *driverPath)
-	plug, err = plugin.Open(*driverPath)
-
-	// fmt.Printf("plug: %#v\n\n", plug)	
-	if err != nil {
-		log.Fatalf("plugin.Open: %v\n", err)	
+	if *driverDir != "none" {
+		results, err := drivermanager.ScanDriverDir(*driverDir)
+		if err != nil {
+			log.Fatalf("%v\n", err)
+		}
+		for _, result := range results {
+			if result.Err != nil {
+				fmt.Printf("%s: %v\n", result.FilePath, result.Err)
+				continue
+			}
+			fmt.Printf("%s: %s(%s), handlers %v\n", result.FilePath, result.DriverName, result.DriverVersion, result.Handlers)
+		}
 		return
 	}
 
-
-	testDriver, err := plug.Lookup("TestDriver")	
-	if err != nil {
-		log.Fatalf("plug.Lookup: %v\n", err)	
+        if *driverPath == "none" {
+		fmt.Println("Usage: CloudDriverManager -driver=/tmp/TestADriver.so or -dir=/tmp")
 		return
-	}
+        }
 
-	cloudDriver, ok := testDriver.(idrv.CloudDriver)
-	if !ok {
-		log.Fatalf("Not CloudDriver interface!!")
+	// checks DriverManifest(interface version, Go toolchain, module versions) before the type assertion.
+	cloudDriver, manifest, err := drivermanager.OpenDriverPlugin(*driverPath)
+	if err != nil {
+		log.Fatalf("%v\n", err)
 		return
 	}
 
+	fmt.Printf("%s: interface version %s, %s, handlers %v\n", manifest.DriverName, manifest.InterfaceVersion, manifest.GoVersion, manifest.Handlers)
 	fmt.Printf("%s: %s\n", *driverPath, cloudDriver.GetDriverVersion())
 
+	drvCapabilityInfo := cloudDriver.GetDriverCapability()
+	fmt.Printf("VMHandler Operations: %v\n", drvCapabilityInfo.VMHandler.Operations)
+	fmt.Printf("VMHandler ReqFields: %v\n", drvCapabilityInfo.VMHandler.ReqFields)
+
 /* in CloudDriver.go
 	type CredentialInfo struct {
 		// @todo TBD
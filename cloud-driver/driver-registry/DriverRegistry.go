@@ -0,0 +1,63 @@
+// Cloud Driver Registry of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the registry of statically linked Cloud Drivers.
+// Drivers register themselves in init(), and the binary selects drivers with build tags,
+// ex) go build -tags "static_aws static_gcp" (see cloud-driver-manager/StaticDriver*.go)
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package registry
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
+)
+
+var driverMap = map[string]idrv.CloudDriver{}
+var driverMutex sync.RWMutex
+
+// Register is called in init() of a driver package, ex) registry.Register("aws", &TestDriver)
+// It panics if driverName is registered twice, like database/sql.Register.
+func Register(driverName string, cloudDriver idrv.CloudDriver) {
+	driverMutex.Lock()
+	defer driverMutex.Unlock()
+
+	if cloudDriver == nil {
+		panic("registry: Register driver is nil: " + driverName)
+	}
+	if _, dup := driverMap[driverName]; dup {
+		panic("registry: Register called twice for driver " + driverName)
+	}
+	driverMap[driverName] = cloudDriver
+}
+
+func Get(driverName string) (idrv.CloudDriver, error) {
+	driverMutex.RLock()
+	defer driverMutex.RUnlock()
+
+	cloudDriver, ok := driverMap[driverName]
+	if !ok {
+		return nil, fmt.Errorf("%s: not a statically linked driver", driverName)
+	}
+	return cloudDriver, nil
+}
+
+// List returns the sorted names of registered drivers.
+func List() []string {
+	driverMutex.RLock()
+	defer driverMutex.RUnlock()
+
+	var driverNames []string
+	for driverName := range driverMap {
+		driverNames = append(driverNames, driverName)
+	}
+	sort.Strings(driverNames)
+	return driverNames
+}
@@ -17,12 +17,17 @@ import (
 	acon "github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/aws/connect"
 	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
 	icon "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/connect"
+	"github.com/cloud-barista/poc-cb-spider/cloud-driver/logger"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 )
-import "fmt"
+import (
+	"net/http"
+)
+
+var cblogger = logger.New("AWS", "Driver")
 
 type AwsDriver struct {
 }
@@ -31,38 +36,38 @@ func (AwsDriver) GetDriverVersion() string {
 	return "TEST AWS DRIVER Version 0.5"
 }
 
+// VNetworkHandler, VNicHandler와 CreateImage, DeleteImage는 아직 Stub이므로 지원하지 않음.
 func (AwsDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 	var drvCapabilityInfo idrv.DriverCapabilityInfo
 
-	drvCapabilityInfo.ImageHandler = false
-	drvCapabilityInfo.VNetworkHandler = false
-	drvCapabilityInfo.SecurityHandler = false
-	drvCapabilityInfo.KeyPairHandler = true
-	drvCapabilityInfo.VNicHandler = false
-	drvCapabilityInfo.PublicIPHandler = false
-	drvCapabilityInfo.VMHandler = true
+	listReqFields := []string{idrv.ListPageSize, idrv.ListNextToken, idrv.ListNameFilter, idrv.ListTagFilter}
+	vmOperations := []string{"StartVM", "StartVMs", "SuspendVM", "ResumeVM", "RebootVM", "TerminateVM", "ChangeVMSpec", "ListVMStatus", "GetVMStatus", "ListVM", "ListVMPage", "GetVM"}
+
+	drvCapabilityInfo.ImageHandler = idrv.HandlerCapabilityInfo{Operations: []string{"ListImage", "ListImagePage", "GetImage"}, ReqFields: append(listReqFields, idrv.ImageFilterOwner, idrv.ImageFilterGuestOS, idrv.ImageFilterArchitecture, idrv.ImageFilterNamePattern, idrv.ImageFilterVisibility)}
+	drvCapabilityInfo.SecurityHandler = idrv.HandlerCapabilityInfo{Operations: []string{"CreateSecurity", "ListSecurity", "ListSecurityPage", "GetSecurity", "DeleteSecurity"}, ReqFields: listReqFields}
+	drvCapabilityInfo.KeyPairHandler = idrv.HandlerCapabilityInfo{Operations: []string{"CreateKey", "ListKey", "ListKeyPage", "GetKey", "DeleteKey"}, ReqFields: listReqFields}
+	drvCapabilityInfo.PublicIPHandler = idrv.HandlerCapabilityInfo{Operations: []string{"CreatePublicIP", "ListPublicIP", "ListPublicIPPage", "GetPublicIP", "DeletePublicIP", "AssociatePublicIP", "DisassociatePublicIP"}, ReqFields: listReqFields}
+	drvCapabilityInfo.VMHandler = idrv.HandlerCapabilityInfo{Operations: vmOperations, ReqFields: listReqFields}
 
 	return drvCapabilityInfo
 }
 
-func getVMClient(regionInfo idrv.RegionInfo) (*ec2.EC2, error) {
+// 하나의 EC2 client를 모든 Handler가 공유 함. transport는 Close()에서 해제 함.
+func getVMClient(regionInfo idrv.RegionInfo, transport *http.Transport) (*ec2.EC2, error) {
 	// setup Region
-	fmt.Println("AwsDriver : getVMClient() - Region : [" + regionInfo.Region + "]")
+	cblogger.Debug("getVMClient() - Region : [" + regionInfo.Region + "]")
 
 	sess, err := session.NewSession(&aws.Config{
-		Region: aws.String(regionInfo.Region)},
-	)
+		Region:     aws.String(regionInfo.Region),
+		HTTPClient: &http.Client{Transport: transport},
+	})
 	if err != nil {
-		fmt.Println("Could not create aws New Session", err)
+		cblogger.Error("Could not create aws New Session : ", err)
 		return nil, err
 	}
 
 	// Create EC2 service client
 	svc := ec2.New(sess)
-	if err != nil {
-		fmt.Println("Could not create EC2 service client", err)
-		return nil, err
-	}
 
 	return svc, nil
 }
@@ -76,7 +81,8 @@ func (driver *AwsDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (icon.
 	// sample code, do not user like this^^
 	//var iConn icon.CloudConnection
 	//VMClient, err := getVMClient(connectionInfo.CredentialInfo)
-	vmClient, err := getVMClient(connectionInfo.RegionInfo)
+	transport := idrv.NewHTTPTransport()
+	vmClient, err := getVMClient(connectionInfo.RegionInfo, transport)
 	if err != nil {
 		return nil, err
 	}
@@ -92,6 +98,8 @@ func (driver *AwsDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (icon.
 		ImageClient:    vmClient,
 		PublicIPClient: vmClient,
 		SecurityClient: vmClient,
+
+		HTTPTransport: transport,
 	}
 
 	return &iConn, nil // return type: (icon.CloudConnection, error)
@@ -111,3 +119,6 @@ func (AwsDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (icon.CloudCon
 }
 */
 var TestDriver AwsDriver
+
+// checked by the driver manager before using TestDriver.
+var DriverManifest = idrv.NewDriverManifest("AWS", &TestDriver)
@@ -0,0 +1,20 @@
+// Proof of Concepts of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This registers the Aws Driver into the static driver registry.
+// The plugin(.so) build uses only AwsDriver.go and its exported TestDriver.
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package aws
+
+import (
+	registry "github.com/cloud-barista/poc-cb-spider/cloud-driver/driver-registry"
+)
+
+func init() {
+	registry.Register("aws", &TestDriver)
+}
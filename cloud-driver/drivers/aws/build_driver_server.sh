@@ -0,0 +1,2 @@
+rm -rf /tmp/AwsDriverServer
+go build -o /tmp/AwsDriverServer $CB_SPIDER_ROOT/cloud-driver/drivers/aws/grpc-server
@@ -11,15 +11,17 @@
 package connect
 
 import (
-	cblog "github.com/cloud-barista/cb-log"
 	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
-	"github.com/sirupsen/logrus"
+	"github.com/cloud-barista/poc-cb-spider/cloud-driver/logger"
 
 	ars "github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/aws/resources"
 
 	//ec2drv "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+
+	"net/http"
 )
 
 //type AwsCloudConnection struct{}
@@ -33,15 +35,12 @@ type AwsCloudConnection struct {
 	ImageClient    *ec2.EC2
 	PublicIPClient *ec2.EC2
 	SecurityClient *ec2.EC2
-}
-
-var cblogger *logrus.Logger
 
-func init() {
-	// cblog is a global variable.
-	cblogger = cblog.GetLogger("AWS Connect")
+	HTTPTransport *http.Transport // owned by this connection, released by Close()
 }
 
+var cblogger = logger.New("AWS", "Connect")
+
 func (cloudConn *AwsCloudConnection) CreateKeyPairHandler() (irs.KeyPairHandler, error) {
 	cblogger.Info("Start CreateKeyPairHandler()")
 
@@ -53,14 +52,26 @@ func (cloudConn *AwsCloudConnection) CreateKeyPairHandler() (irs.KeyPairHandler,
 func (cloudConn *AwsCloudConnection) CreateVMHandler() (irs.VMHandler, error) {
 	cblogger.Info("Start CreateVMHandler()")
 
-	vmHandler := ars.AwsVMHandler{cloudConn.Region, cloudConn.VMClient}
+	vmHandler := ars.AwsVMHandler{Region: cloudConn.Region, Client: cloudConn.VMClient}
 	return &vmHandler, nil
 }
 
+// IsConnected checks the credential with DescribeRegions of the connection region.
 func (cloudConn *AwsCloudConnection) IsConnected() (bool, error) {
+	_, err := cloudConn.VMClient.DescribeRegions(&ec2.DescribeRegionsInput{
+		RegionNames: []*string{aws.String(cloudConn.Region.Region)},
+	})
+	if err != nil {
+		return false, irs.ConnectionError(err)
+	}
 	return true, nil
 }
+
+// Close releases the idle HTTP connections of the EC2 client.
 func (cloudConn *AwsCloudConnection) Close() error {
+	if cloudConn.HTTPTransport != nil {
+		cloudConn.HTTPTransport.CloseIdleConnections()
+	}
 	return nil
 }
 
@@ -0,0 +1,25 @@
+// Proof of Concepts of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the out-of-process(gRPC) server of the Aws Driver.
+// It is started by the driver manager, not by hand.
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package main
+
+import (
+	"log"
+
+	awsdrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/aws"
+	grpcdriver "github.com/cloud-barista/poc-cb-spider/cloud-driver/grpc-driver"
+)
+
+func main() {
+	if err := grpcdriver.Serve(&awsdrv.TestDriver, awsdrv.DriverManifest); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,233 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// EC2 DryRun of the handlers (idrv.DryRunHandler)
+// EC2 API의 DryRun 옵션으로 권한과 파라미터만 검증 하며, 자원은 생성/변경 되지 않음.
+//
+// by powerkim@powerkim.co.kr, 2019.03.
+package resources
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+)
+
+// EC2는 DryRun 요청이 성공할 수 있으면 "DryRunOperation" 에러를 반환 함.
+func dryRunResult(err error) error {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "DryRunOperation" {
+		return nil
+	}
+	if err == nil {
+		return nil
+	}
+	cblogger.Error(err)
+	return err
+}
+
+// checkArgs는 args가 samples와 같은 타입인지 확인 함. args는 외부(ex. gRPC 드라이버)에서 올 수 있으므로 assertion 전에 확인 함.
+func checkArgs(method string, args []interface{}, samples ...interface{}) error {
+	if len(args) < len(samples) {
+		return irs.InvalidArgument("dry run of %s: %d args, want %d", method, len(args), len(samples))
+	}
+	for i, sample := range samples {
+		if reflect.TypeOf(args[i]) != reflect.TypeOf(sample) {
+			return irs.InvalidArgument("dry run of %s: arg %d is %T, want %T", method, i, args[i], sample)
+		}
+	}
+	return nil
+}
+
+func (vmHandler *AwsVMHandler) DryRun(method string, args []interface{}) error {
+	cblogger.Infof("DryRun : [%s]", method)
+
+	var err error
+	switch method {
+	case "StartVM":
+		if err := checkArgs(method, args, irs.VMReqInfo{}); err != nil {
+			return err
+		}
+		input := vmHandler.runInstancesInput(args[0].(irs.VMReqInfo), 1, 1)
+		input.DryRun = aws.Bool(true)
+		_, err = vmHandler.Client.RunInstances(input)
+	case "StartVMs":
+		if err := checkArgs(method, args, irs.VMReqInfo{}, 0, 0); err != nil {
+			return err
+		}
+		count, minCount := args[1].(int), args[2].(int)
+		if minCount < 1 {
+			minCount = 1
+		}
+		input := vmHandler.runInstancesInput(args[0].(irs.VMReqInfo), int64(minCount), int64(count))
+		input.DryRun = aws.Bool(true)
+		_, err = vmHandler.Client.RunInstances(input)
+	case "SuspendVM":
+		if err := checkArgs(method, args, ""); err != nil {
+			return err
+		}
+		_, err = vmHandler.Client.StopInstances(&ec2.StopInstancesInput{
+			InstanceIds: []*string{aws.String(args[0].(string))},
+			DryRun:      aws.Bool(true),
+		})
+	case "ResumeVM":
+		if err := checkArgs(method, args, ""); err != nil {
+			return err
+		}
+		_, err = vmHandler.Client.StartInstances(&ec2.StartInstancesInput{
+			InstanceIds: []*string{aws.String(args[0].(string))},
+			DryRun:      aws.Bool(true),
+		})
+	case "RebootVM":
+		if err := checkArgs(method, args, ""); err != nil {
+			return err
+		}
+		_, err = vmHandler.Client.RebootInstances(&ec2.RebootInstancesInput{
+			InstanceIds: []*string{aws.String(args[0].(string))},
+			DryRun:      aws.Bool(true),
+		})
+	case "TerminateVM":
+		if err := checkArgs(method, args, ""); err != nil {
+			return err
+		}
+		_, err = vmHandler.Client.TerminateInstances(&ec2.TerminateInstancesInput{
+			InstanceIds: []*string{aws.String(args[0].(string))},
+			DryRun:      aws.Bool(true),
+		})
+	case "ChangeVMSpec":
+		if err := checkArgs(method, args, "", ""); err != nil {
+			return err
+		}
+		// 실행 중인 EC2의 Stop/Start는 검증 하지 않고 타입 변경만 검증 함.
+		_, err = vmHandler.Client.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{
+			InstanceId: aws.String(args[0].(string)),
+			InstanceType: &ec2.AttributeValue{
+				Value: aws.String(args[1].(string)),
+			},
+			DryRun: aws.Bool(true),
+		})
+	default:
+		return irs.NotSupported("AWS VMHandler has no dry run of %s", method)
+	}
+	return dryRunResult(err)
+}
+
+func (keyPairHandler *AwsKeyPairHandler) DryRun(method string, args []interface{}) error {
+	cblogger.Infof("DryRun : [%s]", method)
+
+	var err error
+	switch method {
+	case "CreateKey":
+		if err := checkArgs(method, args, irs.KeyPairReqInfo{}); err != nil {
+			return err
+		}
+		_, err = keyPairHandler.Client.CreateKeyPair(&ec2.CreateKeyPairInput{
+			KeyName: aws.String(args[0].(irs.KeyPairReqInfo).Name),
+			DryRun:  aws.Bool(true),
+		})
+	case "DeleteKey":
+		if err := checkArgs(method, args, ""); err != nil {
+			return err
+		}
+		_, err = keyPairHandler.Client.DeleteKeyPair(&ec2.DeleteKeyPairInput{
+			KeyName: aws.String(args[0].(string)),
+			DryRun:  aws.Bool(true),
+		})
+	default:
+		return irs.NotSupported("AWS KeyPairHandler has no dry run of %s", method)
+	}
+	return dryRunResult(err)
+}
+
+// 보안 그룹 생성 후의 Ingress/Egress 규칙 추가는 보안 그룹 ID가 필요하므로 검증 하지 않음.
+func (securityHandler *AwsSecurityHandler) DryRun(method string, args []interface{}) error {
+	cblogger.Infof("DryRun : [%s]", method)
+
+	var err error
+	switch method {
+	case "CreateSecurity":
+		if err := checkArgs(method, args, irs.SecurityReqInfo{}); err != nil {
+			return err
+		}
+		securityReqInfo := args[0].(irs.SecurityReqInfo)
+		_, err = securityHandler.Client.CreateSecurityGroup(&ec2.CreateSecurityGroupInput{
+			GroupName:   aws.String(securityReqInfo.GroupName),
+			Description: aws.String(securityReqInfo.Description),
+			VpcId:       aws.String(securityReqInfo.VpcId),
+			DryRun:      aws.Bool(true),
+		})
+	case "DeleteSecurity":
+		if err := checkArgs(method, args, ""); err != nil {
+			return err
+		}
+		_, err = securityHandler.Client.DeleteSecurityGroup(&ec2.DeleteSecurityGroupInput{
+			GroupId: aws.String(args[0].(string)),
+			DryRun:  aws.Bool(true),
+		})
+	default:
+		return irs.NotSupported("AWS SecurityHandler has no dry run of %s", method)
+	}
+	return dryRunResult(err)
+}
+
+func (publicIpHandler *AwsPublicIPHandler) DryRun(method string, args []interface{}) error {
+	cblogger.Infof("DryRun : [%s]", method)
+
+	var err error
+	switch method {
+	case "CreatePublicIP":
+		_, err = publicIpHandler.Client.AllocateAddress(&ec2.AllocateAddressInput{
+			Domain: aws.String("vpc"),
+			DryRun: aws.Bool(true),
+		})
+	case "DeletePublicIP":
+		if err := checkArgs(method, args, ""); err != nil {
+			return err
+		}
+		_, err = publicIpHandler.Client.ReleaseAddress(&ec2.ReleaseAddressInput{
+			PublicIp: aws.String(args[0].(string)),
+			DryRun:   aws.Bool(true),
+		})
+	case "AssociatePublicIP":
+		if err := checkArgs(method, args, "", ""); err != nil {
+			return err
+		}
+		addr, describeErr := publicIpHandler.describeAddress(args[0].(string))
+		if describeErr != nil {
+			return describeErr
+		}
+		input := &ec2.AssociateAddressInput{
+			AllocationId:       addr.AllocationId,
+			AllowReassociation: aws.Bool(true),
+			DryRun:             aws.Bool(true),
+		}
+		if vmID := args[1].(string); strings.HasPrefix(vmID, "eni-") {
+			input.NetworkInterfaceId = aws.String(vmID)
+		} else {
+			input.InstanceId = aws.String(vmID)
+		}
+		_, err = publicIpHandler.Client.AssociateAddress(input)
+	case "DisassociatePublicIP":
+		if err := checkArgs(method, args, ""); err != nil {
+			return err
+		}
+		addr, describeErr := publicIpHandler.describeAddress(args[0].(string))
+		if describeErr != nil {
+			return describeErr
+		}
+		if addr.AssociationId == nil {
+			return nil
+		}
+		_, err = publicIpHandler.Client.DisassociateAddress(&ec2.DisassociateAddressInput{
+			AssociationId: addr.AssociationId,
+			DryRun:        aws.Bool(true),
+		})
+	default:
+		return irs.NotSupported("AWS PublicIPHandler has no dry run of %s", method)
+	}
+	return dryRunResult(err)
+}
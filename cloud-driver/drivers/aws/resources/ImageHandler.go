@@ -11,6 +11,12 @@
 package resources
 
 import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
@@ -26,12 +32,152 @@ func (imageHandler *AwsImageHandler) CreateImage(imageReqInfo irs.ImageReqInfo)
 	return irs.ImageInfo{}, nil
 }
 
-func (imageHandler *AwsImageHandler) ListImage() ([]*irs.ImageInfo, error) {
-	return nil, nil
+// 공개 AMI는 수만 개이므로 Owner, Visibility 필터가 없으면 계정 소유(self) AMI만 조회 하고,
+// Owner, NamePattern 없이 PUBLIC이면 Amazon과 Marketplace(publicImageOwners)의 AMI만 조회 함.
+// Owner, Architecture, NamePattern(Wildcard), Visibility는 EC2에서 필터링하고 GuestOS는 조회 후 필터링 함.
+func (imageHandler *AwsImageHandler) ListImage(imageFilterInfo irs.ImageFilterInfo) ([]*irs.ImageInfo, error) {
+	return imageHandler.listImage(imageFilterInfo, nil)
+}
+
+// DescribeImages는 페이징을 지원하지 않으므로 전체 조회 후 페이징 함.
+// NameFilter는 imageFilterInfo.NamePattern이 없을 때만 사용 함.
+func (imageHandler *AwsImageHandler) ListImagePage(imageFilterInfo irs.ImageFilterInfo, listReqInfo irs.ListReqInfo) (irs.ImagePageInfo, error) {
+	if imageFilterInfo.NamePattern == "" {
+		imageFilterInfo.NamePattern = listReqInfo.NameFilter
+	}
+
+	imageList, err := imageHandler.listImage(imageFilterInfo, listReqInfo.TagFilter)
+	if err != nil {
+		return irs.ImagePageInfo{}, err
+	}
+
+	start, end, nextToken, err := listReqInfo.LocalPage(len(imageList))
+	if err != nil {
+		return irs.ImagePageInfo{}, err
+	}
+	return irs.ImagePageInfo{ImageInfoList: imageList[start:end], NextToken: nextToken}, nil
+}
+
+// Owner, NamePattern 없는 PUBLIC 조회의 기본 Owner
+var publicImageOwners = []string{"amazon", "aws-marketplace"}
+
+func (imageHandler *AwsImageHandler) listImage(imageFilterInfo irs.ImageFilterInfo, tagFilter map[string]string) ([]*irs.ImageInfo, error) {
+	cblogger.Info(imageFilterInfo)
+
+	input := &ec2.DescribeImagesInput{}
+
+	switch {
+	case imageFilterInfo.Owner != "":
+		input.Owners = aws.StringSlice([]string{imageFilterInfo.Owner})
+	case imageFilterInfo.Visibility == "":
+		input.Owners = aws.StringSlice([]string{"self"})
+	case imageFilterInfo.Visibility == irs.PublicImage && imageFilterInfo.NamePattern == "":
+		input.Owners = aws.StringSlice(publicImageOwners)
+	}
+
+	var filters []*ec2.Filter
+	if imageFilterInfo.Architecture != "" {
+		filters = append(filters, &ec2.Filter{
+			Name:   aws.String("architecture"),
+			Values: aws.StringSlice([]string{irs.NormalizeArchitecture(imageFilterInfo.Architecture)}),
+		})
+	}
+	if imageFilterInfo.NamePattern != "" {
+		filters = append(filters, &ec2.Filter{
+			Name:   aws.String("name"),
+			Values: aws.StringSlice([]string{imageFilterInfo.NamePattern}),
+		})
+	}
+	if imageFilterInfo.Visibility != "" {
+		filters = append(filters, &ec2.Filter{
+			Name:   aws.String("is-public"),
+			Values: aws.StringSlice([]string{strconv.FormatBool(imageFilterInfo.Visibility == irs.PublicImage)}),
+		})
+	}
+	if irs.NormalizeGuestOS(imageFilterInfo.GuestOS) == "windows" {
+		filters = append(filters, &ec2.Filter{
+			Name:   aws.String("platform"),
+			Values: aws.StringSlice([]string{"windows"}),
+		})
+	}
+	for k, v := range tagFilter {
+		filters = append(filters, &ec2.Filter{
+			Name:   aws.String("tag:" + k),
+			Values: aws.StringSlice([]string{v}),
+		})
+	}
+	input.Filters = filters
+
+	result, err := imageHandler.Client.DescribeImages(input)
+	if err != nil {
+		cblogger.Errorf("Unable to describe images, %v", err)
+		return nil, err
+	}
+
+	var imageList []*irs.ImageInfo
+	for _, image := range result.Images {
+		imageInfo := ExtractImageDescribeInfo(image)
+		if !imageFilterInfo.Match(imageInfo) {
+			continue
+		}
+		imageList = append(imageList, &imageInfo)
+	}
+
+	cblogger.Infof("%d images", len(imageList))
+	return imageList, nil
 }
 
 func (imageHandler *AwsImageHandler) GetImage(imageID string) (irs.ImageInfo, error) {
-	return irs.ImageInfo{}, nil
+	cblogger.Infof("imageID : [%s]", imageID)
+
+	result, err := imageHandler.Client.DescribeImages(&ec2.DescribeImagesInput{
+		ImageIds: aws.StringSlice([]string{imageID}),
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to describe image, %v", err)
+		return irs.ImageInfo{}, err
+	}
+	if len(result.Images) == 0 {
+		return irs.ImageInfo{}, errors.New("image " + imageID + " does not exist")
+	}
+
+	return ExtractImageDescribeInfo(result.Images[0]), nil
+}
+
+// DescribeImages결과에서 AMI 정보 추출
+func ExtractImageDescribeInfo(image *ec2.Image) irs.ImageInfo {
+	imageInfo := irs.ImageInfo{
+		Id:           aws.StringValue(image.ImageId),
+		Name:         aws.StringValue(image.Name),
+		Architecture: irs.NormalizeArchitecture(aws.StringValue(image.Architecture)),
+		Visibility:   irs.PrivateImage,
+	}
+
+	if aws.BoolValue(image.Public) {
+		imageInfo.Visibility = irs.PublicImage
+	}
+
+	// Windows가 아니면 Platform 값이 없으므로 AMI 명칭이나 설명에서 OS를 추정 함.
+	if strings.EqualFold(aws.StringValue(image.Platform), "windows") {
+		imageInfo.GuestOS = "windows"
+	} else {
+		imageInfo.GuestOS = irs.NormalizeGuestOS(imageInfo.Name)
+		if imageInfo.GuestOS == "" {
+			imageInfo.GuestOS = irs.NormalizeGuestOS(aws.StringValue(image.Description))
+		}
+	}
+
+	for _, bdm := range image.BlockDeviceMappings {
+		if bdm.Ebs != nil && aws.StringValue(bdm.DeviceName) == aws.StringValue(image.RootDeviceName) {
+			imageInfo.SizeGB = aws.Int64Value(bdm.Ebs.VolumeSize)
+		}
+	}
+
+	if creationTime, err := time.Parse(time.RFC3339, aws.StringValue(image.CreationDate)); err == nil {
+		imageInfo.CreationTime = creationTime
+	}
+
+	return imageInfo
 }
 
 func (imageHandler *AwsImageHandler) DeleteImage(imageID string) (bool, error) {
@@ -6,7 +6,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
-	"github.com/davecgh/go-spew/spew"
 )
 
 type AwsKeyPairHandler struct {
@@ -26,8 +25,7 @@ type KeyPairInfo struct {
 func (keyPairHandler *AwsKeyPairHandler) ListKey() ([]*irs.KeyPairInfo, error) {
 	cblogger.Debug("Start ListKey()")
 	var keyPairList []*irs.KeyPairInfo
-	//spew.Dump(keyPairHandler)
-	cblogger.Info(keyPairHandler)
+	cblogger.Info(keyPairHandler.Region)
 
 	input := &ec2.DescribeKeyPairsInput{
 		KeyNames: []*string{
@@ -53,11 +51,50 @@ func (keyPairHandler *AwsKeyPairHandler) ListKey() ([]*irs.KeyPairInfo, error) {
 		keyPairList = append(keyPairList, keyPairInfo)
 	}
 
-	cblogger.Info(keyPairList)
-	spew.Dump(keyPairList)
+	cblogger.Dump("keyPairList", keyPairList)
 	return keyPairList, nil
 }
 
+// DescribeKeyPairs는 페이징을 지원하지 않으므로 전체 조회 후 페이징 함.
+func (keyPairHandler *AwsKeyPairHandler) ListKeyPage(listReqInfo irs.ListReqInfo) (irs.KeyPairPageInfo, error) {
+	cblogger.Info(listReqInfo)
+
+	input := &ec2.DescribeKeyPairsInput{}
+	if listReqInfo.NameFilter != "" {
+		input.Filters = append(input.Filters, &ec2.Filter{
+			Name:   aws.String("key-name"),
+			Values: aws.StringSlice([]string{listReqInfo.NameFilter}),
+		})
+	}
+	for k, v := range listReqInfo.TagFilter {
+		input.Filters = append(input.Filters, &ec2.Filter{
+			Name:   aws.String("tag:" + k),
+			Values: aws.StringSlice([]string{v}),
+		})
+	}
+
+	result, err := keyPairHandler.Client.DescribeKeyPairs(input)
+	if err != nil {
+		cblogger.Errorf("Unable to get key pairs, %v", err)
+		return irs.KeyPairPageInfo{}, err
+	}
+
+	start, end, nextToken, err := listReqInfo.LocalPage(len(result.KeyPairs))
+	if err != nil {
+		return irs.KeyPairPageInfo{}, err
+	}
+
+	keyPairPageInfo := irs.KeyPairPageInfo{NextToken: nextToken}
+	for _, pair := range result.KeyPairs[start:end] {
+		keyPairPageInfo.KeyPairInfoList = append(keyPairPageInfo.KeyPairInfoList, &irs.KeyPairInfo{
+			Name:        *pair.KeyName,
+			Fingerprint: *pair.KeyFingerprint,
+		})
+	}
+
+	return keyPairPageInfo, nil
+}
+
 func (keyPairHandler *AwsKeyPairHandler) CreateKey(keyPairReqInfo irs.KeyPairReqInfo) (irs.KeyPairInfo, error) {
 	cblogger.Infof("Start CreateKey(%s)", keyPairReqInfo)
 
@@ -74,8 +111,7 @@ func (keyPairHandler *AwsKeyPairHandler) CreateKey(keyPairReqInfo irs.KeyPairReq
 		return irs.KeyPairInfo{}, err
 	}
 
-	cblogger.Infof("Created key pair %q %s\n%s\n", *result.KeyName, *result.KeyFingerprint, *result.KeyMaterial)
-	spew.Dump(result)
+	cblogger.Infof("Created key pair %q %s", *result.KeyName, *result.KeyFingerprint)
 	keyPairInfo := irs.KeyPairInfo{
 		Name:        *result.KeyName,
 		Fingerprint: *result.KeyFingerprint,
@@ -13,13 +13,13 @@ package resources
 import (
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
-	"github.com/davecgh/go-spew/spew"
 )
 
 type AwsPublicIPHandler struct {
@@ -27,7 +27,7 @@ type AwsPublicIPHandler struct {
 	Client *ec2.EC2
 }
 
-//@TODO : EC2에 Public를 할당하는 Associate함수 필요 함.
+// publicIPReqInfo.Id에 VM(Instance) ID가 전달되면 생성된 EIP를 해당 EC2에 바로 할당 함.
 func (publicIpHandler *AwsPublicIPHandler) CreatePublicIP(publicIPReqInfo irs.PublicIPReqInfo) (irs.PublicIPInfo, error) {
 	cblogger.Info("Start : ", publicIPReqInfo)
 
@@ -46,28 +46,23 @@ func (publicIpHandler *AwsPublicIPHandler) CreatePublicIP(publicIPReqInfo irs.Pu
 		return irs.PublicIPInfo{}, err
 	}
 
-	spew.Dump(allocRes)
-	cblogger.Infof("EIP 생성 성공 - Public IP : [%s], Allocation Id : [%s]", *allocRes.PublicIp, *allocRes.AllocationId)
+	cblogger.Dump("allocRes", allocRes)
+	cblogger.Infof("Created EIP - Public IP : [%s], Allocation Id : [%s]", *allocRes.PublicIp, *allocRes.AllocationId)
 	publicIPInfo.Domain = *allocRes.Domain
 	publicIPInfo.PublicIp = *allocRes.PublicIp
 	publicIPInfo.PublicIpv4Pool = *allocRes.PublicIpv4Pool
 	publicIPInfo.AllocationId = *allocRes.AllocationId
 
-	cblogger.Infof("[%s] EC2에 [%s] IP 할당 시작", instanceID, *allocRes.PublicIp)
+	if instanceID == "" {
+		return publicIPInfo, nil
+	}
+
 	// EC2에 할당.
-	// Associate the new Elastic IP address with an existing EC2 instance.
-	assocRes, err := publicIpHandler.Client.AssociateAddress(&ec2.AssociateAddressInput{
-		AllocationId: allocRes.AllocationId,
-		InstanceId:   aws.String(instanceID),
-	})
-	if err != nil {
-		cblogger.Errorf("Unable to associate IP address with %s, %v", instanceID, err)
+	if _, err := publicIpHandler.AssociatePublicIP(*allocRes.PublicIp, instanceID); err != nil {
 		return irs.PublicIPInfo{}, err
 	}
-	spew.Dump(assocRes)
-	cblogger.Infof("[%s] EC2에 [%s] IP 할당 완료 - Allocation Id : [%s]", instanceID, *allocRes.PublicIp, *assocRes.AssociationId)
 
-	return publicIPInfo, nil
+	return publicIpHandler.GetPublicIP(*allocRes.PublicIp)
 }
 
 func (publicIpHandler *AwsPublicIPHandler) ListPublicIP() ([]*irs.PublicIPInfo, error) {
@@ -97,7 +92,7 @@ func (publicIpHandler *AwsPublicIPHandler) ListPublicIP() ([]*irs.PublicIPInfo,
 		cblogger.Info("Elastic IPs")
 		for _, addr := range result.Addresses {
 			cblogger.Info("*", fmtAddress(addr))
-			spew.Dump(addr)
+			cblogger.Dump("addr", addr)
 		}
 	}
 
@@ -142,32 +137,72 @@ func (publicIpHandler *AwsPublicIPHandler) GetPublicIP(publicIPID string) (irs.P
 		cblogger.Info("Elastic IPs")
 		for _, allocRes := range result.Addresses {
 			cblogger.Info("*", fmtAddress(allocRes))
-			spew.Dump(allocRes)
-			publicIPInfo.Domain = *allocRes.Domain
-			publicIPInfo.PublicIp = *allocRes.PublicIp
-			publicIPInfo.PublicIpv4Pool = *allocRes.PublicIpv4Pool
-			publicIPInfo.AllocationId = *allocRes.AllocationId
-
-			if !reflect.ValueOf(allocRes.AssociationId).IsNil() {
-				publicIPInfo.AssociationId = *allocRes.AssociationId           // AWS:연결ID
-				publicIPInfo.InstanceId = *allocRes.InstanceId                 // AWS:연결된 VM
-				publicIPInfo.NetworkInterfaceId = *allocRes.NetworkInterfaceId // AWS:연결된 Nic
-				publicIPInfo.NetworkInterfaceOwnerId = *allocRes.NetworkInterfaceOwnerId
-				publicIPInfo.PrivateIpAddress = *allocRes.PrivateIpAddress
-			}
+			cblogger.Dump("allocRes", allocRes)
+			publicIPInfo = ExtractPublicIPInfo(allocRes)
+		}
+	}
 
-			for _, t := range allocRes.Tags {
-				if *t.Key == "Name" {
-					publicIPInfo.Name = *t.Value
-					cblogger.Debug("명칭 : ", publicIPInfo.Name)
-					break
-				}
-			}
+	return publicIPInfo, nil
+}
+
+// DescribeAddresses는 페이징을 지원하지 않으므로 전체 조회 후 페이징 함.
+func (publicIpHandler *AwsPublicIPHandler) ListPublicIPPage(listReqInfo irs.ListReqInfo) (irs.PublicIPPageInfo, error) {
+	cblogger.Info(listReqInfo)
+
+	filters := []*ec2.Filter{
+		{
+			Name:   aws.String("domain"),
+			Values: aws.StringSlice([]string{"vpc"}),
+		},
+	}
+	result, err := publicIpHandler.Client.DescribeAddresses(&ec2.DescribeAddressesInput{
+		Filters: append(filters, listReqFilters(listReqInfo)...),
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to elastic IP address, %v", err)
+		return irs.PublicIPPageInfo{}, err
+	}
+
+	start, end, nextToken, err := listReqInfo.LocalPage(len(result.Addresses))
+	if err != nil {
+		return irs.PublicIPPageInfo{}, err
+	}
 
+	publicIPPageInfo := irs.PublicIPPageInfo{NextToken: nextToken}
+	for _, addr := range result.Addresses[start:end] {
+		publicIPInfo := ExtractPublicIPInfo(addr)
+		publicIPPageInfo.PublicIPInfoList = append(publicIPPageInfo.PublicIPInfoList, &publicIPInfo)
+	}
+
+	return publicIPPageInfo, nil
+}
+
+// DescribeAddresses결과에서 EIP 정보 추출
+func ExtractPublicIPInfo(allocRes *ec2.Address) irs.PublicIPInfo {
+	var publicIPInfo irs.PublicIPInfo
+
+	publicIPInfo.Domain = *allocRes.Domain
+	publicIPInfo.PublicIp = *allocRes.PublicIp
+	publicIPInfo.PublicIpv4Pool = *allocRes.PublicIpv4Pool
+	publicIPInfo.AllocationId = *allocRes.AllocationId
+
+	if !reflect.ValueOf(allocRes.AssociationId).IsNil() {
+		publicIPInfo.AssociationId = *allocRes.AssociationId           // AWS:연결ID
+		publicIPInfo.InstanceId = *allocRes.InstanceId                 // AWS:연결된 VM
+		publicIPInfo.NetworkInterfaceId = *allocRes.NetworkInterfaceId // AWS:연결된 Nic
+		publicIPInfo.NetworkInterfaceOwnerId = *allocRes.NetworkInterfaceOwnerId
+		publicIPInfo.PrivateIpAddress = *allocRes.PrivateIpAddress
+	}
+
+	for _, t := range allocRes.Tags {
+		if *t.Key == "Name" {
+			publicIPInfo.Name = *t.Value
+			cblogger.Debug("Name : ", publicIPInfo.Name)
+			break
 		}
 	}
 
-	return publicIPInfo, nil
+	return publicIPInfo
 }
 
 func (publicIpHandler *AwsPublicIPHandler) DeletePublicIP(publicIPID string) (bool, error) {
@@ -192,6 +227,85 @@ func (publicIpHandler *AwsPublicIPHandler) DeletePublicIP(publicIPID string) (bo
 		return false, err
 	}
 
-	fmt.Println(result)
+	cblogger.Dump("result", result)
+	return true, nil
+}
+
+// public-ip 필터로 EIP를 조회 함.
+func (publicIpHandler *AwsPublicIPHandler) describeAddress(publicIPID string) (*ec2.Address, error) {
+	result, err := publicIpHandler.Client.DescribeAddresses(&ec2.DescribeAddressesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name: aws.String("public-ip"),
+				Values: []*string{
+					aws.String(publicIPID),
+				},
+			},
+		},
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to elastic IP address, %v", err)
+		return nil, err
+	}
+
+	if len(result.Addresses) == 0 {
+		return nil, fmt.Errorf("elastic IP [%s] does not exist in %s region", publicIPID, *publicIpHandler.Client.Config.Region)
+	}
+
+	return result.Addresses[0], nil
+}
+
+// vmID가 "eni-"로 시작하면 Network Interface에, 그 외에는 EC2 Instance에 EIP를 할당 함.
+// 이미 다른 EC2에 할당된 EIP도 재할당(AllowReassociation) 되므로 Failover 시 IP 이동에 사용할 수 있음.
+func (publicIpHandler *AwsPublicIPHandler) AssociatePublicIP(publicIPID string, vmID string) (bool, error) {
+	cblogger.Infof("publicIPID : [%s], vmID : [%s]", publicIPID, vmID)
+
+	addr, err := publicIpHandler.describeAddress(publicIPID)
+	if err != nil {
+		return false, err
+	}
+
+	input := &ec2.AssociateAddressInput{
+		AllocationId:       addr.AllocationId,
+		AllowReassociation: aws.Bool(true),
+	}
+	if strings.HasPrefix(vmID, "eni-") {
+		input.NetworkInterfaceId = aws.String(vmID)
+	} else {
+		input.InstanceId = aws.String(vmID)
+	}
+
+	assocRes, err := publicIpHandler.Client.AssociateAddress(input)
+	if err != nil {
+		cblogger.Errorf("Unable to associate IP address with %s, %v", vmID, err)
+		return false, err
+	}
+
+	cblogger.Infof("Associated IP [%s] with [%s] - Association Id : [%s]", publicIPID, vmID, *assocRes.AssociationId)
+	return true, nil
+}
+
+func (publicIpHandler *AwsPublicIPHandler) DisassociatePublicIP(publicIPID string) (bool, error) {
+	cblogger.Infof("publicIPID : [%s]", publicIPID)
+
+	addr, err := publicIpHandler.describeAddress(publicIPID)
+	if err != nil {
+		return false, err
+	}
+
+	if addr.AssociationId == nil {
+		cblogger.Infof("IP [%s] is not associated with any EC2", publicIPID)
+		return true, nil
+	}
+
+	_, err = publicIpHandler.Client.DisassociateAddress(&ec2.DisassociateAddressInput{
+		AssociationId: addr.AssociationId,
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to disassociate IP address %s, %v", publicIPID, err)
+		return false, err
+	}
+
+	cblogger.Infof("Disassociated IP [%s]", publicIPID)
 	return true, nil
 }
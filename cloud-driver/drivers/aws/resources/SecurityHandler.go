@@ -18,7 +18,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
-	"github.com/davecgh/go-spew/spew"
 )
 
 type AwsSecurityHandler struct {
@@ -29,8 +28,7 @@ type AwsSecurityHandler struct {
 //@TODO : 존재하는 보안 그룹에 정책 추가하는 기능 필요
 //VPC 생략 시 활성화된 세션의 기본 VPC를 이용 함.
 func (securityHandler *AwsSecurityHandler) CreateSecurity(securityReqInfo irs.SecurityReqInfo) (irs.SecurityInfo, error) {
-	cblogger.Infof("securityReqInfo : ", securityReqInfo)
-	spew.Dump(securityReqInfo)
+	cblogger.Dump("securityReqInfo", securityReqInfo)
 
 	// Create the security group with the VPC, name and description.
 	createRes, err := securityHandler.Client.CreateSecurityGroup(&ec2.CreateSecurityGroupInput{
@@ -52,8 +50,7 @@ func (securityHandler *AwsSecurityHandler) CreateSecurity(securityReqInfo irs.Se
 		cblogger.Errorf("Unable to create security group %q, %v", securityReqInfo.GroupName, err)
 		return irs.SecurityInfo{}, err
 	}
-	cblogger.Debug("보안 그룹 생성완료")
-	spew.Dump(createRes)
+	cblogger.Dump("Created security group", createRes)
 
 	cblogger.Infof("Created security group %s with VPC %s.\n",
 		aws.StringValue(createRes.GroupId), securityReqInfo.VpcId)
@@ -150,6 +147,36 @@ func (securityHandler *AwsSecurityHandler) ListSecurity() ([]*irs.SecurityInfo,
 	return results, nil
 }
 
+// EC2의 NextToken/MaxResults로 페이징 함. (MaxResults 범위: 5~1000)
+func (securityHandler *AwsSecurityHandler) ListSecurityPage(listReqInfo irs.ListReqInfo) (irs.SecurityPageInfo, error) {
+	cblogger.Info(listReqInfo)
+
+	input := &ec2.DescribeSecurityGroupsInput{
+		Filters: listReqFilters(listReqInfo),
+	}
+	if listReqInfo.PageSize > 0 {
+		input.MaxResults = aws.Int64(pageSizeRange(listReqInfo.PageSize, 5, 1000))
+	}
+	if listReqInfo.NextToken != "" {
+		input.NextToken = aws.String(listReqInfo.NextToken)
+	}
+
+	result, err := securityHandler.Client.DescribeSecurityGroups(input)
+	if err != nil {
+		cblogger.Error(err.Error())
+		return irs.SecurityPageInfo{}, err
+	}
+
+	securityPageInfo := irs.SecurityPageInfo{}
+	for _, securityGroup := range result.SecurityGroups {
+		securityInfo := ExtractSecurityInfo(securityGroup)
+		securityPageInfo.SecurityInfoList = append(securityPageInfo.SecurityInfoList, &securityInfo)
+	}
+	securityPageInfo.NextToken = aws.StringValue(result.NextToken)
+
+	return securityPageInfo, nil
+}
+
 func (securityHandler *AwsSecurityHandler) GetSecurity(securityID string) (irs.SecurityInfo, error) {
 	cblogger.Infof("securityID : [%s]", securityID)
 	input := &ec2.DescribeSecurityGroupsInput{
@@ -183,7 +210,7 @@ func ExtractSecurityInfo(securityGroupResult *ec2.SecurityGroup) irs.SecurityInf
 	var ipPermissions []*irs.SecurityRuleInfo
 	var ipPermissionsEgress []*irs.SecurityRuleInfo
 
-	cblogger.Info("===[그룹아이디:%s]===", *securityGroupResult.GroupId)
+	cblogger.Infof("===[Group Id:%s]===", *securityGroupResult.GroupId)
 	ipPermissions = ExtractIpPermissions(securityGroupResult.IpPermissions)
 	cblogger.Info("InBouds : ", ipPermissions)
 	ipPermissionsEgress = ExtractIpPermissions(securityGroupResult.IpPermissionsEgress)
@@ -203,7 +230,7 @@ func ExtractSecurityInfo(securityGroupResult *ec2.SecurityGroup) irs.SecurityInf
 	}
 
 	//Name은 Tag의 "Name" 속성에만 저장됨
-	cblogger.Debug("Name Tag 찾기")
+	cblogger.Debug("Finding the Name Tag")
 	for _, t := range securityGroupResult.Tags {
 		if *t.Key == "Name" {
 			securityInfo.Name = *t.Value
@@ -237,7 +264,7 @@ func ExtractIpPermissions(ipPermissions []*ec2.IpPermission) []*irs.SecurityRule
 
 		//ipv4 처리
 		for _, ipv4 := range ip.IpRanges {
-			cblogger.Info("Inbound/Outbound 정보 조회 : ", *ip.IpProtocol)
+			cblogger.Info("Inbound/Outbound rule : ", *ip.IpProtocol)
 			securityRuleInfo := new(irs.SecurityRuleInfo)
 			securityRuleInfo.Cidr = *ipv4.CidrIp
 
@@ -273,7 +300,7 @@ func ExtractIpPermissions(ipPermissions []*ec2.IpPermission) []*irs.SecurityRule
 			if !reflect.ValueOf(ip.UserIdGroupPairs).IsNil() {
 				securityRuleInfo.Cidr = *ip.UserIdGroupPairs[0].GroupId
 			} else {
-				cblogger.Error("미지원 보안 그룹 형태 발견 - 구조 파악 필요 ", ip)
+				cblogger.Error("Unsupported security group rule : ", ip)
 			}
 		}
 		*/
@@ -289,7 +316,7 @@ func _ExtractIpPermissions(ipPermissions []*ec2.IpPermission) []*irs.SecurityRul
 	var results []*irs.SecurityRuleInfo
 
 	for _, ip := range ipPermissions {
-		cblogger.Info("Inbound/Outbound 정보 조회 : ", *ip.IpProtocol)
+		cblogger.Info("Inbound/Outbound rule : ", *ip.IpProtocol)
 		securityRuleInfo := new(irs.SecurityRuleInfo)
 
 		if !reflect.ValueOf(ip.FromPort).IsNil() {
@@ -311,7 +338,7 @@ func _ExtractIpPermissions(ipPermissions []*ec2.IpPermission) []*irs.SecurityRul
 			if !reflect.ValueOf(ip.UserIdGroupPairs).IsNil() {
 				securityRuleInfo.Cidr = *ip.UserIdGroupPairs[0].GroupId
 			} else {
-				cblogger.Error("미지원 보안 그룹 형태 발견 - 구조 파악 필요 ", ip)
+				cblogger.Error("Unsupported security group rule : ", ip)
 			}
 		}
 
@@ -7,6 +7,7 @@
 package resources
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strings"
@@ -15,26 +16,25 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
-	"github.com/davecgh/go-spew/spew"
-	"github.com/sirupsen/logrus"
 
-	cblog "github.com/cloud-barista/cb-log"
 	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+	"github.com/cloud-barista/poc-cb-spider/cloud-driver/logger"
 )
 
 type AwsVMHandler struct {
-	Region idrv.RegionInfo
-	Client *ec2.EC2
+	Region      idrv.RegionInfo
+	Client      *ec2.EC2
+	ClientToken string // ClientToken of RunInstances, EC2 returns the instances of the first request with the same token
 }
 
-var cblogger *logrus.Logger
-
-func init() {
-	// cblog is a global variable.
-	cblogger = cblog.GetLogger("AWS VMHandler")
+// WithContext implements idrv.ContextHandler, RunInstances of the handler uses the client token of ctx.
+func (vmHandler *AwsVMHandler) WithContext(ctx context.Context) interface{} {
+	return &AwsVMHandler{Region: vmHandler.Region, Client: vmHandler.Client, ClientToken: idrv.ClientTokenFrom(ctx)}
 }
 
+var cblogger = logger.New("AWS", "Resources")
+
 func Connect(region string) *ec2.EC2 {
 	// setup Region
 	sess, err := session.NewSession(&aws.Config{
@@ -42,7 +42,7 @@ func Connect(region string) *ec2.EC2 {
 	)
 
 	if err != nil {
-		fmt.Println("Could not create instance", err)
+		cblogger.Error("Could not create session : ", err)
 		return nil
 	}
 
@@ -53,58 +53,25 @@ func Connect(region string) *ec2.EC2 {
 }
 
 // @Todo : SecurityGroupId 배열 처리 방안
-// 1개의 VM만 생성되도록 수정 (MinCount / MaxCount 이용 안 함)
+// 1개의 VM만 생성되도록 수정 (MinCount / MaxCount 이용 안 함, 여러 개는 StartVMs 이용)
 //키페어 이름(예:mcloud-barista)은 아래 URL에 나오는 목록 중 "키페어 이름"의 값을 적으면 됨.
 //https://ap-northeast-2.console.aws.amazon.com/ec2/v2/home?region=ap-northeast-2#KeyPairs:sort=keyName
 func (vmHandler *AwsVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, error) {
 	cblogger.Info("Start VMHandler()::StartVM()")
-	spew.Dump(vmReqInfo)
+	cblogger.Dump("vmReqInfo", vmReqInfo)
 
-	imageID := vmReqInfo.ImageInfo.Id
-	instanceType := vmReqInfo.SpecID // "t2.micro"
-	minCount := aws.Int64(1)
-	maxCount := aws.Int64(1)
-	keyName := vmReqInfo.KeyPairInfo.Name
-	securityGroupID := vmReqInfo.SecurityInfo.Id // "sg-0df1c209ea1915e4b" - 미지정시 보안 그룹명이 "default"인 보안 그룹이 사용 됨.
-	subnetID := vmReqInfo.VNetworkInfo.Id        // "subnet-cf9ccf83" - 미지정시 기본 VPC의 기본 서브넷이 임의로 이용되며 PublicIP가 할당 됨.
-	baseName := vmReqInfo.Name                   //"mcloud-barista-VMHandlerTest"
+	baseName := vmReqInfo.Name //"mcloud-barista-VMHandlerTest"
 
 	cblogger.Info("Create EC2 Instance")
 
 	// Specify the details of the instance that you want to create.
-	runResult, err := vmHandler.Client.RunInstances(&ec2.RunInstancesInput{
-		ImageId:      aws.String(imageID),
-		InstanceType: aws.String(instanceType),
-		MinCount:     minCount,
-		MaxCount:     maxCount,
-		KeyName:      aws.String(keyName),
-
-		SecurityGroupIds: []*string{
-			aws.String(securityGroupID), // set a security group.
-		},
-
-		SubnetId: aws.String(subnetID), // set a subnet.
-	})
+	runResult, err := vmHandler.Client.RunInstances(vmHandler.runInstancesInput(vmReqInfo, 1, 1))
 	if err != nil {
 		cblogger.Errorf("Could not create instance", err)
 		return irs.VMInfo{}, err
 	}
 
 	cblogger.Info("Created instance", *runResult.Instances[0].InstanceId)
-	// Tag에 VM Name 설정
-	_, errtag := vmHandler.Client.CreateTags(&ec2.CreateTagsInput{
-		Resources: []*string{runResult.Instances[0].InstanceId},
-		Tags: []*ec2.Tag{
-			{
-				Key:   aws.String("Name"),
-				Value: aws.String(baseName),
-			},
-		},
-	})
-	if errtag != nil {
-		cblogger.Error("Could not create tags for instance", runResult.Instances[0].InstanceId, errtag)
-		return irs.VMInfo{}, errtag
-	}
 
 	//빠른 생성을 위해 Running 상태를 대기하지 않고 최소한의 정보만 리턴 함.
 	//Running 상태를 대기 후 Public Ip 등의 정보를 추출하려면 GetVM()을 호출해서 최신 정보를 다시 받아와야 함.
@@ -123,6 +90,95 @@ func (vmHandler *AwsVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, err
 	return vmInfo, nil
 }
 
+// EC2 RunInstances의 MinCount/MaxCount로 한 번에 생성 함.
+// EC2는 MinCount개를 생성할 수 없으면 하나도 생성하지 않음.
+func (vmHandler *AwsVMHandler) StartVMs(vmReqInfo irs.VMReqInfo, count int, minCount int) ([]*irs.VMBatchResult, error) {
+	cblogger.Infof("count : [%d] / minCount : [%d]", count, minCount)
+	if count < 1 || minCount > count {
+		return nil, fmt.Errorf("invalid count: %d, minCount: %d", count, minCount)
+	}
+
+	results := make([]*irs.VMBatchResult, count)
+	for i := range results {
+		results[i] = &irs.VMBatchResult{Name: irs.BatchVMName(vmReqInfo.Name, i)}
+	}
+
+	runMinCount := minCount
+	if runMinCount < 1 {
+		runMinCount = 1
+	}
+	runResult, err := vmHandler.Client.RunInstances(vmHandler.runInstancesInput(vmReqInfo, int64(runMinCount), int64(count)))
+	if err != nil {
+		cblogger.Errorf("Could not create instances: %v", err)
+		for _, result := range results {
+			result.Error = err
+		}
+		return results, err
+	}
+
+	for i, instance := range runResult.Instances {
+		cblogger.Info("Created instance", *instance.InstanceId)
+		results[i].VMInfo = ExtractDescribeInstances(&ec2.Reservation{Instances: []*ec2.Instance{instance}})
+		results[i].VMInfo.Name = results[i].Name
+
+		// Tag에 VM Name 설정, RunInstances의 TagSpecifications는 모든 VM에 같은 이름을 설정 함.
+		_, err := vmHandler.Client.CreateTags(&ec2.CreateTagsInput{
+			Resources: []*string{instance.InstanceId},
+			Tags: []*ec2.Tag{
+				{
+					Key:   aws.String("Name"),
+					Value: aws.String(results[i].Name),
+				},
+			},
+		})
+		if err != nil {
+			// 이름으로 찾을 수 없는 VM이 남지 않도록 삭제 함.
+			cblogger.Error("Could not create tags for instance", *instance.InstanceId, err)
+			vmHandler.TerminateVM(*instance.InstanceId)
+			results[i].Error, results[i].RolledBack = err, true
+		}
+	}
+	for i := len(runResult.Instances); i < count; i++ {
+		results[i].Error = fmt.Errorf("EC2 launched only %d of %d instances", len(runResult.Instances), count)
+	}
+
+	return results, irs.RollbackVMBatch(vmHandler, results, minCount)
+}
+
+// @Todo : SecurityGroupId 배열 처리 방안
+func (vmHandler *AwsVMHandler) runInstancesInput(vmReqInfo irs.VMReqInfo, minCount int64, maxCount int64) *ec2.RunInstancesInput {
+	input := &ec2.RunInstancesInput{
+		ImageId:      aws.String(vmReqInfo.ImageInfo.Id),
+		InstanceType: aws.String(vmReqInfo.SpecID), // "t2.micro"
+		MinCount:     aws.Int64(minCount),
+		MaxCount:     aws.Int64(maxCount),
+		KeyName:      aws.String(vmReqInfo.KeyPairInfo.Name),
+
+		SecurityGroupIds: []*string{
+			aws.String(vmReqInfo.SecurityInfo.Id), // "sg-0df1c209ea1915e4b" - 미지정시 보안 그룹명이 "default"인 보안 그룹이 사용 됨.
+		},
+
+		SubnetId: aws.String(vmReqInfo.VNetworkInfo.Id), // "subnet-cf9ccf83" - 미지정시 기본 VPC의 기본 서브넷이 임의로 이용되며 PublicIP가 할당 됨.
+
+		// Tag에 VM Name 설정, 생성과 함께 설정되므로 이름 없는 VM이 남지 않음.
+		TagSpecifications: []*ec2.TagSpecification{
+			{
+				ResourceType: aws.String(ec2.ResourceTypeInstance),
+				Tags: []*ec2.Tag{
+					{
+						Key:   aws.String("Name"),
+						Value: aws.String(vmReqInfo.Name),
+					},
+				},
+			},
+		},
+	}
+	if vmHandler.ClientToken != "" {
+		input.ClientToken = aws.String(vmHandler.ClientToken)
+	}
+	return input
+}
+
 //VM이 Running 상태일때까지 대기 함.
 func WaitForRun(svc *ec2.EC2, instanceID string) {
 	cblogger.Infof("EC2 ID : [%s]", instanceID)
@@ -136,7 +192,7 @@ func WaitForRun(svc *ec2.EC2, instanceID string) {
 	if err != nil {
 		cblogger.Errorf("failed to wait until instances exist: %v", err)
 	}
-	cblogger.Info("=========WaitForRun() 종료")
+	cblogger.Info("=========WaitForRun() finished")
 }
 
 func (vmHandler *AwsVMHandler) ResumeVM(vmID string) {
@@ -199,28 +255,28 @@ func (vmHandler *AwsVMHandler) RebootVM(vmID string) {
 		DryRun: aws.Bool(true),
 	}
 	result, err := vmHandler.Client.RebootInstances(input)
-	cblogger.Info("result 값 : ", result)
-	cblogger.Info("err 값 : ", err)
+	cblogger.Info("result : ", result)
+	cblogger.Info("err : ", err)
 
 	awsErr, ok := err.(awserr.Error)
-	cblogger.Info("ok 값 : ", ok)
-	cblogger.Info("awsErr 값 : ", awsErr)
+	cblogger.Info("ok : ", ok)
+	cblogger.Info("awsErr : ", awsErr)
 	if ok && awsErr.Code() == "DryRunOperation" {
-		cblogger.Info("Reboot 권한 있음 - awsErr.Code() : ", awsErr.Code())
+		cblogger.Info("Reboot is permitted - awsErr.Code() : ", awsErr.Code())
 
 		//DryRun 권한 해제 후 리부팅을 요청 함.
-		cblogger.Info("DryRun 권한 해제 후 리부팅을 요청 함.")
+		cblogger.Info("Requesting the reboot without DryRun.")
 		input.DryRun = aws.Bool(false)
 		result, err = vmHandler.Client.RebootInstances(input)
-		cblogger.Info("result 값 : ", result)
-		cblogger.Info("err 값 : ", err)
+		cblogger.Info("result : ", result)
+		cblogger.Info("err : ", err)
 		if err != nil {
 			cblogger.Error("Error", err)
 		} else {
 			cblogger.Info("Success", result)
 		}
 	} else { // This could be due to a lack of permissions
-		cblogger.Info("리부팅 권한이 없는 것같음.")
+		cblogger.Info("Reboot seems not permitted.")
 		cblogger.Error("Error", err)
 	}
 	return
@@ -244,6 +300,79 @@ func (vmHandler *AwsVMHandler) TerminateVM(vmID string) {
 	return
 }
 
+// EC2는 중지된 인스턴스만 타입을 변경할 수 있으므로 실행 중이면 중지 후 변경하고 다시 시작 함.
+// 진행 상태는 GetVMStatus로 확인 가능 함. (STOPPING -> STOPPED -> PENDING -> RUNNING)
+// 실행 중인 VM은 중지 후 변경하고 다시 시작 함. 중지 후 실패하면 VM을 다시 시작하고 실패 원인을 반환 함.
+func (vmHandler *AwsVMHandler) ChangeVMSpec(vmID string, specID string) (irs.VMInfo, error) {
+	cblogger.Infof("vmID : [%s] / specID : [%s]", vmID, specID)
+
+	describeInput := &ec2.DescribeInstancesInput{
+		InstanceIds: []*string{
+			aws.String(vmID),
+		},
+	}
+
+	wasRunning := vmHandler.GetVMStatus(vmID) == irs.VMStatus("RUNNING")
+	if wasRunning {
+		_, err := vmHandler.Client.StopInstances(&ec2.StopInstancesInput{
+			InstanceIds: []*string{
+				aws.String(vmID),
+			},
+		})
+		if err != nil {
+			cblogger.Error(err)
+			return irs.VMInfo{}, err
+		}
+
+		cblogger.Info("Waiting for EC2 to stop")
+		if err := vmHandler.Client.WaitUntilInstanceStopped(describeInput); err != nil {
+			cblogger.Error(err)
+			return irs.VMInfo{}, vmHandler.restartInstance(describeInput, err)
+		}
+	}
+
+	_, err := vmHandler.Client.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{
+		InstanceId: aws.String(vmID),
+		InstanceType: &ec2.AttributeValue{
+			Value: aws.String(specID),
+		},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		if wasRunning {
+			err = vmHandler.restartInstance(describeInput, err)
+		}
+		return irs.VMInfo{}, err
+	}
+
+	if wasRunning {
+		if err := vmHandler.startInstance(describeInput); err != nil {
+			cblogger.Error(err)
+			return irs.VMInfo{}, err
+		}
+	}
+
+	return vmHandler.GetVM(vmID), nil
+}
+
+// restartInstance starts the instance stopped by a failed ChangeVMSpec, and returns err with the error of the start.
+func (vmHandler *AwsVMHandler) restartInstance(describeInput *ec2.DescribeInstancesInput, err error) error {
+	if startErr := vmHandler.startInstance(describeInput); startErr != nil {
+		cblogger.Error(startErr)
+		return fmt.Errorf("%w, and failed to restart %s: %v", err, aws.StringValue(describeInput.InstanceIds[0]), startErr)
+	}
+	return err
+}
+
+// startInstance starts the stopped EC2 instance and waits until it is running.
+func (vmHandler *AwsVMHandler) startInstance(describeInput *ec2.DescribeInstancesInput) error {
+	if _, err := vmHandler.Client.StartInstances(&ec2.StartInstancesInput{InstanceIds: describeInput.InstanceIds}); err != nil {
+		return err
+	}
+	cblogger.Info("Waiting for EC2 to start")
+	return vmHandler.Client.WaitUntilInstanceRunning(describeInput)
+}
+
 //- 보안그룹의 경우 멀티개 설정이 가능한데 현재는 1개만 입력 받음
 // @Todo : SecurityID에 보안그룹 Name을 할당하는게 맞는지 확인 필요
 func (vmHandler *AwsVMHandler) GetVM(vmID string) irs.VMInfo {
@@ -296,7 +425,7 @@ func ExtractDescribeInstances(reservation *ec2.Reservation) irs.VMInfo {
 	//"stopped" / "terminated" / "running" ...
 	var state string
 	state = *reservation.Instances[0].State.Name
-	cblogger.Info("EC2 상태 : [%s]", state)
+	cblogger.Infof("EC2 state : [%s]", state)
 
 	//VM상태와 무관하게 항상 값이 존재하는 항목들만 초기화
 	vmInfo := irs.VMInfo{
@@ -372,11 +501,11 @@ func ExtractDescribeInstances(reservation *ec2.Reservation) irs.VMInfo {
 	}
 
 	//Name은 Tag의 "Name" 속성에만 저장됨
-	cblogger.Debug("Name Tag 찾기")
+	cblogger.Debug("Finding the Name Tag")
 	for _, t := range reservation.Instances[0].Tags {
 		if *t.Key == "Name" {
 			vmInfo.Name = *t.Value
-			cblogger.Debug("EC2 명칭 : ", vmInfo.Name)
+			cblogger.Debug("EC2 name : ", vmInfo.Name)
 			break
 		}
 	}
@@ -414,7 +543,7 @@ func (vmHandler *AwsVMHandler) ListVM() []*irs.VMInfo {
 
 	for _, i := range result.Reservations {
 		for _, vm := range i.Instances {
-			cblogger.Info("[%s] EC2 정보 조회", *vm.InstanceId)
+			cblogger.Infof("[%s] EC2 info", *vm.InstanceId)
 			vmInfo := vmHandler.GetVM(*vm.InstanceId)
 			vmInfoList = append(vmInfoList, &vmInfo)
 		}
@@ -423,6 +552,67 @@ func (vmHandler *AwsVMHandler) ListVM() []*irs.VMInfo {
 	return vmInfoList
 }
 
+// EC2의 NextToken/MaxResults로 페이징 함. (MaxResults 범위: 5~1000)
+func (vmHandler *AwsVMHandler) ListVMPage(listReqInfo irs.ListReqInfo) (irs.VMPageInfo, error) {
+	cblogger.Info(listReqInfo)
+
+	input := &ec2.DescribeInstancesInput{
+		Filters: listReqFilters(listReqInfo),
+	}
+	if listReqInfo.PageSize > 0 {
+		input.MaxResults = aws.Int64(pageSizeRange(listReqInfo.PageSize, 5, 1000))
+	}
+	if listReqInfo.NextToken != "" {
+		input.NextToken = aws.String(listReqInfo.NextToken)
+	}
+
+	result, err := vmHandler.Client.DescribeInstances(input)
+	if err != nil {
+		cblogger.Error(err.Error())
+		return irs.VMPageInfo{}, err
+	}
+
+	vmPageInfo := irs.VMPageInfo{}
+	for _, reservation := range result.Reservations {
+		for _, vm := range reservation.Instances {
+			vmInfo := ExtractDescribeInstances(&ec2.Reservation{Instances: []*ec2.Instance{vm}})
+			vmPageInfo.VMInfoList = append(vmPageInfo.VMInfoList, &vmInfo)
+		}
+	}
+	vmPageInfo.NextToken = aws.StringValue(result.NextToken)
+
+	return vmPageInfo, nil
+}
+
+// ListReqInfo의 NameFilter(Name 태그 기준), TagFilter를 EC2 필터로 변환 함. (EC2 필터는 '*', '?' Wildcard를 지원 함)
+func listReqFilters(listReqInfo irs.ListReqInfo) []*ec2.Filter {
+	var filters []*ec2.Filter
+	if listReqInfo.NameFilter != "" {
+		filters = append(filters, &ec2.Filter{
+			Name:   aws.String("tag:Name"),
+			Values: aws.StringSlice([]string{listReqInfo.NameFilter}),
+		})
+	}
+	for k, v := range listReqInfo.TagFilter {
+		filters = append(filters, &ec2.Filter{
+			Name:   aws.String("tag:" + k),
+			Values: aws.StringSlice([]string{v}),
+		})
+	}
+	return filters
+}
+
+func pageSizeRange(pageSize int, min int64, max int64) int64 {
+	size := int64(pageSize)
+	if size < min {
+		return min
+	}
+	if size > max {
+		return max
+	}
+	return size
+}
+
 //SHUTTING-DOWN / TERMINATED
 func (vmHandler *AwsVMHandler) GetVMStatus(vmID string) irs.VMStatus {
 	cblogger.Infof("vmID : [%s]", vmID)
@@ -32,6 +32,11 @@ func (vNetworkHandler *AwsVNetworkHandler) CreateVNetwork(vNetworkReqInfo irs.VN
 	return irs.VNetworkInfo{}, nil
 }
 
+func (vNetworkHandler *AwsVNetworkHandler) ListVNetworkPage(listReqInfo irs.ListReqInfo) (irs.VNetworkPageInfo, error) {
+	cblogger.Info(listReqInfo)
+	return irs.VNetworkPageInfo{}, nil
+}
+
 func (vNetworkHandler *AwsVNetworkHandler) GetVNetwork(vNetworkID string) (irs.VNetworkInfo, error) {
 	cblogger.Info("vNetworkID : [%s]", vNetworkID)
 	//result, err := vNetworkHandler.Client.DescribeKeyPairs(input)
@@ -29,6 +29,10 @@ func (vNicHandler *AwsVNicHandler) ListVNic() ([]*irs.VNicInfo, error) {
 	return nil, nil
 }
 
+func (vNicHandler *AwsVNicHandler) ListVNicPage(listReqInfo irs.ListReqInfo) (irs.VNicPageInfo, error) {
+	return irs.VNicPageInfo{}, nil
+}
+
 func (vNicHandler *AwsVNicHandler) GetVNic(vNicID string) (irs.VNicInfo, error) {
 	return irs.VNicInfo{}, nil
 }
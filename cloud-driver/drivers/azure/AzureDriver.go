@@ -14,11 +14,13 @@ import (
 	"context"
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-06-01/subscriptions"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure/auth"
 	azcon "github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/azure/connect"
 	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
 	icon "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/connect"
-	"time"
+	"net/http"
 )
 
 type AzureDriver struct{}
@@ -27,169 +29,93 @@ func (AzureDriver) GetDriverVersion() string {
 	return "AZURE DRIVER Version 1.0"
 }
 
+// Azure는 KeyPair 리소스가 없으므로 KeyPairHandler를 지원하지 않음.
+// CreateImage(고정된 Disk ID)와 결과를 반환하지 않는 Create, List, Get(ex. GetPublicIP)은 Stub이므로 지원하지 않음.
 func (AzureDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 	var drvCapabilityInfo idrv.DriverCapabilityInfo
 
-	drvCapabilityInfo.ImageHandler = false
-	drvCapabilityInfo.VNetworkHandler = false
-	drvCapabilityInfo.SecurityHandler = false
-	drvCapabilityInfo.KeyPairHandler = false
-	drvCapabilityInfo.VNicHandler = false
-	drvCapabilityInfo.PublicIPHandler = false
-	drvCapabilityInfo.VMHandler = true
+	listReqFields := []string{idrv.ListPageSize, idrv.ListNextToken, idrv.ListNameFilter, idrv.ListTagFilter}
+	vmOperations := []string{"StartVM", "StartVMs", "SuspendVM", "ResumeVM", "RebootVM", "TerminateVM", "ChangeVMSpec", "ListVMStatus", "GetVMStatus", "ListVM", "ListVMPage", "GetVM"}
+
+	drvCapabilityInfo.ImageHandler = idrv.HandlerCapabilityInfo{Operations: []string{"ListImage", "ListImagePage", "GetImage", "DeleteImage"}, ReqFields: append(listReqFields, idrv.ImageFilterGuestOS, idrv.ImageFilterArchitecture, idrv.ImageFilterNamePattern, idrv.ImageFilterVisibility)}
+	drvCapabilityInfo.VNetworkHandler = idrv.HandlerCapabilityInfo{Operations: []string{"ListVNetworkPage", "DeleteVNetwork"}, ReqFields: listReqFields}
+	drvCapabilityInfo.SecurityHandler = idrv.HandlerCapabilityInfo{Operations: []string{"ListSecurityPage", "DeleteSecurity"}, ReqFields: listReqFields}
+	drvCapabilityInfo.VNicHandler = idrv.HandlerCapabilityInfo{Operations: []string{"ListVNicPage", "DeleteVNic"}, ReqFields: listReqFields}
+	drvCapabilityInfo.PublicIPHandler = idrv.HandlerCapabilityInfo{Operations: []string{"ListPublicIPPage", "DeletePublicIP", "AssociatePublicIP", "DisassociatePublicIP"}, ReqFields: listReqFields}
+	drvCapabilityInfo.VMHandler = idrv.HandlerCapabilityInfo{Operations: vmOperations, ReqFields: listReqFields}
 
 	return drvCapabilityInfo
 }
 
+// 모든 Client가 하나의 Authorizer, HTTP Transport, Context를 공유 함.
+// Ctx는 Close()에서 취소 되므로 Connection이 캐시되어 있는 동안 만료되지 않음.
 func (driver *AzureDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (icon.CloudConnection, error) {
 	// 1. get info of credential and region for Test A Cloud from connectionInfo.
 	// 2. create a client object(or service  object) of Test A Cloud with credential info.
 	// 3. create CloudConnection Instance of "connect/TDA_CloudConnection".
 	// 4. return CloudConnection Interface of TDA_CloudConnection.
 
-	Ctx, VMClient, err := getVMClient(connectionInfo.CredentialInfo)
-	if err != nil {
-		return nil, err
-	}
-	Ctx, imageClient, err := getImageClient(connectionInfo.CredentialInfo)
-	if err != nil {
-		return nil, err
-	}
-	Ctx, publicIPClient, err := getPublicIPClient(connectionInfo.CredentialInfo)
-	if err != nil {
-		return nil, err
-	}
-	Ctx, sgClient, err := getSecurityGroupClient(connectionInfo.CredentialInfo)
-	if err != nil {
-		return nil, err
-	}
-	Ctx, vNicClient, err := getVNicClient(connectionInfo.CredentialInfo)
-	if err != nil {
-		return nil, err
-	}
-	Ctx, SubnetClient, err := getSubnetClient(connectionInfo.CredentialInfo)
-	if err != nil {
-		return nil, err
-	}
-	Ctx, VNetClient, err := getVNetworkClient(connectionInfo.CredentialInfo)
+	credential := connectionInfo.CredentialInfo
+	authorizer, err := getAuthorizer(credential)
 	if err != nil {
 		return nil, err
 	}
+	transport := idrv.NewHTTPTransport()
+	sender := &http.Client{Transport: transport}
+
+	vmClient := compute.NewVirtualMachinesClient(credential.SubscriptionId)
+	setClient(&vmClient.Client, authorizer, sender)
+	imageClient := compute.NewImagesClient(credential.SubscriptionId)
+	setClient(&imageClient.Client, authorizer, sender)
+	publicIPClient := network.NewPublicIPAddressesClient(credential.SubscriptionId)
+	setClient(&publicIPClient.Client, authorizer, sender)
+	sgClient := network.NewSecurityGroupsClient(credential.SubscriptionId)
+	setClient(&sgClient.Client, authorizer, sender)
+	vNetClient := network.NewVirtualNetworksClient(credential.SubscriptionId)
+	setClient(&vNetClient.Client, authorizer, sender)
+	vNicClient := network.NewInterfacesClient(credential.SubscriptionId)
+	setClient(&vNicClient.Client, authorizer, sender)
+	subnetClient := network.NewSubnetsClient(credential.SubscriptionId)
+	setClient(&subnetClient.Client, authorizer, sender)
+	subscriptionClient := subscriptions.NewClient()
+	setClient(&subscriptionClient.Client, authorizer, sender)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
 	iConn := azcon.AzureCloudConnection{
 		Region:              connectionInfo.RegionInfo,
-		Ctx:                 Ctx,
-		VMClient:            VMClient,
-		ImageClient:         imageClient,
-		PublicIPClient:      publicIPClient,
-		SecurityGroupClient: sgClient,
-		VNetClient:          VNetClient,
-		VNicClient:          vNicClient,
-		SubnetClient:        SubnetClient,
+		Ctx:                 ctx,
+		VMClient:            &vmClient,
+		ImageClient:         &imageClient,
+		PublicIPClient:      &publicIPClient,
+		SecurityGroupClient: &sgClient,
+		VNetClient:          &vNetClient,
+		VNicClient:          &vNicClient,
+		SubnetClient:        &subnetClient,
+		SubscriptionClient:  &subscriptionClient,
+
+		CtxCancel:     cancel,
+		HTTPTransport: transport,
 	}
 	return &iConn, nil
 }
 
-func getVMClient(credential idrv.CredentialInfo) (context.Context, *compute.VirtualMachinesClient, error) {
+func getAuthorizer(credential idrv.CredentialInfo) (autorest.Authorizer, error) {
 	/*auth.NewClientCredentialsConfig()
 	  authorizer, err := auth.NewAuthorizerFromFile(azure.PublicCloud.ResourceManagerEndpoint)
 	  if err != nil {
 	      return nil, nil, err
 	  }*/
 	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
-	authorizer, err := config.Authorizer()
-	if err != nil {
-		return nil, nil, err
-	}
-
-	vmClient := compute.NewVirtualMachinesClient(credential.SubscriptionId)
-	vmClient.Authorizer = authorizer
-	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
-
-	return ctx, &vmClient, nil
+	return config.Authorizer()
 }
 
-func getImageClient(credential idrv.CredentialInfo) (context.Context, *compute.ImagesClient, error) {
-	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
-	authorizer, err := config.Authorizer()
-	if err != nil {
-		return nil, nil, err
-	}
-
-	imageClient := compute.NewImagesClient(credential.SubscriptionId)
-	imageClient.Authorizer = authorizer
-	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
-
-	return ctx, &imageClient, nil
-}
-
-func getPublicIPClient(credential idrv.CredentialInfo) (context.Context, *network.PublicIPAddressesClient, error) {
-	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
-	authorizer, err := config.Authorizer()
-	if err != nil {
-		return nil, nil, err
-	}
-
-	publicIPClient := network.NewPublicIPAddressesClient(credential.SubscriptionId)
-	publicIPClient.Authorizer = authorizer
-	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
-
-	return ctx, &publicIPClient, nil
-}
-
-func getSecurityGroupClient(credential idrv.CredentialInfo) (context.Context, *network.SecurityGroupsClient, error) {
-	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
-	authorizer, err := config.Authorizer()
-	if err != nil {
-		return nil, nil, err
-	}
-
-	sgClient := network.NewSecurityGroupsClient(credential.SubscriptionId)
-	sgClient.Authorizer = authorizer
-	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
-
-	return ctx, &sgClient, nil
-}
-
-func getVNetworkClient(credential idrv.CredentialInfo) (context.Context, *network.VirtualNetworksClient, error) {
-	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
-	authorizer, err := config.Authorizer()
-	if err != nil {
-		return nil, nil, err
-	}
-
-	vNetClient := network.NewVirtualNetworksClient(credential.SubscriptionId)
-	vNetClient.Authorizer = authorizer
-	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
-
-	return ctx, &vNetClient, nil
-}
-
-func getVNicClient(credential idrv.CredentialInfo) (context.Context, *network.InterfacesClient, error) {
-	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
-	authorizer, err := config.Authorizer()
-	if err != nil {
-		return nil, nil, err
-	}
-
-	vNicClient := network.NewInterfacesClient(credential.SubscriptionId)
-	vNicClient.Authorizer = authorizer
-	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
-
-	return ctx, &vNicClient, nil
-}
-
-func getSubnetClient(credential idrv.CredentialInfo) (context.Context, *network.SubnetsClient, error) {
-	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
-	authorizer, err := config.Authorizer()
-	if err != nil {
-		return nil, nil, err
-	}
-
-	subnetClient := network.NewSubnetsClient(credential.SubscriptionId)
-	subnetClient.Authorizer = authorizer
-	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
-
-	return ctx, &subnetClient, nil
+func setClient(client *autorest.Client, authorizer autorest.Authorizer, sender autorest.Sender) {
+	client.Authorizer = authorizer
+	client.Sender = sender
 }
 
 var TestDriver AzureDriver
+
+// checked by the driver manager before using TestDriver.
+var DriverManifest = idrv.NewDriverManifest("Azure", &TestDriver)
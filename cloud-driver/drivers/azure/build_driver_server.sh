@@ -0,0 +1,2 @@
+rm -rf /tmp/AzureDriverServer
+go build -o /tmp/AzureDriverServer $CB_SPIDER_ROOT/cloud-driver/drivers/azure/grpc-server
@@ -12,14 +12,18 @@ package connect
 
 import (
 	"context"
-	"fmt"
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-06-01/subscriptions"
 	azrs "github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/azure/resources"
 	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+	"github.com/cloud-barista/poc-cb-spider/cloud-driver/logger"
+	"net/http"
 )
 
+var cblogger = logger.New("Azure", "Connect")
+
 type AzureCloudConnection struct {
 	Region              idrv.RegionInfo
 	Ctx                 context.Context
@@ -30,48 +34,65 @@ type AzureCloudConnection struct {
 	VNetClient          *network.VirtualNetworksClient
 	VNicClient          *network.InterfacesClient
 	SubnetClient        *network.SubnetsClient
+	SubscriptionClient  *subscriptions.Client
+
+	CtxCancel     context.CancelFunc
+	HTTPTransport *http.Transport // 모든 Client가 공유, Close()에서 해제 함.
 }
 
 func (cloudConn *AzureCloudConnection) CreateVNetworkHandler() (irs.VNetworkHandler, error) {
-	fmt.Println("Azure Cloud Driver: called CreateVNetworkHandler()!")
+	cblogger.Debug("called CreateVNetworkHandler()")
 	vNetHandler := azrs.AzureVNetworkHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.VNetClient}
 	return &vNetHandler, nil
 }
 
 func (cloudConn *AzureCloudConnection) CreateImageHandler() (irs.ImageHandler, error) {
-	fmt.Println("Azure Cloud Driver: called CreateImageHandler()!")
+	cblogger.Debug("called CreateImageHandler()")
 	imageHandler := azrs.AzureImageHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.ImageClient}
 	return &imageHandler, nil
 }
 
 func (cloudConn *AzureCloudConnection) CreateSecurityHandler() (irs.SecurityHandler, error) {
-	fmt.Println("Azure Cloud Driver: called CreateSecurityHandler()!")
+	cblogger.Debug("called CreateSecurityHandler()")
 	sgHandler := azrs.AzureSecurityHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.SecurityGroupClient}
 	return &sgHandler, nil
 }
 func (AzureCloudConnection) CreateKeyPairHandler() (irs.KeyPairHandler, error) {
-	return nil, nil
+	return nil, irs.NotSupported("Azure driver does not support KeyPairHandler")
 }
 func (cloudConn *AzureCloudConnection) CreateVNicHandler() (irs.VNicHandler, error) {
-	fmt.Println("Azure Cloud Driver: called CreateVNicHandler()!")
+	cblogger.Debug("called CreateVNicHandler()")
 	vNicHandler := azrs.AzureVNicHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.VNicClient, cloudConn.SubnetClient}
 	return &vNicHandler, nil
 }
 func (cloudConn *AzureCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
-	fmt.Println("Azure Cloud Driver: called CreatePublicIPHandler()!")
-	publicIPHandler := azrs.AzurePublicIPHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.PublicIPClient}
+	cblogger.Debug("called CreatePublicIPHandler()")
+	publicIPHandler := azrs.AzurePublicIPHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.PublicIPClient, cloudConn.VNicClient}
 	return &publicIPHandler, nil
 }
 
 func (cloudConn *AzureCloudConnection) CreateVMHandler() (irs.VMHandler, error) {
-	fmt.Println("Azure Cloud Driver: called CreateVMHandler()!")
+	cblogger.Debug("called CreateVMHandler()")
 	vmHandler := azrs.AzureVMHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.VMClient}
 	return &vmHandler, nil
 }
 
-func (AzureCloudConnection) IsConnected() (bool, error) {
+// IsConnected checks the credential with a get of the subscription.
+func (cloudConn *AzureCloudConnection) IsConnected() (bool, error) {
+	_, err := cloudConn.SubscriptionClient.Get(cloudConn.Ctx, cloudConn.VMClient.SubscriptionID)
+	if err != nil {
+		return false, irs.ConnectionError(err)
+	}
 	return true, nil
 }
-func (AzureCloudConnection) Close() error {
+
+// Close cancels in-flight requests of the handlers and releases idle HTTP connections.
+func (cloudConn *AzureCloudConnection) Close() error {
+	if cloudConn.CtxCancel != nil {
+		cloudConn.CtxCancel()
+	}
+	if cloudConn.HTTPTransport != nil {
+		cloudConn.HTTPTransport.CloseIdleConnections()
+	}
 	return nil
 }
@@ -161,7 +161,7 @@ Loop:
 			switch commandNum {
 			case 1:
 				fmt.Println("Start ListImage() ...")
-				imageHandler.ListImage()
+				imageHandler.ListImage(irs.ImageFilterInfo{})
 				fmt.Println("Finish ListImage()")
 			case 2:
 				fmt.Println("Start GetImage() ...")
@@ -8,7 +8,6 @@ import (
 	"github.com/Azure/go-autorest/autorest/to"
 	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
-	"github.com/davecgh/go-spew/spew"
 	"strings"
 )
 
@@ -95,22 +94,67 @@ func (imageHandler *AzureImageHandler) CreateImage(imageReqInfo irs.ImageReqInfo
 	return irs.ImageInfo{}, nil
 }
 
-func (imageHandler *AzureImageHandler) ListImage() ([]*irs.ImageInfo, error) {
+// Resource Group의 Managed Image(Private)만 조회 함.
+// Marketplace(Public) 이미지는 Publisher:Offer:Sku:Version으로 VM 생성 시 직접 지정 함.
+func (imageHandler *AzureImageHandler) ListImage(imageFilterInfo irs.ImageFilterInfo) ([]*irs.ImageInfo, error) {
+	if imageFilterInfo.Visibility == irs.PublicImage {
+		return nil, errors.New("Azure driver lists only private(managed) images")
+	}
+
 	//resultList, err := imageHandler.Client.List(imageHandler.Ctx)
 	resultList, err := imageHandler.Client.ListByResourceGroup(imageHandler.Ctx, imageHandler.Region.ResourceGroup)
 	if err != nil {
-		panic(err)
+		return nil, err
+	}
+
+	var imageList []*irs.ImageInfo
+	for resultList.NotDone() {
+		for _, image := range resultList.Values() {
+			imageInfo := mappingImageInfo(image)
+			if imageFilterInfo.Match(imageInfo) {
+				imageList = append(imageList, &imageInfo)
+			}
+		}
+		if err := resultList.NextWithContext(imageHandler.Ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return imageList, nil
+}
+
+// NameFilter는 imageFilterInfo.NamePattern이 없을 때만 사용 하고, Resource Group 전체 조회 후 페이징 함.
+func (imageHandler *AzureImageHandler) ListImagePage(imageFilterInfo irs.ImageFilterInfo, listReqInfo irs.ListReqInfo) (irs.ImagePageInfo, error) {
+	if imageFilterInfo.NamePattern == "" {
+		imageFilterInfo.NamePattern = listReqInfo.NameFilter
+	}
+	if imageFilterInfo.Visibility == irs.PublicImage {
+		return irs.ImagePageInfo{}, errors.New("Azure driver lists only private(managed) images")
 	}
 
-	var imageList []*ImageInfo
-	for _, image := range resultList.Values() {
+	resultList, err := imageHandler.Client.ListByResourceGroup(imageHandler.Ctx, imageHandler.Region.ResourceGroup)
+	if err != nil {
+		return irs.ImagePageInfo{}, err
+	}
 
-		imageInfo := new(ImageInfo).setter(image)
-		imageList = append(imageList, imageInfo)
+	var imageList []*irs.ImageInfo
+	for resultList.NotDone() {
+		for _, image := range resultList.Values() {
+			imageInfo := mappingImageInfo(image)
+			if imageFilterInfo.Match(imageInfo) && listReqInfo.MatchTags(to.StringMap(image.Tags)) {
+				imageList = append(imageList, &imageInfo)
+			}
+		}
+		if err := resultList.NextWithContext(imageHandler.Ctx); err != nil {
+			return irs.ImagePageInfo{}, err
+		}
 	}
 
-	spew.Dump(imageList)
-	return nil, nil
+	start, end, nextToken, err := listReqInfo.LocalPage(len(imageList))
+	if err != nil {
+		return irs.ImagePageInfo{}, err
+	}
+	return irs.ImagePageInfo{ImageInfoList: imageList[start:end], NextToken: nextToken}, nil
 }
 
 func (imageHandler *AzureImageHandler) GetImage(imageID string) (irs.ImageInfo, error) {
@@ -118,13 +162,13 @@ func (imageHandler *AzureImageHandler) GetImage(imageID string) (irs.ImageInfo,
 
 	image, err := imageHandler.Client.Get(imageHandler.Ctx, imageIdArr[0], imageIdArr[1], "")
 	if err != nil {
-		panic(err)
+		return irs.ImageInfo{}, err
 	}
 
 	imageInfo := new(ImageInfo).setter(image)
 
-	spew.Dump(imageInfo)
-	return irs.ImageInfo{}, nil
+	cblogger.Dump("imageInfo", imageInfo)
+	return mappingImageInfo(image), nil
 }
 
 func (imageHandler *AzureImageHandler) DeleteImage(imageID string) (bool, error) {
@@ -140,3 +184,26 @@ func (imageHandler *AzureImageHandler) DeleteImage(imageID string) (bool, error)
 	}
 	return true, nil
 }
+
+func mappingImageInfo(image compute.Image) irs.ImageInfo {
+	imageInfo := irs.ImageInfo{
+		Id:   *image.ID,
+		Name: *image.Name,
+		// 2018-06-01 API의 Managed Image는 x86_64만 지원 함.
+		Architecture: "x86_64",
+		Visibility:   irs.PrivateImage,
+	}
+
+	if image.ImageProperties != nil && image.StorageProfile != nil && image.StorageProfile.OsDisk != nil {
+		osDisk := image.StorageProfile.OsDisk
+		imageInfo.GuestOS = irs.NormalizeGuestOS(imageInfo.Name)
+		if imageInfo.GuestOS == "" {
+			imageInfo.GuestOS = irs.NormalizeGuestOS(fmt.Sprint(osDisk.OsType))
+		}
+		if osDisk.DiskSizeGB != nil {
+			imageInfo.SizeGB = int64(*osDisk.DiskSizeGB)
+		}
+	}
+
+	return imageInfo
+}
@@ -5,16 +5,17 @@ import (
 	"errors"
 	"fmt"
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/Azure/go-autorest/autorest/to"
 	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
-	"github.com/davecgh/go-spew/spew"
 	"strings"
 )
 
 type AzurePublicIPHandler struct {
-	Region idrv.RegionInfo
-	Ctx    context.Context
-	Client *network.PublicIPAddressesClient
+	Region    idrv.RegionInfo
+	Ctx       context.Context
+	Client    *network.PublicIPAddressesClient
+	NicClient *network.InterfacesClient
 }
 
 // @TODO: PublicIP 리소스 프로퍼티 정의 필요
@@ -62,7 +63,10 @@ func (publicIpHandler *AzurePublicIPHandler) CreatePublicIP(publicIPReqInfo irs.
 		PublicIPIdleTimeoutInMinutes: 4,
 	}
 
-	publicIPArr := strings.Split(publicIPReqInfo.Id, ":")
+	publicIPArr, err := splitResourceID(publicIPReqInfo.Id)
+	if err != nil {
+		return irs.PublicIPInfo{}, err
+	}
 
 	// Check PublicIP Exists
 	publicIP, err := publicIpHandler.Client.Get(publicIpHandler.Ctx, publicIPArr[0], publicIPArr[1], "")
@@ -114,12 +118,42 @@ func (publicIpHandler *AzurePublicIPHandler) ListPublicIP() ([]*irs.PublicIPInfo
 		publicIPList = append(publicIPList, publicIPInfo)
 	}
 
-	spew.Dump(publicIPList)
+	cblogger.Dump("publicIPList", publicIPList)
 	return nil, nil
 }
 
+// Azure 목록 조회는 NextLink 기반이나 임의 위치부터 재개할 수 없으므로, Resource Group 전체 조회 후 페이징 함.
+func (publicIpHandler *AzurePublicIPHandler) ListPublicIPPage(listReqInfo irs.ListReqInfo) (irs.PublicIPPageInfo, error) {
+	resultList, err := publicIpHandler.Client.List(publicIpHandler.Ctx, publicIpHandler.Region.ResourceGroup)
+	if err != nil {
+		return irs.PublicIPPageInfo{}, err
+	}
+
+	var publicIPList []*irs.PublicIPInfo
+	for resultList.NotDone() {
+		for _, publicIP := range resultList.Values() {
+			if listReqInfo.MatchName(*publicIP.Name) && listReqInfo.MatchTags(to.StringMap(publicIP.Tags)) {
+				publicIPInfo := mappingPublicIPInfo(publicIpHandler.Region.ResourceGroup, publicIP)
+				publicIPList = append(publicIPList, &publicIPInfo)
+			}
+		}
+		if err := resultList.NextWithContext(publicIpHandler.Ctx); err != nil {
+			return irs.PublicIPPageInfo{}, err
+		}
+	}
+
+	start, end, nextToken, err := listReqInfo.LocalPage(len(publicIPList))
+	if err != nil {
+		return irs.PublicIPPageInfo{}, err
+	}
+	return irs.PublicIPPageInfo{PublicIPInfoList: publicIPList[start:end], NextToken: nextToken}, nil
+}
+
 func (publicIpHandler *AzurePublicIPHandler) GetPublicIP(publicIPID string) (irs.PublicIPInfo, error) {
-	publicIPArr := strings.Split(publicIPID, ":")
+	publicIPArr, err := splitResourceID(publicIPID)
+	if err != nil {
+		return irs.PublicIPInfo{}, err
+	}
 	publicIP, err := publicIpHandler.Client.Get(publicIpHandler.Ctx, publicIPArr[0], publicIPArr[1], "")
 	if err != nil {
 		return irs.PublicIPInfo{}, err
@@ -127,12 +161,15 @@ func (publicIpHandler *AzurePublicIPHandler) GetPublicIP(publicIPID string) (irs
 
 	publicIPInfo := new(PublicIPInfo).setter(publicIP)
 
-	spew.Dump(publicIPInfo)
+	cblogger.Dump("publicIPInfo", publicIPInfo)
 	return irs.PublicIPInfo{}, nil
 }
 
 func (publicIpHandler *AzurePublicIPHandler) DeletePublicIP(publicIPID string) (bool, error) {
-	publicIPArr := strings.Split(publicIPID, ":")
+	publicIPArr, err := splitResourceID(publicIPID)
+	if err != nil {
+		return false, err
+	}
 	future, err := publicIpHandler.Client.Delete(publicIpHandler.Ctx, publicIPArr[0], publicIPArr[1])
 	if err != nil {
 		return false, err
@@ -143,3 +180,146 @@ func (publicIpHandler *AzurePublicIPHandler) DeletePublicIP(publicIPID string) (
 	}
 	return true, nil
 }
+
+// Azure는 PublicIP를 VM이 아닌 NIC의 IPConfiguration에 할당 함.
+// 대상 NIC를 먼저 확인하고, 할당에 실패하면 이전 IPConfiguration에 다시 할당 함.
+// publicIPID, vNicID 모두 "{ResourceGroup}:{Name}" 형식
+func (publicIpHandler *AzurePublicIPHandler) AssociatePublicIP(publicIPID string, vNicID string) (bool, error) {
+	publicIPArr, err := splitResourceID(publicIPID)
+	if err != nil {
+		return false, err
+	}
+	vNicIDArr, err := splitResourceID(vNicID)
+	if err != nil {
+		return false, err
+	}
+	publicIP, err := publicIpHandler.Client.Get(publicIpHandler.Ctx, publicIPArr[0], publicIPArr[1], "")
+	if err != nil {
+		return false, err
+	}
+
+	vNic, err := publicIpHandler.NicClient.Get(publicIpHandler.Ctx, vNicIDArr[0], vNicIDArr[1], "")
+	if err != nil {
+		return false, err
+	}
+	if vNic.IPConfigurations == nil || len(*vNic.IPConfigurations) == 0 {
+		return false, irs.InvalidArgument("Virtual Network Interface %s has no IP configuration", vNicIDArr[1])
+	}
+
+	// 다른 NIC에 할당되어 있으면 먼저 해제
+	oldIPConfigID := ""
+	if publicIP.IPConfiguration != nil && publicIP.IPConfiguration.ID != nil {
+		oldIPConfigID = *publicIP.IPConfiguration.ID
+		if err := publicIpHandler.setIPConfigPublicIP(oldIPConfigID, nil); err != nil {
+			return false, err
+		}
+		// 해제로 대상 NIC가 변경되었을 수 있으므로 다시 조회
+		vNic, err = publicIpHandler.NicClient.Get(publicIpHandler.Ctx, vNicIDArr[0], vNicIDArr[1], "")
+		if err != nil {
+			return false, publicIpHandler.restoreIPConfig(oldIPConfigID, publicIP, err)
+		}
+	}
+
+	// Primary IPConfiguration에 할당
+	ipConfigs := *vNic.IPConfigurations
+	idx := 0
+	for i, ipConfig := range ipConfigs {
+		if ipConfig.Primary != nil && *ipConfig.Primary {
+			idx = i
+			break
+		}
+	}
+	ipConfigs[idx].PublicIPAddress = &network.PublicIPAddress{ID: publicIP.ID}
+
+	err = publicIpHandler.updateVNic(vNicIDArr[0], vNicIDArr[1], vNic)
+	if err != nil {
+		return false, publicIpHandler.restoreIPConfig(oldIPConfigID, publicIP, err)
+	}
+	return true, nil
+}
+
+func (publicIpHandler *AzurePublicIPHandler) DisassociatePublicIP(publicIPID string) (bool, error) {
+	publicIPArr, err := splitResourceID(publicIPID)
+	if err != nil {
+		return false, err
+	}
+	publicIP, err := publicIpHandler.Client.Get(publicIpHandler.Ctx, publicIPArr[0], publicIPArr[1], "")
+	if err != nil {
+		return false, err
+	}
+	if publicIP.IPConfiguration == nil || publicIP.IPConfiguration.ID == nil {
+		return true, nil
+	}
+
+	err = publicIpHandler.setIPConfigPublicIP(*publicIP.IPConfiguration.ID, nil)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// 실패한 AssociatePublicIP가 해제한 PublicIP를 이전 IPConfiguration에 다시 할당하고, 복원도 실패하면 그 원인을 err에 덧붙여 반환 함.
+func (publicIpHandler *AzurePublicIPHandler) restoreIPConfig(ipConfigID string, publicIP network.PublicIPAddress, err error) error {
+	if ipConfigID == "" {
+		return err
+	}
+	if restoreErr := publicIpHandler.setIPConfigPublicIP(ipConfigID, &network.PublicIPAddress{ID: publicIP.ID}); restoreErr != nil {
+		cblogger.Errorf("failed to restore the public IP %s to %s: %v", to.String(publicIP.Name), ipConfigID, restoreErr)
+		return fmt.Errorf("%w, and failed to restore the public IP to %s: %v", err, ipConfigID, restoreErr)
+	}
+	return err
+}
+
+// ipConfigID의 IPConfiguration에 publicIP를 할당 함. publicIP가 nil이면 해제 함.
+func (publicIpHandler *AzurePublicIPHandler) setIPConfigPublicIP(ipConfigID string, publicIP *network.PublicIPAddress) error {
+	// IPConfiguration ID 형식
+	// /subscriptions/{id}/resourceGroups/{rg}/providers/Microsoft.Network/networkInterfaces/{nic}/ipConfigurations/{name}
+	ipConfigIDArr := strings.Split(ipConfigID, "/")
+	if len(ipConfigIDArr) < 9 {
+		return errors.New(fmt.Sprintf("Invalid IPConfiguration ID %s", ipConfigID))
+	}
+	rsgName, vNicName := ipConfigIDArr[4], ipConfigIDArr[8]
+
+	vNic, err := publicIpHandler.NicClient.Get(publicIpHandler.Ctx, rsgName, vNicName, "")
+	if err != nil {
+		return err
+	}
+	if vNic.IPConfigurations == nil {
+		return errors.New(fmt.Sprintf("Virtual Network Interface %s has no IP configuration", vNicName))
+	}
+	for i, ipConfig := range *vNic.IPConfigurations {
+		if ipConfig.ID != nil && strings.EqualFold(*ipConfig.ID, ipConfigID) {
+			(*vNic.IPConfigurations)[i].PublicIPAddress = publicIP
+		}
+	}
+	return publicIpHandler.updateVNic(rsgName, vNicName, vNic)
+}
+
+func (publicIpHandler *AzurePublicIPHandler) updateVNic(rsgName string, vNicName string, vNic network.Interface) error {
+	future, err := publicIpHandler.NicClient.CreateOrUpdate(publicIpHandler.Ctx, rsgName, vNicName, vNic)
+	if err != nil {
+		return err
+	}
+	return future.WaitForCompletionRef(publicIpHandler.Ctx, publicIpHandler.NicClient.Client)
+}
+
+// splitResourceID splits an ID of "{ResourceGroup}:{Name}" format.
+func splitResourceID(id string) ([]string, error) {
+	idArr := strings.Split(id, ":")
+	if len(idArr) != 2 || idArr[0] == "" || idArr[1] == "" {
+		return nil, irs.InvalidArgument("invalid ID %s, ID is {ResourceGroup}:{Name}", id)
+	}
+	return idArr, nil
+}
+
+func mappingPublicIPInfo(resourceGroup string, publicIP network.PublicIPAddress) irs.PublicIPInfo {
+	publicIPInfo := irs.PublicIPInfo{
+		Name:   *publicIP.Name,
+		Id:     resourceGroup + ":" + *publicIP.Name,
+		Region: *publicIP.Location,
+	}
+	if publicIP.PublicIPAddressPropertiesFormat != nil && publicIP.IPAddress != nil {
+		publicIPInfo.PublicIp = *publicIP.IPAddress
+	}
+	return publicIPInfo
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/Azure/go-autorest/autorest/to"
 	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
-	"github.com/davecgh/go-spew/spew"
 	"strings"
 )
 
@@ -182,10 +181,37 @@ func (securityHandler *AzureSecurityHandler) ListSecurity() ([]*irs.SecurityInfo
 		securityList = append(securityList, securityInfo)
 	}
 
-	spew.Dump(securityList)
+	cblogger.Dump("securityList", securityList)
 	return nil, nil
 }
 
+// Azure 목록 조회는 NextLink 기반이나 임의 위치부터 재개할 수 없으므로, Resource Group 전체 조회 후 페이징 함.
+func (securityHandler *AzureSecurityHandler) ListSecurityPage(listReqInfo irs.ListReqInfo) (irs.SecurityPageInfo, error) {
+	resultList, err := securityHandler.Client.List(securityHandler.Ctx, securityHandler.Region.ResourceGroup)
+	if err != nil {
+		return irs.SecurityPageInfo{}, err
+	}
+
+	var securityList []*irs.SecurityInfo
+	for resultList.NotDone() {
+		for _, security := range resultList.Values() {
+			if listReqInfo.MatchName(*security.Name) && listReqInfo.MatchTags(to.StringMap(security.Tags)) {
+				securityInfo := mappingSecurityInfo(securityHandler.Region.ResourceGroup, security)
+				securityList = append(securityList, &securityInfo)
+			}
+		}
+		if err := resultList.NextWithContext(securityHandler.Ctx); err != nil {
+			return irs.SecurityPageInfo{}, err
+		}
+	}
+
+	start, end, nextToken, err := listReqInfo.LocalPage(len(securityList))
+	if err != nil {
+		return irs.SecurityPageInfo{}, err
+	}
+	return irs.SecurityPageInfo{SecurityInfoList: securityList[start:end], NextToken: nextToken}, nil
+}
+
 func (securityHandler *AzureSecurityHandler) GetSecurity(securityID string) (irs.SecurityInfo, error) {
 	securityIdArr := strings.Split(securityID, ":")
 	security, err := securityHandler.Client.Get(securityHandler.Ctx, securityIdArr[0], securityIdArr[1], "")
@@ -195,7 +221,7 @@ func (securityHandler *AzureSecurityHandler) GetSecurity(securityID string) (irs
 
 	securityInfo := new(SecurityInfo).setter(security)
 
-	spew.Dump(securityInfo)
+	cblogger.Dump("securityInfo", securityInfo)
 	return irs.SecurityInfo{}, nil
 }
 
@@ -211,3 +237,11 @@ func (securityHandler *AzureSecurityHandler) DeleteSecurity(securityID string) (
 	}
 	return true, nil
 }
+
+func mappingSecurityInfo(resourceGroup string, securityGroup network.SecurityGroup) irs.SecurityInfo {
+	return irs.SecurityInfo{
+		Name:      *securityGroup.Name,
+		Id:        resourceGroup + ":" + *securityGroup.Name,
+		GroupName: *securityGroup.Name,
+	}
+}
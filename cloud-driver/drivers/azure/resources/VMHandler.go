@@ -18,11 +18,14 @@ import (
 	"github.com/Azure/go-autorest/autorest/to"
 	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+	"github.com/cloud-barista/poc-cb-spider/cloud-driver/logger"
 	"io/ioutil"
 	"os"
 	"strings"
 )
 
+var cblogger = logger.New("Azure", "Resources")
+
 type AzureVMHandler struct {
 	Region idrv.RegionInfo
 	Ctx    context.Context
@@ -122,6 +125,11 @@ func (vmHandler *AzureVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, e
 	return vmInfo, nil
 }
 
+// 일괄 생성 API가 없으므로 StartVM을 병렬로 호출 함.
+func (vmHandler *AzureVMHandler) StartVMs(vmReqInfo irs.VMReqInfo, count int, minCount int) ([]*irs.VMBatchResult, error) {
+	return irs.StartVMsConcurrently(vmHandler, vmReqInfo, count, minCount)
+}
+
 func (vmHandler *AzureVMHandler) SuspendVM(vmID string) {
 	vmIdArr := strings.Split(vmID, ":")
 
@@ -175,6 +183,96 @@ func (vmHandler *AzureVMHandler) TerminateVM(vmID string) {
 	}
 }
 
+// 현재 클러스터에서 사용 가능한 Size면 실행 중에 변경(Azure가 재시작) 하고,
+// 아니면 Deallocate 후 변경하고 실행 중이었던 VM만 다시 시작 함. Deallocate 후 실패해도 다시 시작 함.
+// 진행 상태는 GetVMStatus로 확인 가능 함.
+func (vmHandler *AzureVMHandler) ChangeVMSpec(vmID string, specID string) (irs.VMInfo, error) {
+	vmIdArr := strings.Split(vmID, ":")
+
+	sizeList, err := vmHandler.Client.ListAvailableSizes(vmHandler.Ctx, vmIdArr[0], vmIdArr[1])
+	if err != nil {
+		return irs.VMInfo{}, err
+	}
+	available := false
+	if sizeList.Value != nil {
+		for _, size := range *sizeList.Value {
+			if size.Name != nil && strings.EqualFold(*size.Name, specID) {
+				available = true
+				break
+			}
+		}
+	}
+
+	wasRunning := false
+	if !available {
+		instanceView, err := vmHandler.Client.InstanceView(vmHandler.Ctx, vmIdArr[0], vmIdArr[1])
+		if err != nil {
+			return irs.VMInfo{}, err
+		}
+		wasRunning = instanceView.Statuses != nil && strings.HasPrefix(getVmStatus(instanceView), "running")
+
+		future, err := vmHandler.Client.Deallocate(vmHandler.Ctx, vmIdArr[0], vmIdArr[1])
+		if err != nil {
+			return irs.VMInfo{}, err
+		}
+		err = future.WaitForCompletionRef(vmHandler.Ctx, vmHandler.Client.Client)
+		if err != nil {
+			if wasRunning {
+				err = vmHandler.restartVM(vmIdArr, err)
+			}
+			return irs.VMInfo{}, err
+		}
+	}
+
+	vmOpts := compute.VirtualMachineUpdate{
+		VirtualMachineProperties: &compute.VirtualMachineProperties{
+			HardwareProfile: &compute.HardwareProfile{
+				VMSize: compute.VirtualMachineSizeTypes(specID),
+			},
+		},
+	}
+	future, err := vmHandler.Client.Update(vmHandler.Ctx, vmIdArr[0], vmIdArr[1], vmOpts)
+	if err == nil {
+		err = future.WaitForCompletionRef(vmHandler.Ctx, vmHandler.Client.Client)
+	}
+	if err != nil {
+		if wasRunning {
+			err = vmHandler.restartVM(vmIdArr, err)
+		}
+		return irs.VMInfo{}, err
+	}
+
+	if wasRunning {
+		if err := vmHandler.startVM(vmIdArr); err != nil {
+			return irs.VMInfo{}, err
+		}
+	}
+
+	vm, err := vmHandler.Client.Get(vmHandler.Ctx, vmIdArr[0], vmIdArr[1], compute.InstanceView)
+	if err != nil {
+		return irs.VMInfo{}, err
+	}
+	return mappingServerInfo(vm), nil
+}
+
+// restartVM starts the VM deallocated by a failed ChangeVMSpec, and returns err with the error of the start.
+func (vmHandler *AzureVMHandler) restartVM(vmIdArr []string, err error) error {
+	if startErr := vmHandler.startVM(vmIdArr); startErr != nil {
+		cblogger.Error(startErr)
+		return fmt.Errorf("%w, and failed to restart %s: %v", err, vmIdArr[1], startErr)
+	}
+	return err
+}
+
+// startVM starts the deallocated VM and waits until it is done.
+func (vmHandler *AzureVMHandler) startVM(vmIdArr []string) error {
+	future, err := vmHandler.Client.Start(vmHandler.Ctx, vmIdArr[0], vmIdArr[1])
+	if err != nil {
+		return err
+	}
+	return future.WaitForCompletionRef(vmHandler.Ctx, vmHandler.Client.Client)
+}
+
 func (vmHandler *AzureVMHandler) ListVMStatus() []*irs.VMStatusInfo {
 	//serverList, err := vmHandler.Client.ListAll(vmHandler.Ctx)
 	serverList, err := vmHandler.Client.List(vmHandler.Ctx, vmHandler.Region.ResourceGroup)
@@ -235,6 +333,33 @@ func (vmHandler *AzureVMHandler) ListVM() []*irs.VMInfo {
 	return vmList
 }
 
+// Azure 목록 조회는 NextLink 기반이나 임의 위치부터 재개할 수 없으므로, Resource Group 전체 조회 후 페이징 함.
+func (vmHandler *AzureVMHandler) ListVMPage(listReqInfo irs.ListReqInfo) (irs.VMPageInfo, error) {
+	resultList, err := vmHandler.Client.List(vmHandler.Ctx, vmHandler.Region.ResourceGroup)
+	if err != nil {
+		return irs.VMPageInfo{}, err
+	}
+
+	var serverList []*irs.VMInfo
+	for resultList.NotDone() {
+		for _, server := range resultList.Values() {
+			if listReqInfo.MatchName(*server.Name) && listReqInfo.MatchTags(to.StringMap(server.Tags)) {
+				serverInfo := mappingServerInfo(server)
+				serverList = append(serverList, &serverInfo)
+			}
+		}
+		if err := resultList.NextWithContext(vmHandler.Ctx); err != nil {
+			return irs.VMPageInfo{}, err
+		}
+	}
+
+	start, end, nextToken, err := listReqInfo.LocalPage(len(serverList))
+	if err != nil {
+		return irs.VMPageInfo{}, err
+	}
+	return irs.VMPageInfo{VMInfoList: serverList[start:end], NextToken: nextToken}, nil
+}
+
 func (vmHandler *AzureVMHandler) GetVM(vmID string) irs.VMInfo {
 	vmIdArr := strings.Split(vmID, ":")
 	vm, err := vmHandler.Client.Get(vmHandler.Ctx, vmIdArr[0], vmIdArr[1], compute.InstanceView)
@@ -5,9 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/Azure/go-autorest/autorest/to"
 	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
-	"github.com/davecgh/go-spew/spew"
 	"strings"
 )
 
@@ -74,8 +74,18 @@ func (vNetworkHandler *AzureVNetworkHandler) CreateVNetwork(vNetworkReqInfo irs.
 		},
 	}
 
+	// 요청된 서브넷이 있으면 기본 서브넷 대신 생성 함.
+	if len(vNetworkReqInfo.SubnetReqInfoList) > 0 {
+		var subnetInfoList []SubnetInfo
+		for _, subnetReqInfo := range vNetworkReqInfo.SubnetReqInfoList {
+			subnetInfoList = append(subnetInfoList, SubnetInfo{Name: subnetReqInfo.Name, AddressPrefix: subnetReqInfo.CIDR})
+		}
+		reqInfo.Subnets = &subnetInfoList
+	}
+
 	var subnetArr []network.Subnet
 	for _, subnet := range *reqInfo.Subnets {
+		subnet := subnet // 서브넷 마다 Name, AddressPrefix의 포인터가 달라야 함.
 		subnetInfo := network.Subnet{
 			Name: &subnet.Name,
 			SubnetPropertiesFormat: &network.SubnetPropertiesFormat{
@@ -129,10 +139,37 @@ func (vNetworkHandler *AzureVNetworkHandler) ListVNetwork() ([]*irs.VNetworkInfo
 		vNetList = append(vNetList, vNetInfo)
 	}
 
-	spew.Dump(vNetList)
+	cblogger.Dump("vNetList", vNetList)
 	return nil, nil
 }
 
+// Azure 목록 조회는 NextLink 기반이나 임의 위치부터 재개할 수 없으므로, Resource Group 전체 조회 후 페이징 함.
+func (vNetworkHandler *AzureVNetworkHandler) ListVNetworkPage(listReqInfo irs.ListReqInfo) (irs.VNetworkPageInfo, error) {
+	resultList, err := vNetworkHandler.Client.List(vNetworkHandler.Ctx, vNetworkHandler.Region.ResourceGroup)
+	if err != nil {
+		return irs.VNetworkPageInfo{}, err
+	}
+
+	var vNetworkList []*irs.VNetworkInfo
+	for resultList.NotDone() {
+		for _, vNetwork := range resultList.Values() {
+			if listReqInfo.MatchName(*vNetwork.Name) && listReqInfo.MatchTags(to.StringMap(vNetwork.Tags)) {
+				vNetworkInfo := mappingVNetworkInfo(vNetworkHandler.Region.ResourceGroup, vNetwork)
+				vNetworkList = append(vNetworkList, &vNetworkInfo)
+			}
+		}
+		if err := resultList.NextWithContext(vNetworkHandler.Ctx); err != nil {
+			return irs.VNetworkPageInfo{}, err
+		}
+	}
+
+	start, end, nextToken, err := listReqInfo.LocalPage(len(vNetworkList))
+	if err != nil {
+		return irs.VNetworkPageInfo{}, err
+	}
+	return irs.VNetworkPageInfo{VNetworkInfoList: vNetworkList[start:end], NextToken: nextToken}, nil
+}
+
 func (vNetworkHandler *AzureVNetworkHandler) GetVNetwork(vNetworkID string) (irs.VNetworkInfo, error) {
 	vNetworkIdArr := strings.Split(vNetworkID, ":")
 	vNetwork, err := vNetworkHandler.Client.Get(vNetworkHandler.Ctx, vNetworkIdArr[0], vNetworkIdArr[1], "")
@@ -142,7 +179,7 @@ func (vNetworkHandler *AzureVNetworkHandler) GetVNetwork(vNetworkID string) (irs
 
 	vNetInfo := new(VNetworkInfo).setter(vNetwork)
 
-	spew.Dump(vNetInfo)
+	cblogger.Dump("vNetInfo", vNetInfo)
 	return irs.VNetworkInfo{}, nil
 }
 
@@ -158,3 +195,14 @@ func (vNetworkHandler *AzureVNetworkHandler) DeleteVNetwork(vNetworkID string) (
 	}
 	return true, nil
 }
+
+func mappingVNetworkInfo(resourceGroup string, vNetwork network.VirtualNetwork) irs.VNetworkInfo {
+	vNetworkInfo := irs.VNetworkInfo{
+		Name: *vNetwork.Name,
+		Id:   resourceGroup + ":" + *vNetwork.Name,
+	}
+	if vNetwork.VirtualNetworkPropertiesFormat != nil && vNetwork.Subnets != nil && len(*vNetwork.Subnets) > 0 {
+		vNetworkInfo.SubnetId = *(*vNetwork.Subnets)[0].Name
+	}
+	return vNetworkInfo
+}
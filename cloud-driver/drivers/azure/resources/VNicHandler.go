@@ -5,9 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/Azure/go-autorest/autorest/to"
 	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
-	"github.com/davecgh/go-spew/spew"
 	"strings"
 )
 
@@ -168,10 +168,37 @@ func (vNicHandler *AzureVNicHandler) ListVNic() ([]*irs.VNicInfo, error) {
 		vNicList = append(vNicList, vNicInfo)
 	}
 
-	spew.Dump(vNicList)
+	cblogger.Dump("vNicList", vNicList)
 	return nil, nil
 }
 
+// Azure 목록 조회는 NextLink 기반이나 임의 위치부터 재개할 수 없으므로, Resource Group 전체 조회 후 페이징 함.
+func (vNicHandler *AzureVNicHandler) ListVNicPage(listReqInfo irs.ListReqInfo) (irs.VNicPageInfo, error) {
+	resultList, err := vNicHandler.NicClient.List(vNicHandler.Ctx, vNicHandler.Region.ResourceGroup)
+	if err != nil {
+		return irs.VNicPageInfo{}, err
+	}
+
+	var vNicList []*irs.VNicInfo
+	for resultList.NotDone() {
+		for _, vNic := range resultList.Values() {
+			if listReqInfo.MatchName(*vNic.Name) && listReqInfo.MatchTags(to.StringMap(vNic.Tags)) {
+				vNicInfo := mappingVNicInfo(vNicHandler.Region.ResourceGroup, vNic)
+				vNicList = append(vNicList, &vNicInfo)
+			}
+		}
+		if err := resultList.NextWithContext(vNicHandler.Ctx); err != nil {
+			return irs.VNicPageInfo{}, err
+		}
+	}
+
+	start, end, nextToken, err := listReqInfo.LocalPage(len(vNicList))
+	if err != nil {
+		return irs.VNicPageInfo{}, err
+	}
+	return irs.VNicPageInfo{VNicInfoList: vNicList[start:end], NextToken: nextToken}, nil
+}
+
 func (vNicHandler *AzureVNicHandler) GetVNic(vNicID string) (irs.VNicInfo, error) {
 	vNicIDArr := strings.Split(vNicID, ":")
 	vNic, err := vNicHandler.NicClient.Get(vNicHandler.Ctx, vNicIDArr[0], vNicIDArr[1], "")
@@ -181,7 +208,7 @@ func (vNicHandler *AzureVNicHandler) GetVNic(vNicID string) (irs.VNicInfo, error
 
 	vNicInfo := new(VNicInfo).setter(vNic)
 
-	spew.Dump(vNicInfo)
+	cblogger.Dump("vNicInfo", vNicInfo)
 	return irs.VNicInfo{}, nil
 }
 
@@ -201,3 +228,10 @@ func (vNicHandler *AzureVNicHandler) DeleteVNic(vNicID string) (bool, error) {
 func (vNicHandler *AzureVNicHandler) getSubnet(rsgName string, vNetName string, subnetName string) (network.Subnet, error) {
 	return vNicHandler.SubnetClient.Get(vNicHandler.Ctx, rsgName, vNetName, subnetName, "")
 }
+
+func mappingVNicInfo(resourceGroup string, vNic network.Interface) irs.VNicInfo {
+	return irs.VNicInfo{
+		Name: *vNic.Name,
+		Id:   resourceGroup + ":" + *vNic.Name,
+	}
+}
@@ -5,6 +5,8 @@ import (
 	cicon "github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/cloudit/connect"
 	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
 	icon "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/connect"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"net/http"
 )
 
 type ClouditDriver struct{}
@@ -13,16 +15,20 @@ func (ClouditDriver) GetDriverVersion() string {
 	return "CLOUDIT DRIVER Version 1.0"
 }
 
+// Cloudit은 KeyPair 리소스가 없으므로 KeyPairHandler를 지원하지 않음.
+// List{Resource}는 Stub이므로 지원하지 않으며 List{Resource}Page를 사용 함.
 func (ClouditDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 	var drvCapabilityInfo idrv.DriverCapabilityInfo
 
-	drvCapabilityInfo.ImageHandler = false
-	drvCapabilityInfo.VNetworkHandler = false
-	drvCapabilityInfo.SecurityHandler = false
-	drvCapabilityInfo.KeyPairHandler = false
-	drvCapabilityInfo.VNicHandler = false
-	drvCapabilityInfo.PublicIPHandler = false
-	drvCapabilityInfo.VMHandler = true
+	listReqFields := []string{idrv.ListPageSize, idrv.ListNextToken, idrv.ListNameFilter}
+	vmOperations := []string{"StartVM", "StartVMs", "SuspendVM", "ResumeVM", "RebootVM", "TerminateVM", "ChangeVMSpec", "ListVMStatus", "GetVMStatus", "ListVM", "ListVMPage", "GetVM"}
+
+	drvCapabilityInfo.ImageHandler = idrv.HandlerCapabilityInfo{Operations: []string{"CreateImage", "ListImage", "ListImagePage", "GetImage", "DeleteImage"}, ReqFields: append(listReqFields, idrv.ImageFilterGuestOS, idrv.ImageFilterArchitecture, idrv.ImageFilterNamePattern, idrv.ImageFilterVisibility)}
+	drvCapabilityInfo.VNetworkHandler = idrv.HandlerCapabilityInfo{Operations: []string{"CreateVNetwork", "ListVNetworkPage", "GetVNetwork", "DeleteVNetwork"}, ReqFields: listReqFields}
+	drvCapabilityInfo.SecurityHandler = idrv.HandlerCapabilityInfo{Operations: []string{"CreateSecurity", "ListSecurityPage", "GetSecurity", "DeleteSecurity"}, ReqFields: listReqFields}
+	drvCapabilityInfo.VNicHandler = idrv.HandlerCapabilityInfo{Operations: []string{"CreateVNic", "ListVNicPage", "GetVNic", "DeleteVNic"}, ReqFields: listReqFields}
+	drvCapabilityInfo.PublicIPHandler = idrv.HandlerCapabilityInfo{Operations: []string{"CreatePublicIP", "ListPublicIPPage", "GetPublicIP", "DeletePublicIP", "AssociatePublicIP", "DisassociatePublicIP"}, ReqFields: listReqFields}
+	drvCapabilityInfo.VMHandler = idrv.HandlerCapabilityInfo{Operations: vmOperations, ReqFields: listReqFields}
 
 	return drvCapabilityInfo
 }
@@ -33,26 +39,33 @@ func (driver *ClouditDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (i
 	// 3. create CloudConnection Instance of "connect/TDA_CloudConnection".
 	// 4. return CloudConnection Interface of TDA_CloudConnection.
 
-	Client, err := getServiceClient(connectionInfo)
+	transport := idrv.NewHTTPTransport()
+	Client, err := getServiceClient(connectionInfo, transport)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
 	iConn := cicon.ClouditCloudConnection{
 		CredentialInfo: connectionInfo.CredentialInfo,
 		Client:         *Client,
+		HTTPTransport:  transport,
 	}
 
 	return &iConn, nil
 }
 
-func getServiceClient(connInfo idrv.ConnectionInfo) (*client.RestClient, error) {
+func getServiceClient(connInfo idrv.ConnectionInfo, transport *http.Transport) (*client.RestClient, error) {
 	restClient := client.RestClient{
 		IdentityBase:   connInfo.CredentialInfo.IdentityEndpoint,
 		ClouditVersion: "v4.0",
 		TenantID:       connInfo.CredentialInfo.TenantId,
+		// 요청마다 client span을 만들고 traceparent 헤더로 전파 함.
+		HTTPClient: http.Client{Transport: otelhttp.NewTransport(transport)},
 	}
 	return &restClient, nil
 }
 
 var TestDriver ClouditDriver
+
+// checked by the driver manager before using TestDriver.
+var DriverManifest = idrv.NewDriverManifest("Cloudit", &TestDriver)
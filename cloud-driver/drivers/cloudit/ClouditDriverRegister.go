@@ -0,0 +1,10 @@
+package cloudit
+
+import (
+	registry "github.com/cloud-barista/poc-cb-spider/cloud-driver/driver-registry"
+)
+
+// static driver registry에 등록 함. plugin(.so) 빌드는 ClouditDriver.go만 사용 함.
+func init() {
+	registry.Register("cloudit", &TestDriver)
+}
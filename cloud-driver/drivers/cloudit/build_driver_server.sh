@@ -0,0 +1,2 @@
+rm -rf /tmp/ClouditDriverServer
+go build -o /tmp/ClouditDriverServer $CB_SPIDER_ROOT/cloud-driver/drivers/cloudit/grpc-server
@@ -2,14 +2,18 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/cloud-barista/poc-cb-spider/cloud-driver/logger"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"strings"
 )
 
+var cblogger = logger.New("Cloudit", "RestClient")
+
 // DefaultUserAgent is the default User-Agent string set in the request header.
 const (
 	DefaultUserAgent = "cloudit/1.0.0"
@@ -73,6 +77,9 @@ type RestClient struct {
 	// fails with a 401 HTTP response code. This a needed because there may be multiple
 	// authentication functions for different Identity service versions.
 	ReauthFunc func() error
+	
+	// Context of the requests, ex) the trace span of a handler call. nil: context.Background()
+	Context context.Context
 }
 
 // AuthenticatedHeaders returns a map of HTTP headers that are common for all
@@ -178,6 +185,9 @@ func (client *RestClient) Request(method, url string, options RequestOpts) (*htt
 	if err != nil {
 		return nil, err
 	}
+	if client.Context != nil {
+		req = req.WithContext(client.Context)
+	}
 	
 	// Populate the request headers. Apply options.MoreHeaders last, to give the caller the chance to
 	// modify or omit any header.
@@ -207,6 +217,7 @@ func (client *RestClient) Request(method, url string, options RequestOpts) (*htt
 	req.Close = true
 	
 	// Issue the request.
+	cblogger.WithContext(client.Context).Debugf("%s %s", method, url)
 	resp, err := client.HTTPClient.Do(req)
 	if err != nil {
 		return nil, err
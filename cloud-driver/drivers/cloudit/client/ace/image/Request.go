@@ -1,7 +1,6 @@
 package image
 
 import (
-	"fmt"
 	"github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/cloudit/client"
 )
 
@@ -37,7 +36,6 @@ type ImageInfo struct {
 
 func List(restClient *client.RestClient, requestOpts *client.RequestOpts) (*[]ImageInfo, error) {
 	requestURL := restClient.CreateRequestBaseURL(client.ACE, "templates")
-	fmt.Println(requestURL)
 
 	var result client.Result
 	if _, result.Err = restClient.Get(requestURL, &result.Body, requestOpts); result.Err != nil {
@@ -53,7 +51,6 @@ func List(restClient *client.RestClient, requestOpts *client.RequestOpts) (*[]Im
 
 func Get(restClient *client.RestClient, templateId string, requestOpts *client.RequestOpts) (*ImageInfo, error) {
 	requestURL := restClient.CreateRequestBaseURL(client.ACE, "templates", templateId)
-	fmt.Println(requestURL)
 
 	var result client.Result
 	if _, result.Err = restClient.Get(requestURL, &result.Body, requestOpts); result.Err != nil {
@@ -69,7 +66,6 @@ func Get(restClient *client.RestClient, templateId string, requestOpts *client.R
 
 func Create(restClient *client.RestClient, requestOpts *client.RequestOpts) (*ImageInfo, error) {
 	requestURL := restClient.CreateRequestBaseURL(client.ACE, "templates")
-	fmt.Println(requestURL)
 
 	var result client.Result
 	if _, result.Err = restClient.Post(requestURL, nil, &result.Body, requestOpts); result.Err != nil {
@@ -85,7 +81,6 @@ func Create(restClient *client.RestClient, requestOpts *client.RequestOpts) (*Im
 
 func Delete(restClient *client.RestClient, templateId string, requestOpts *client.RequestOpts) error {
 	requestURL := restClient.CreateRequestBaseURL(client.ACE, "templates", templateId)
-	fmt.Println(requestURL)
 
 	var result client.Result
 	if _, result.Err = restClient.Delete(requestURL, requestOpts); result.Err != nil {
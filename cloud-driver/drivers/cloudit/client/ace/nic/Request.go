@@ -1,7 +1,6 @@
 package nic
 
 import (
-	"fmt"
 	"github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/cloudit/client"
 	"github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/cloudit/client/iam/securitygroup"
 )
@@ -32,7 +31,6 @@ type VmNicInfo struct {
 
 func List(restClient *client.RestClient, serverId string, requestOpts *client.RequestOpts) (*[]VmNicInfo, error) {
 	requestURL := restClient.CreateRequestBaseURL(client.ACE, "servers", serverId, "nics")
-	fmt.Println(requestURL)
 
 	var result client.Result
 	if _, result.Err = restClient.Get(requestURL, &result.Body, requestOpts); result.Err != nil {
@@ -48,7 +46,6 @@ func List(restClient *client.RestClient, serverId string, requestOpts *client.Re
 
 func Get(restClient *client.RestClient, serverId string, macAddr string, requestOpts *client.RequestOpts) (*VmNicInfo, error) {
 	requestURL := restClient.CreateRequestBaseURL(client.ACE, "servers", serverId, "nics", macAddr)
-	fmt.Println(requestURL)
 
 	var result client.Result
 	if _, result.Err = restClient.Get(requestURL, &result.Body, requestOpts); result.Err != nil {
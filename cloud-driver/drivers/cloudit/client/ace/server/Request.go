@@ -1,7 +1,6 @@
 package server
 
 import (
-	"fmt"
 	"github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/cloudit/client"
 	"github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/cloudit/client/iam/securitygroup"
 )
@@ -88,7 +87,6 @@ type ServerInfo struct {
 
 func List(restClient *client.RestClient, requestOpts *client.RequestOpts) (*[]ServerInfo, error) {
 	requestURL := restClient.CreateRequestBaseURL(client.ACE, "servers")
-	fmt.Println(requestURL)
 	
 	var result client.Result
 	if _, result.Err = restClient.Get(requestURL, &result.Body, requestOpts); result.Err != nil {
@@ -104,7 +102,6 @@ func List(restClient *client.RestClient, requestOpts *client.RequestOpts) (*[]Se
 
 func Get(restClient *client.RestClient, id string, requestOpts *client.RequestOpts) (*ServerInfo, error) {
 	requestURL := restClient.CreateRequestBaseURL(client.ACE, "servers", id)
-	fmt.Println(requestURL)
 	
 	var result client.Result
 	_, result.Err = restClient.Get(requestURL, &result.Body, requestOpts)
@@ -119,7 +116,6 @@ func Get(restClient *client.RestClient, id string, requestOpts *client.RequestOp
 // create
 func Start(restClient *client.RestClient, requestOpts *client.RequestOpts) (*ServerInfo, error) {
 	requestURL := restClient.CreateRequestBaseURL(client.ACE, "servers")
-	fmt.Println(requestURL)
 
 	var result client.Result
 	if _, result.Err = restClient.Post(requestURL, nil, &result.Body, requestOpts); result.Err != nil {
@@ -137,7 +133,6 @@ func Start(restClient *client.RestClient, requestOpts *client.RequestOpts) (*Ser
 //shutdown
 func Suspend(restClient *client.RestClient, id string, requestOpts *client.RequestOpts) error {
 	requestURL := restClient.CreateRequestBaseURL(client.ACE, "servers", id, "shutdown")
-	fmt.Println(requestURL)
 
 	var result client.Result
 	if _, result.Err = restClient.Post(requestURL, nil, nil, requestOpts); result.Err != nil {
@@ -149,7 +144,6 @@ func Suspend(restClient *client.RestClient, id string, requestOpts *client.Reque
 //start
 func Resume(restClient *client.RestClient, id string, requestOpts *client.RequestOpts) error {
 	requestURL := restClient.CreateRequestBaseURL(client.ACE, "servers", id, "start")
-	fmt.Println(requestURL)
 
 	var result client.Result
 	if _, result.Err = restClient.Post(requestURL, nil, nil, requestOpts); result.Err != nil {
@@ -161,7 +155,6 @@ func Resume(restClient *client.RestClient, id string, requestOpts *client.Reques
 //reboot
 func Reboot(restClient *client.RestClient, id string, requestOpts *client.RequestOpts) error {
 	requestURL := restClient.CreateRequestBaseURL(client.ACE, "servers", id, "reboot")
-	fmt.Println(requestURL)
 
 	var result client.Result
 	if _, result.Err = restClient.Post(requestURL, nil, nil, requestOpts); result.Err != nil {
@@ -173,7 +166,6 @@ func Reboot(restClient *client.RestClient, id string, requestOpts *client.Reques
 //delete
 func Terminate(restClient *client.RestClient, id string, requestOpts *client.RequestOpts) error {
 	requestURL := restClient.CreateRequestBaseURL(client.ACE, "servers", id)
-	fmt.Println(requestURL)
 
 	var result client.Result
 	if _, result.Err = restClient.Delete(requestURL, requestOpts); result.Err != nil {
@@ -181,3 +173,14 @@ func Terminate(restClient *client.RestClient, id string, requestOpts *client.Req
 	}
 	return nil
 }
+
+//spec change (shutdown 상태에서만 가능)
+func ChangeSpec(restClient *client.RestClient, id string, requestOpts *client.RequestOpts) error {
+	requestURL := restClient.CreateRequestBaseURL(client.ACE, "servers", id, "spec")
+
+	var result client.Result
+	if _, result.Err = restClient.Put(requestURL, nil, nil, requestOpts); result.Err != nil {
+		return result.Err
+	}
+	return nil
+}
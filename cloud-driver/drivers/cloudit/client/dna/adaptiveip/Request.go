@@ -1,7 +1,6 @@
 package adaptiveip
 
 import (
-	"fmt"
 	"github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/cloudit/client"
 )
 
@@ -31,7 +30,6 @@ type AdaptiveIPInfo struct {
 
 func List(restClient *client.RestClient, requestOpts *client.RequestOpts) (*[]AdaptiveIPInfo, error) {
 	requestURL := restClient.CreateRequestBaseURL(client.DNA, "adaptive-ips")
-	fmt.Println(requestURL)
 
 	var result client.Result
 	if _, result.Err = restClient.Get(requestURL, &result.Body, requestOpts); result.Err != nil {
@@ -47,7 +45,6 @@ func List(restClient *client.RestClient, requestOpts *client.RequestOpts) (*[]Ad
 
 func ListAvailableIP(restClient *client.RestClient, requestOpts *client.RequestOpts) (*[]IPInfo, error) {
 	requestURL := restClient.CreateRequestBaseURL(client.DNA, "ips")
-	fmt.Println(requestURL)
 
 	var result client.Result
 	if _, result.Err = restClient.Get(requestURL, &result.Body, requestOpts); result.Err != nil {
@@ -63,7 +60,6 @@ func ListAvailableIP(restClient *client.RestClient, requestOpts *client.RequestO
 
 func Get(restClient *client.RestClient, adaptiveIPId string, requestOpts *client.RequestOpts) (*AdaptiveIPInfo, error) {
 	requestURL := restClient.CreateRequestBaseURL(client.DNA, "adaptive-ips", adaptiveIPId, "detail")
-	fmt.Println(requestURL)
 
 	var result client.Result
 	if _, result.Err = restClient.Get(requestURL, &result.Body, requestOpts); result.Err != nil {
@@ -79,7 +75,6 @@ func Get(restClient *client.RestClient, adaptiveIPId string, requestOpts *client
 
 func Create(restClient *client.RestClient, requestOpts *client.RequestOpts) (*AdaptiveIPInfo, error) {
 	requestURL := restClient.CreateRequestBaseURL(client.DNA, "adaptive-ips")
-	fmt.Println(requestURL)
 
 	var result client.Result
 
@@ -96,7 +91,6 @@ func Create(restClient *client.RestClient, requestOpts *client.RequestOpts) (*Ad
 
 func Delete(restClient *client.RestClient, adaptiveIP string, requestOpts *client.RequestOpts) error {
 	requestURL := restClient.CreateRequestBaseURL(client.DNA, "adaptive-ips", adaptiveIP)
-	fmt.Println(requestURL)
 
 	var result client.Result
 	if _, result.Err = restClient.Delete(requestURL, requestOpts); result.Err != nil {
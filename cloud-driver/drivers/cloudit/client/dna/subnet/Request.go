@@ -1,7 +1,6 @@
 package subnet
 
 import (
-	"fmt"
 	"github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/cloudit/client"
 )
 
@@ -23,7 +22,6 @@ type SubnetInfo struct {
 
 func List(restClient *client.RestClient, requestOpts *client.RequestOpts) (*[]SubnetInfo, error) {
 	requestURL := restClient.CreateRequestBaseURL(client.DNA, "subnets")
-	fmt.Println(requestURL)
 
 	var result client.Result
 	if _, result.Err = restClient.Get(requestURL, &result.Body, requestOpts); result.Err != nil {
@@ -39,7 +37,6 @@ func List(restClient *client.RestClient, requestOpts *client.RequestOpts) (*[]Su
 
 func ListCreatableSubnet(restClient *client.RestClient, requestOpts *client.RequestOpts) (*[]SubnetInfo, error) {
 	requestURL := restClient.CreateRequestBaseURL(client.DNA, "subnets", "creatable")
-	fmt.Println(requestURL)
 
 	var result client.Result
 	if _, result.Err = restClient.Get(requestURL, &result.Body, requestOpts); result.Err != nil {
@@ -55,7 +52,6 @@ func ListCreatableSubnet(restClient *client.RestClient, requestOpts *client.Requ
 
 func Get(restClient *client.RestClient, subnetId string, requestOpts *client.RequestOpts) (*SubnetInfo, error) {
 	requestURL := restClient.CreateRequestBaseURL(client.DNA, "subnets", subnetId, "detail")
-	fmt.Println(requestURL)
 
 	var result client.Result
 	if _, result.Err = restClient.Get(requestURL, &result.Body, requestOpts); result.Err != nil {
@@ -71,7 +67,6 @@ func Get(restClient *client.RestClient, subnetId string, requestOpts *client.Req
 
 func Create(restClient *client.RestClient, requestOpts *client.RequestOpts) (*SubnetInfo, error) {
 	requestURL := restClient.CreateRequestBaseURL(client.DNA, "subnets")
-	fmt.Println(requestURL)
 
 	var result client.Result
 
@@ -88,7 +83,6 @@ func Create(restClient *client.RestClient, requestOpts *client.RequestOpts) (*Su
 
 func Delete(restClient *client.RestClient, addr string, requestOpts *client.RequestOpts) error {
 	requestURL := restClient.CreateRequestBaseURL(client.DNA, "subnets", addr)
-	fmt.Println(requestURL)
 
 	var result client.Result
 	if _, result.Err = restClient.Delete(requestURL, requestOpts); result.Err != nil {
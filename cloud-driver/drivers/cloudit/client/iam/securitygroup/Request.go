@@ -1,7 +1,6 @@
 package securitygroup
 
 import (
-	"fmt"
 	"github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/cloudit/client"
 )
 
@@ -33,7 +32,6 @@ type SecurityGroupInfo struct {
 
 func List(restClient *client.RestClient, requestOpts *client.RequestOpts) (*[]SecurityGroupInfo, error) {
 	requestURL := restClient.CreateRequestBaseURL(client.IAM, "securitygroups")
-	fmt.Println(requestURL)
 
 	var result client.Result
 	if _, result.Err = restClient.Get(requestURL, &result.Body, requestOpts); result.Err != nil {
@@ -49,7 +47,6 @@ func List(restClient *client.RestClient, requestOpts *client.RequestOpts) (*[]Se
 
 func ListRule(restClient *client.RestClient, securitygroupId string, requestOpts *client.RequestOpts) (*[]SecurityGroupRules, error) {
 	requestURL := restClient.CreateRequestBaseURL(client.IAM, "securitygroups", securitygroupId)
-	fmt.Println(requestURL)
 	
 	var result client.Result
 	if _, result.Err = restClient.Get(requestURL, &result.Body, requestOpts); result.Err != nil {
@@ -65,7 +62,6 @@ func ListRule(restClient *client.RestClient, securitygroupId string, requestOpts
 
 func Get(restClient *client.RestClient, securitygroupId string, requestOpts *client.RequestOpts) (*SecurityGroupInfo, error) {
 	requestURL := restClient.CreateRequestBaseURL(client.IAM, "securitygroups", securitygroupId, "detail")
-	fmt.Println(requestURL)
 
 	var result client.Result
 	if _, result.Err = restClient.Get(requestURL, &result.Body, requestOpts); result.Err != nil {
@@ -81,7 +77,6 @@ func Get(restClient *client.RestClient, securitygroupId string, requestOpts *cli
 
 func Create(restClient *client.RestClient, requestOpts *client.RequestOpts) (*SecurityGroupInfo, error) {
 	requestURL := restClient.CreateRequestBaseURL(client.IAM, "securitygroups")
-	fmt.Println(requestURL)
 
 	var result client.Result
 	if _, result.Err = restClient.Post(requestURL, nil, &result.Body, requestOpts); result.Err != nil {
@@ -97,7 +92,6 @@ func Create(restClient *client.RestClient, requestOpts *client.RequestOpts) (*Se
 
 func Delete(restClient *client.RestClient, securitygroupId string, requestOpts *client.RequestOpts) error {
 	requestURL := restClient.CreateRequestBaseURL(client.IAM, "securitygroups", securitygroupId)
-	fmt.Println(requestURL)
 
 	var result client.Result
 	if _, result.Err = restClient.Delete(requestURL, requestOpts); result.Err != nil {
@@ -11,61 +11,93 @@
 package connect
 
 import (
-	"fmt"
 	"github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/cloudit/client"
+	"github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/cloudit/client/iam/securitygroup"
 	cirs "github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/cloudit/resources"
 	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+	"github.com/cloud-barista/poc-cb-spider/cloud-driver/logger"
+	"net/http"
 )
 
+var cblogger = logger.New("Cloudit", "Connect")
+
 type ClouditCloudConnection struct {
 	CredentialInfo idrv.CredentialInfo
 	Client         client.RestClient
+
+	HTTPTransport *http.Transport // 모든 Handler의 RestClient가 공유, Close()에서 해제 함.
+}
+
+// Handler는 요청마다 RestClient.TokenID를 설정 하므로,
+// 캐시된 Connection을 동시에 사용할 수 있도록 Handler마다 RestClient를 복사 함.
+func (cloudConn *ClouditCloudConnection) restClient() *client.RestClient {
+	restClient := cloudConn.Client
+	return &restClient
 }
 
 func (cloudConn *ClouditCloudConnection) CreateVNetworkHandler() (irs.VNetworkHandler, error) {
-	fmt.Println("Cloudit Cloud Driver: called CreateVNetworkHandler()!")
-	vNetHandler := cirs.ClouditVNetworkHandler{cloudConn.CredentialInfo, &cloudConn.Client}
+	cblogger.Debug("called CreateVNetworkHandler()")
+	vNetHandler := cirs.ClouditVNetworkHandler{cloudConn.CredentialInfo, cloudConn.restClient()}
 	return &vNetHandler, nil
 }
 
 func (cloudConn *ClouditCloudConnection) CreateImageHandler() (irs.ImageHandler, error) {
-	fmt.Println("Cloudit Cloud Driver: called CreateImageHandler()!")
-	imageHandler := cirs.ClouditImageHandler{cloudConn.CredentialInfo, &cloudConn.Client}
+	cblogger.Debug("called CreateImageHandler()")
+	imageHandler := cirs.ClouditImageHandler{cloudConn.CredentialInfo, cloudConn.restClient()}
 	return &imageHandler, nil
 }
 
-func (cloudConn ClouditCloudConnection) CreateSecurityHandler() (irs.SecurityHandler, error) {
-	fmt.Println("Cloudit Cloud Driver: called CreateSecurityHandler()!")
-	securityHandler := cirs.ClouditSecurityHandler{cloudConn.CredentialInfo, &cloudConn.Client}
+func (cloudConn *ClouditCloudConnection) CreateSecurityHandler() (irs.SecurityHandler, error) {
+	cblogger.Debug("called CreateSecurityHandler()")
+	securityHandler := cirs.ClouditSecurityHandler{cloudConn.CredentialInfo, cloudConn.restClient()}
 	return &securityHandler, nil
 }
 
 func (cloudConn *ClouditCloudConnection) CreateKeyPairHandler() (irs.KeyPairHandler, error) {
-	fmt.Println("Cloudit Cloud Driver: called CreateKeyPairHandler()!")
-	return nil, nil
+	cblogger.Debug("called CreateKeyPairHandler()")
+	return nil, irs.NotSupported("Cloudit driver does not support KeyPairHandler")
 }
 
-func (cloudConn ClouditCloudConnection) CreateVNicHandler() (irs.VNicHandler, error) {
-	fmt.Println("Cloudit Cloud Driver: called CreateVNicHandler()!")
-	vNicHandler := cirs.ClouditNicHandler{cloudConn.CredentialInfo, &cloudConn.Client}
+func (cloudConn *ClouditCloudConnection) CreateVNicHandler() (irs.VNicHandler, error) {
+	cblogger.Debug("called CreateVNicHandler()")
+	vNicHandler := cirs.ClouditNicHandler{cloudConn.CredentialInfo, cloudConn.restClient()}
 	return &vNicHandler, nil
 }
 
-func (cloudConn ClouditCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
-	fmt.Println("Cloudit Cloud Driver: called CreatePublicIPHandler()!")
-	publicIPHandler := cirs.ClouditPublicIPHandler{cloudConn.CredentialInfo, &cloudConn.Client}
+func (cloudConn *ClouditCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
+	cblogger.Debug("called CreatePublicIPHandler()")
+	publicIPHandler := cirs.ClouditPublicIPHandler{cloudConn.CredentialInfo, cloudConn.restClient()}
 	return &publicIPHandler, nil
 }
 func (cloudConn *ClouditCloudConnection) CreateVMHandler() (irs.VMHandler, error) {
-	fmt.Println("Cloudit Cloud Driver: called CreateVMHandler()!")
-	vmHandler := cirs.ClouditVMHandler{cloudConn.CredentialInfo, &cloudConn.Client}
+	cblogger.Debug("called CreateVMHandler()")
+	vmHandler := cirs.ClouditVMHandler{cloudConn.CredentialInfo, cloudConn.restClient()}
 	return &vmHandler, nil
 }
 
-func (ClouditCloudConnection) IsConnected() (bool, error) {
+// IsConnected checks AuthToken with an authenticated IAM request.
+// Cloudit API has no token validation, so it lists security groups, the lightest IAM resource.
+func (cloudConn *ClouditCloudConnection) IsConnected() (bool, error) {
+	if cloudConn.CredentialInfo.AuthToken == "" {
+		return false, irs.Unauthenticated("Cloudit AuthToken is empty")
+	}
+
+	restClient := cloudConn.restClient()
+	restClient.TokenID = cloudConn.CredentialInfo.AuthToken
+	requestOpts := client.RequestOpts{
+		MoreHeaders: restClient.AuthenticatedHeaders(),
+	}
+	if _, err := securitygroup.List(restClient, &requestOpts); err != nil {
+		return false, irs.ConnectionError(err)
+	}
 	return true, nil
 }
-func (ClouditCloudConnection) Close() error {
+
+// Close releases idle HTTP connections of the RestClient.
+func (cloudConn *ClouditCloudConnection) Close() error {
+	if cloudConn.HTTPTransport != nil {
+		cloudConn.HTTPTransport.CloseIdleConnections()
+	}
 	return nil
 }
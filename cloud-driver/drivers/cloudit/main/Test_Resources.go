@@ -40,7 +40,7 @@ Loop:
 			switch commandNum {
 			case 1:
 				fmt.Println("Start ListImage() ...")
-				if _, err := imageHandler.ListImage(); err != nil {
+				if _, err := imageHandler.ListImage(irs.ImageFilterInfo{}); err != nil {
 					panic(err)
 				}
 				fmt.Println("Finish ListImage()")
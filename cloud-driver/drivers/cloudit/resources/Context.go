@@ -0,0 +1,46 @@
+package resources
+
+import (
+	"context"
+	"github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/cloudit/client"
+	"github.com/cloud-barista/poc-cb-spider/cloud-driver/logger"
+)
+
+var cblogger = logger.New("Cloudit", "Resources")
+
+// loggerOf returns the logger with the fields of the call of restClient, ex) request_id
+func loggerOf(restClient *client.RestClient) *logger.Logger {
+	return cblogger.WithContext(restClient.Context)
+}
+
+// Handler는 요청마다 RestClient.TokenID를 설정 하므로, ctx를 사용하는 Handler는 RestClient를 복사 함.
+func withContext(restClient *client.RestClient, ctx context.Context) *client.RestClient {
+	contextClient := *restClient
+	contextClient.Context = ctx
+	return &contextClient
+}
+
+// WithContext implements idrv.ContextHandler, the RestClient requests of the handler use ctx.
+func (imageHandler *ClouditImageHandler) WithContext(ctx context.Context) interface{} {
+	return &ClouditImageHandler{imageHandler.CredentialInfo, withContext(imageHandler.Client, ctx)}
+}
+
+func (vNetworkHandler *ClouditVNetworkHandler) WithContext(ctx context.Context) interface{} {
+	return &ClouditVNetworkHandler{vNetworkHandler.CredentialInfo, withContext(vNetworkHandler.Client, ctx)}
+}
+
+func (securityHandler *ClouditSecurityHandler) WithContext(ctx context.Context) interface{} {
+	return &ClouditSecurityHandler{securityHandler.CredentialInfo, withContext(securityHandler.Client, ctx)}
+}
+
+func (nicHandler *ClouditNicHandler) WithContext(ctx context.Context) interface{} {
+	return &ClouditNicHandler{nicHandler.CredentialInfo, withContext(nicHandler.Client, ctx)}
+}
+
+func (publicIPHandler *ClouditPublicIPHandler) WithContext(ctx context.Context) interface{} {
+	return &ClouditPublicIPHandler{publicIPHandler.CredentialInfo, withContext(publicIPHandler.Client, ctx)}
+}
+
+func (vmHandler *ClouditVMHandler) WithContext(ctx context.Context) interface{} {
+	return &ClouditVMHandler{vmHandler.CredentialInfo, withContext(vmHandler.Client, ctx)}
+}
@@ -1,13 +1,12 @@
 package resources
 
 import (
-	"fmt"
+	"errors"
 	"github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/cloudit/client"
 	"github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/cloudit/client/ace/image"
 	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
-	"github.com/davecgh/go-spew/spew"
-	"strconv"
+	"time"
 )
 
 type ClouditImageHandler struct {
@@ -51,12 +50,13 @@ func (imageHandler *ClouditImageHandler) CreateImage(imageReqInfo irs.ImageReqIn
 	if image, err := image.Create(imageHandler.Client, &createOpts); err != nil {
 		return irs.ImageInfo{}, err
 	} else {
-		spew.Dump(image)
+		loggerOf(imageHandler.Client).Dump("image", image)
 		return irs.ImageInfo{Id: image.ID, Name: image.Name}, nil
 	}
 }
 
-func (imageHandler *ClouditImageHandler) ListImage() ([]*irs.ImageInfo, error) {
+// Cloudit Template API는 필터를 지원하지 않으므로 조회 후 필터링 함.
+func (imageHandler *ClouditImageHandler) ListImage(imageFilterInfo irs.ImageFilterInfo) ([]*irs.ImageInfo, error) {
 	imageHandler.Client.TokenID = imageHandler.CredentialInfo.AuthToken
 	authHeader := imageHandler.Client.AuthenticatedHeaders()
 
@@ -67,14 +67,54 @@ func (imageHandler *ClouditImageHandler) ListImage() ([]*irs.ImageInfo, error) {
 	if imageList, err := image.List(imageHandler.Client, &requestOpts); err != nil {
 		return nil, err
 	} else {
-		for i, image := range *imageList {
-			fmt.Println("[" + strconv.Itoa(i) + "]")
-			spew.Dump(image)
+		var imageInfoList []*irs.ImageInfo
+		for _, image := range *imageList {
+			imageInfo := mappingImageInfo(image)
+			if imageFilterInfo.Match(imageInfo) {
+				imageInfoList = append(imageInfoList, &imageInfo)
+			}
 		}
-		return nil, nil
+		return imageInfoList, nil
 	}
 }
 
+// NameFilter는 imageFilterInfo.NamePattern이 없을 때만 사용 함.
+// Cloudit API는 페이징, Tag를 지원하지 않으므로 전체 조회 후 페이징 함.
+func (imageHandler *ClouditImageHandler) ListImagePage(imageFilterInfo irs.ImageFilterInfo, listReqInfo irs.ListReqInfo) (irs.ImagePageInfo, error) {
+	if len(listReqInfo.TagFilter) > 0 {
+		return irs.ImagePageInfo{}, errors.New("Cloudit driver does not support TagFilter")
+	}
+	imageHandler.Client.TokenID = imageHandler.CredentialInfo.AuthToken
+	authHeader := imageHandler.Client.AuthenticatedHeaders()
+
+	requestOpts := client.RequestOpts{
+		MoreHeaders: authHeader,
+	}
+
+	if imageFilterInfo.NamePattern == "" {
+		imageFilterInfo.NamePattern = listReqInfo.NameFilter
+	}
+
+	templateList, err := image.List(imageHandler.Client, &requestOpts)
+	if err != nil {
+		return irs.ImagePageInfo{}, err
+	}
+
+	var imageList []*irs.ImageInfo
+	for _, template := range *templateList {
+		imageInfo := mappingImageInfo(template)
+		if imageFilterInfo.Match(imageInfo) {
+			imageList = append(imageList, &imageInfo)
+		}
+	}
+
+	start, end, nextToken, err := listReqInfo.LocalPage(len(imageList))
+	if err != nil {
+		return irs.ImagePageInfo{}, err
+	}
+	return irs.ImagePageInfo{ImageInfoList: imageList[start:end], NextToken: nextToken}, nil
+}
+
 func (imageHandler *ClouditImageHandler) GetImage(imageID string) (irs.ImageInfo, error) {
 	imageHandler.Client.TokenID = imageHandler.CredentialInfo.AuthToken
 	authHeader := imageHandler.Client.AuthenticatedHeaders()
@@ -86,8 +126,7 @@ func (imageHandler *ClouditImageHandler) GetImage(imageID string) (irs.ImageInfo
 	if image, err := image.Get(imageHandler.Client, imageID, &requestOpts); err != nil {
 		return irs.ImageInfo{}, err
 	} else {
-		spew.Dump(image)
-		return irs.ImageInfo{Id: image.ID, Name: image.Name}, nil
+		return mappingImageInfo(*image), nil
 	}
 }
 
@@ -105,3 +144,25 @@ func (imageHandler *ClouditImageHandler) DeleteImage(imageID string) (bool, erro
 		return true, nil
 	}
 }
+
+func mappingImageInfo(image image.ImageInfo) irs.ImageInfo {
+	imageInfo := irs.ImageInfo{
+		Id:           image.ID,
+		Name:         image.Name,
+		GuestOS:      irs.NormalizeGuestOS(image.OS),
+		Architecture: irs.NormalizeArchitecture(image.Arch),
+		SizeGB:       int64(image.Size),
+		Visibility:   irs.PublicImage,
+	}
+
+	// Ownership - TENANT: 테넌트 공유, PRIVATE: 개인 소유
+	if image.Ownership == "PRIVATE" {
+		imageInfo.Visibility = irs.PrivateImage
+	}
+
+	if creationTime, err := time.Parse("2006-01-02 15:04:05", image.CreatedAt); err == nil {
+		imageInfo.CreationTime = creationTime
+	}
+
+	return imageInfo
+}
@@ -4,10 +4,10 @@ import (
 	"errors"
 	"fmt"
 	"github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/cloudit/client"
+	"github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/cloudit/client/ace/server"
 	"github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/cloudit/client/dna/adaptiveip"
 	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
-	"github.com/davecgh/go-spew/spew"
 	"strconv"
 )
 
@@ -59,7 +59,7 @@ func (publicIPHandler *ClouditPublicIPHandler) CreatePublicIP(publicIPReqInfo ir
 	if err != nil {
 		panic(err)
 	} else {
-		spew.Dump(publicIP)
+		loggerOf(publicIPHandler.Client).Dump("publicIP", publicIP)
 		return irs.PublicIPInfo{Id: publicIP.IP, Name: publicIP.Name}, nil
 	}
 }
@@ -77,13 +77,49 @@ func (publicIPHandler *ClouditPublicIPHandler) ListPublicIP() ([]*irs.PublicIPIn
 		return nil, err
 	} else {
 		for i, publicIP := range *publicIPList {
-			fmt.Println("[" + strconv.Itoa(i) + "]")
-			spew.Dump(publicIP)
+			loggerOf(publicIPHandler.Client).Dump("publicIP["+strconv.Itoa(i)+"]", publicIP)
 		}
 		return nil, nil
 	}
 }
 
+// Cloudit API는 페이징, Tag를 지원하지 않으므로 전체 조회 후 페이징 함.
+func (publicIPHandler *ClouditPublicIPHandler) ListPublicIPPage(listReqInfo irs.ListReqInfo) (irs.PublicIPPageInfo, error) {
+	if len(listReqInfo.TagFilter) > 0 {
+		return irs.PublicIPPageInfo{}, errors.New("Cloudit driver does not support TagFilter")
+	}
+	publicIPHandler.Client.TokenID = publicIPHandler.CredentialInfo.AuthToken
+	authHeader := publicIPHandler.Client.AuthenticatedHeaders()
+
+	requestOpts := client.RequestOpts{
+		MoreHeaders: authHeader,
+	}
+
+	adaptiveIPList, err := adaptiveip.List(publicIPHandler.Client, &requestOpts)
+	if err != nil {
+		return irs.PublicIPPageInfo{}, err
+	}
+
+	var publicIPList []*irs.PublicIPInfo
+	for _, adaptiveIP := range *adaptiveIPList {
+		if listReqInfo.MatchName(adaptiveIP.Name) {
+			publicIPList = append(publicIPList, &irs.PublicIPInfo{
+				Id:               adaptiveIP.ID,
+				Name:             adaptiveIP.Name,
+				PublicIp:         adaptiveIP.IP,
+				PrivateIpAddress: adaptiveIP.PrivateIp,
+				Status:           adaptiveIP.State,
+			})
+		}
+	}
+
+	start, end, nextToken, err := listReqInfo.LocalPage(len(publicIPList))
+	if err != nil {
+		return irs.PublicIPPageInfo{}, err
+	}
+	return irs.PublicIPPageInfo{PublicIPInfoList: publicIPList[start:end], NextToken: nextToken}, nil
+}
+
 func (publicIPHandler *ClouditPublicIPHandler) GetPublicIP(publicIPID string) (irs.PublicIPInfo, error) {
 	publicIPHandler.Client.TokenID = publicIPHandler.CredentialInfo.AuthToken
 	authHeader := publicIPHandler.Client.AuthenticatedHeaders()
@@ -95,7 +131,7 @@ func (publicIPHandler *ClouditPublicIPHandler) GetPublicIP(publicIPID string) (i
 	if publicIP, err := adaptiveip.Get(publicIPHandler.Client, publicIPID, &requestOpts); err != nil {
 		return irs.PublicIPInfo{}, err
 	} else {
-		spew.Dump(publicIP)
+		loggerOf(publicIPHandler.Client).Dump("publicIP", publicIP)
 		return irs.PublicIPInfo{Id: publicIP.ID, Name: publicIP.Name}, nil
 	}
 }
@@ -114,3 +150,108 @@ func (publicIPHandler *ClouditPublicIPHandler) DeletePublicIP(publicIPID string)
 		return true, nil
 	}
 }
+
+// Cloudit의 AdaptiveIP는 VM Private IP와의 매핑으로만 존재 함.
+// 기존 매핑을 삭제한 후 같은 IP로 대상 VM의 Private IP에 다시 매핑 함. 다시 매핑하지 못하면 기존 매핑을 복구 함.
+// publicIPID는 AdaptiveIP ID 또는 (해제된 경우) IP 주소
+func (publicIPHandler *ClouditPublicIPHandler) AssociatePublicIP(publicIPID string, vmID string) (bool, error) {
+	publicIPHandler.Client.TokenID = publicIPHandler.CredentialInfo.AuthToken
+	authHeader := publicIPHandler.Client.AuthenticatedHeaders()
+
+	requestOpts := client.RequestOpts{
+		MoreHeaders: authHeader,
+	}
+
+	vm, err := server.Get(publicIPHandler.Client, vmID, &requestOpts)
+	if err != nil {
+		return false, err
+	}
+
+	ip, name, oldPrivateIP := publicIPID, publicIPID, ""
+	if adaptiveIP, err := publicIPHandler.findAdaptiveIP(publicIPID); err != nil {
+		return false, err
+	} else if adaptiveIP != nil {
+		if adaptiveIP.PrivateIp == vm.PrivateIp {
+			return true, nil
+		}
+		if err := adaptiveip.Delete(publicIPHandler.Client, adaptiveIP.ID, &requestOpts); err != nil {
+			return false, err
+		}
+		ip, name, oldPrivateIP = adaptiveIP.IP, adaptiveIP.Name, adaptiveIP.PrivateIp
+	}
+
+	if err := publicIPHandler.createAdaptiveIP(ip, name, vm.PrivateIp); err != nil {
+		if oldPrivateIP == "" {
+			return false, err
+		}
+		if restoreErr := publicIPHandler.createAdaptiveIP(ip, name, oldPrivateIP); restoreErr != nil {
+			cblogger.Errorf("failed to restore the mapping of %s to %s: %v", ip, oldPrivateIP, restoreErr)
+			return false, fmt.Errorf("failed to map %s to %s: %v, and failed to restore the mapping to %s: %v", ip, vm.PrivateIp, err, oldPrivateIP, restoreErr)
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (publicIPHandler *ClouditPublicIPHandler) createAdaptiveIP(ip string, name string, privateIP string) error {
+	// @TODO: PublicIP 생성 요청 파라미터 정의 필요
+	type PublicIPReqInfo struct {
+		IP        string `json:"ip" required:"true"`
+		Name      string `json:"name" required:"true"`
+		PrivateIP string `json:"privateIp" required:"true"`
+	}
+	reqInfo := PublicIPReqInfo{
+		IP:        ip,
+		Name:      name,
+		PrivateIP: privateIP,
+	}
+
+	createOpts := client.RequestOpts{
+		JSONBody:    reqInfo,
+		MoreHeaders: publicIPHandler.Client.AuthenticatedHeaders(),
+	}
+	_, err := adaptiveip.Create(publicIPHandler.Client, &createOpts)
+	return err
+}
+
+// 매핑을 삭제하면 IP는 사용 가능한 IP 목록으로 반환되며, IP 주소로 다시 AssociatePublicIP 할 수 있음.
+func (publicIPHandler *ClouditPublicIPHandler) DisassociatePublicIP(publicIPID string) (bool, error) {
+	publicIPHandler.Client.TokenID = publicIPHandler.CredentialInfo.AuthToken
+	authHeader := publicIPHandler.Client.AuthenticatedHeaders()
+
+	requestOpts := client.RequestOpts{
+		MoreHeaders: authHeader,
+	}
+
+	adaptiveIP, err := publicIPHandler.findAdaptiveIP(publicIPID)
+	if err != nil {
+		return false, err
+	}
+	if adaptiveIP == nil {
+		return true, nil
+	}
+
+	if err := adaptiveip.Delete(publicIPHandler.Client, adaptiveIP.ID, &requestOpts); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (publicIPHandler *ClouditPublicIPHandler) findAdaptiveIP(publicIPID string) (*adaptiveip.AdaptiveIPInfo, error) {
+	authHeader := publicIPHandler.Client.AuthenticatedHeaders()
+
+	requestOpts := client.RequestOpts{
+		MoreHeaders: authHeader,
+	}
+
+	publicIPList, err := adaptiveip.List(publicIPHandler.Client, &requestOpts)
+	if err != nil {
+		return nil, err
+	}
+	for _, publicIP := range *publicIPList {
+		if publicIP.ID == publicIPID || publicIP.IP == publicIPID {
+			return &publicIP, nil
+		}
+	}
+	return nil, nil
+}
@@ -1,12 +1,11 @@
 package resources
 
 import (
-	"fmt"
+	"errors"
 	"github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/cloudit/client"
 	"github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/cloudit/client/iam/securitygroup"
 	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
-	"github.com/davecgh/go-spew/spew"
 	"strconv"
 )
 
@@ -54,7 +53,7 @@ func (securityHandler *ClouditSecurityHandler) CreateSecurity(securityReqInfo ir
 	if securityGroup, err := securitygroup.Create(securityHandler.Client, &createOpts); err != nil {
 		return irs.SecurityInfo{}, err
 	} else {
-		spew.Dump(securityGroup)
+		loggerOf(securityHandler.Client).Dump("securityGroup", securityGroup)
 		return irs.SecurityInfo{Id: securityGroup.ID, Name: securityGroup.Name}, nil
 	}
 }
@@ -80,13 +79,43 @@ func (securityHandler *ClouditSecurityHandler) ListSecurity() ([]*irs.SecurityIn
 			}
 		}
 		for i, security := range *securityList {
-			fmt.Println("[" + strconv.Itoa(i) + "]")
-			spew.Dump(security)
+			loggerOf(securityHandler.Client).Dump("security["+strconv.Itoa(i)+"]", security)
 		}
 		return nil, nil
 	}
 }
 
+// Cloudit API는 페이징, Tag를 지원하지 않으므로 전체 조회 후 페이징 함.
+func (securityHandler *ClouditSecurityHandler) ListSecurityPage(listReqInfo irs.ListReqInfo) (irs.SecurityPageInfo, error) {
+	if len(listReqInfo.TagFilter) > 0 {
+		return irs.SecurityPageInfo{}, errors.New("Cloudit driver does not support TagFilter")
+	}
+	securityHandler.Client.TokenID = securityHandler.CredentialInfo.AuthToken
+	authHeader := securityHandler.Client.AuthenticatedHeaders()
+
+	requestOpts := client.RequestOpts{
+		MoreHeaders: authHeader,
+	}
+
+	securityGroupList, err := securitygroup.List(securityHandler.Client, &requestOpts)
+	if err != nil {
+		return irs.SecurityPageInfo{}, err
+	}
+
+	var securityList []*irs.SecurityInfo
+	for _, securityGroup := range *securityGroupList {
+		if listReqInfo.MatchName(securityGroup.Name) {
+			securityList = append(securityList, &irs.SecurityInfo{Id: securityGroup.ID, Name: securityGroup.Name})
+		}
+	}
+
+	start, end, nextToken, err := listReqInfo.LocalPage(len(securityList))
+	if err != nil {
+		return irs.SecurityPageInfo{}, err
+	}
+	return irs.SecurityPageInfo{SecurityInfoList: securityList[start:end], NextToken: nextToken}, nil
+}
+
 func (securityHandler *ClouditSecurityHandler) GetSecurity(securityID string) (irs.SecurityInfo, error) {
 	securityHandler.Client.TokenID = securityHandler.CredentialInfo.AuthToken
 	authHeader := securityHandler.Client.AuthenticatedHeaders()
@@ -105,7 +134,7 @@ func (securityHandler *ClouditSecurityHandler) GetSecurity(securityID string) (i
 			(*securityInfo).Rules = *sgRules
 			(*securityInfo).RulesCount = len(*sgRules)
 		}
-		spew.Dump(securityInfo)
+		loggerOf(securityHandler.Client).Dump("securityInfo", securityInfo)
 		return irs.SecurityInfo{Id: securityInfo.ID, Name: securityInfo.Name}, nil
 	}
 }
@@ -11,10 +11,18 @@
 package resources
 
 import (
+	"errors"
+	"fmt"
 	"github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/cloudit/client"
 	"github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/cloudit/client/ace/server"
 	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+	"time"
+)
+
+const (
+	waitStateMaxRetry = 120
+	waitStateInterval = 5 * time.Second
 )
 
 type ClouditVMHandler struct {
@@ -72,6 +80,11 @@ func (vmHandler *ClouditVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo,
 	}
 }
 
+// 일괄 생성 API가 없으므로 StartVM을 병렬로 호출 함.
+func (vmHandler *ClouditVMHandler) StartVMs(vmReqInfo irs.VMReqInfo, count int, minCount int) ([]*irs.VMBatchResult, error) {
+	return irs.StartVMsConcurrently(vmHandler, vmReqInfo, count, minCount)
+}
+
 func (vmHandler *ClouditVMHandler) SuspendVM(vmID string) {
 	vmHandler.Client.TokenID = vmHandler.CredentialInfo.AuthToken
 	authHeader := vmHandler.Client.AuthenticatedHeaders()
@@ -124,6 +137,98 @@ func (vmHandler *ClouditVMHandler) TerminateVM(vmID string) {
 	}
 }
 
+// Cloudit은 중지된 서버만 Spec을 변경할 수 있으므로 실행 중이면 중지 후 변경하고 다시 시작 함.
+// 중지 후 실패하면 서버를 다시 시작하고 실패 원인을 반환 함.
+// 진행 상태는 GetVMStatus로 확인 가능 함.
+func (vmHandler *ClouditVMHandler) ChangeVMSpec(vmID string, specID string) (irs.VMInfo, error) {
+	vmHandler.Client.TokenID = vmHandler.CredentialInfo.AuthToken
+	authHeader := vmHandler.Client.AuthenticatedHeaders()
+
+	requestOpts := client.RequestOpts{
+		MoreHeaders: authHeader,
+	}
+
+	vm, err := server.Get(vmHandler.Client, vmID, &requestOpts)
+	if err != nil {
+		return irs.VMInfo{}, err
+	}
+
+	wasRunning := vm.State == "RUNNING"
+	if wasRunning {
+		if err := server.Suspend(vmHandler.Client, vmID, &requestOpts); err != nil {
+			return irs.VMInfo{}, err
+		}
+		if err := vmHandler.waitForState(vmID, "STOPPED"); err != nil {
+			return irs.VMInfo{}, vmHandler.restartServer(vmID, err)
+		}
+	}
+
+	type SpecReqInfo struct {
+		SpecId string `json:"specId" required:"true"`
+	}
+	specOpts := client.RequestOpts{
+		MoreHeaders: authHeader,
+		JSONBody:    SpecReqInfo{SpecId: specID},
+	}
+	if err := server.ChangeSpec(vmHandler.Client, vmID, &specOpts); err != nil {
+		if wasRunning {
+			err = vmHandler.restartServer(vmID, err)
+		}
+		return irs.VMInfo{}, err
+	}
+
+	if wasRunning {
+		if err := vmHandler.startServer(vmID); err != nil {
+			return irs.VMInfo{}, err
+		}
+	}
+
+	vm, err = server.Get(vmHandler.Client, vmID, &requestOpts)
+	if err != nil {
+		return irs.VMInfo{}, err
+	}
+	return mappingServerInfo(*vm), nil
+}
+
+// 실패한 ChangeVMSpec이 중지한 서버를 다시 시작하고, 시작도 실패하면 그 원인을 err에 덧붙여 반환 함.
+func (vmHandler *ClouditVMHandler) restartServer(vmID string, err error) error {
+	if startErr := vmHandler.startServer(vmID); startErr != nil {
+		loggerOf(vmHandler.Client).Error(startErr)
+		return fmt.Errorf("%w, and failed to restart %s: %v", err, vmID, startErr)
+	}
+	return err
+}
+
+// 중지된 서버를 시작하고 RUNNING 상태가 될 때까지 대기 함.
+func (vmHandler *ClouditVMHandler) startServer(vmID string) error {
+	requestOpts := client.RequestOpts{
+		MoreHeaders: vmHandler.Client.AuthenticatedHeaders(),
+	}
+	if err := server.Resume(vmHandler.Client, vmID, &requestOpts); err != nil {
+		return err
+	}
+	return vmHandler.waitForState(vmID, "RUNNING")
+}
+
+// 서버가 state 상태가 될 때까지 대기 함.
+func (vmHandler *ClouditVMHandler) waitForState(vmID string, state string) error {
+	requestOpts := client.RequestOpts{
+		MoreHeaders: vmHandler.Client.AuthenticatedHeaders(),
+	}
+
+	for i := 0; i < waitStateMaxRetry; i++ {
+		vm, err := server.Get(vmHandler.Client, vmID, &requestOpts)
+		if err != nil {
+			return err
+		}
+		if vm.State == state {
+			return nil
+		}
+		time.Sleep(waitStateInterval)
+	}
+	return errors.New("timeout waiting for server " + vmID + " to be " + state)
+}
+
 func (vmHandler *ClouditVMHandler) ListVMStatus() []*irs.VMStatusInfo {
 	vmHandler.Client.TokenID = vmHandler.CredentialInfo.AuthToken
 	authHeader := vmHandler.Client.AuthenticatedHeaders()
@@ -182,6 +287,38 @@ func (vmHandler *ClouditVMHandler) ListVM() []*irs.VMInfo {
 	}
 }
 
+// Cloudit API는 페이징, Tag를 지원하지 않으므로 전체 조회 후 페이징 함.
+func (vmHandler *ClouditVMHandler) ListVMPage(listReqInfo irs.ListReqInfo) (irs.VMPageInfo, error) {
+	if len(listReqInfo.TagFilter) > 0 {
+		return irs.VMPageInfo{}, errors.New("Cloudit driver does not support TagFilter")
+	}
+	vmHandler.Client.TokenID = vmHandler.CredentialInfo.AuthToken
+	authHeader := vmHandler.Client.AuthenticatedHeaders()
+
+	requestOpts := client.RequestOpts{
+		MoreHeaders: authHeader,
+	}
+
+	serverList, err := server.List(vmHandler.Client, &requestOpts)
+	if err != nil {
+		return irs.VMPageInfo{}, err
+	}
+
+	var vmList []*irs.VMInfo
+	for _, vm := range *serverList {
+		if listReqInfo.MatchName(vm.Name) {
+			vmInfo := mappingServerInfo(vm)
+			vmList = append(vmList, &vmInfo)
+		}
+	}
+
+	start, end, nextToken, err := listReqInfo.LocalPage(len(vmList))
+	if err != nil {
+		return irs.VMPageInfo{}, err
+	}
+	return irs.VMPageInfo{VMInfoList: vmList[start:end], NextToken: nextToken}, nil
+}
+
 func (vmHandler *ClouditVMHandler) GetVM(vmID string) irs.VMInfo {
 	vmHandler.Client.TokenID = vmHandler.CredentialInfo.AuthToken
 	authHeader := vmHandler.Client.AuthenticatedHeaders()
@@ -7,7 +7,6 @@ import (
 	"github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/cloudit/client/dna/subnet"
 	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
-	"github.com/davecgh/go-spew/spew"
 	"strconv"
 )
 
@@ -60,7 +59,7 @@ func (vNetworkHandler *ClouditVNetworkHandler) CreateVNetwork(vNetReqInfo irs.VN
 	if subnet, err := subnet.Create(vNetworkHandler.Client, &createOpts); err != nil {
 		return irs.VNetworkInfo{}, err
 	} else {
-		spew.Dump(subnet)
+		loggerOf(vNetworkHandler.Client).Dump("subnet", subnet)
 		return irs.VNetworkInfo{Id: subnet.Addr, Name: subnet.Name}, nil
 	}
 }
@@ -77,13 +76,43 @@ func (vNetworkHandler *ClouditVNetworkHandler) ListVNetwork() ([]*irs.VNetworkIn
 		return nil, err
 	} else {
 		for i, vNet := range *vNetList {
-			fmt.Println("[" + strconv.Itoa(i) + "]")
-			spew.Dump(vNet)
+			loggerOf(vNetworkHandler.Client).Dump("vNet["+strconv.Itoa(i)+"]", vNet)
 		}
 		return nil, nil
 	}
 }
 
+// Cloudit API는 페이징, Tag를 지원하지 않으므로 전체 조회 후 페이징 함.
+func (vNetworkHandler *ClouditVNetworkHandler) ListVNetworkPage(listReqInfo irs.ListReqInfo) (irs.VNetworkPageInfo, error) {
+	if len(listReqInfo.TagFilter) > 0 {
+		return irs.VNetworkPageInfo{}, errors.New("Cloudit driver does not support TagFilter")
+	}
+	vNetworkHandler.Client.TokenID = vNetworkHandler.CredentialInfo.AuthToken
+	authHeader := vNetworkHandler.Client.AuthenticatedHeaders()
+
+	requestOpts := client.RequestOpts{
+		MoreHeaders: authHeader,
+	}
+
+	subnetList, err := subnet.List(vNetworkHandler.Client, &requestOpts)
+	if err != nil {
+		return irs.VNetworkPageInfo{}, err
+	}
+
+	var vNetworkList []*irs.VNetworkInfo
+	for _, vNet := range *subnetList {
+		if listReqInfo.MatchName(vNet.Name) {
+			vNetworkList = append(vNetworkList, &irs.VNetworkInfo{Id: vNet.ID, Name: vNet.Name, SubnetId: vNet.Addr})
+		}
+	}
+
+	start, end, nextToken, err := listReqInfo.LocalPage(len(vNetworkList))
+	if err != nil {
+		return irs.VNetworkPageInfo{}, err
+	}
+	return irs.VNetworkPageInfo{VNetworkInfoList: vNetworkList[start:end], NextToken: nextToken}, nil
+}
+
 func (vNetworkHandler *ClouditVNetworkHandler) GetVNetwork(vNetworkID string) (irs.VNetworkInfo, error) {
 	vNetworkHandler.Client.TokenID = vNetworkHandler.CredentialInfo.AuthToken
 	authHeader := vNetworkHandler.Client.AuthenticatedHeaders()
@@ -95,7 +124,7 @@ func (vNetworkHandler *ClouditVNetworkHandler) GetVNetwork(vNetworkID string) (i
 	if vNetwork, err := subnet.Get(vNetworkHandler.Client, vNetworkID, &requestOpts); err != nil {
 		return irs.VNetworkInfo{}, err
 	} else {
-		spew.Dump(vNetwork)
+		loggerOf(vNetworkHandler.Client).Dump("vNetwork", vNetwork)
 		return irs.VNetworkInfo{Id: vNetwork.ID, Name: vNetwork.Name}, nil
 	}
 }
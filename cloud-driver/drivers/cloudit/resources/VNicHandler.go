@@ -1,13 +1,13 @@
 package resources
 
 import (
-	"fmt"
+	"errors"
 	"github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/cloudit/client"
 	"github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/cloudit/client/ace/nic"
+	"github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/cloudit/client/ace/server"
 	"github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/cloudit/client/iam/securitygroup"
 	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
-	"github.com/davecgh/go-spew/spew"
 	"strconv"
 )
 
@@ -47,7 +47,7 @@ func (nicHandler *ClouditNicHandler) CreateVNic(vNicReqInfo irs.VNicReqInfo) (ir
 	if nic, err := nic.Create(nicHandler.Client, reqInfo.VmId, &createOpts); err != nil {
 		return irs.VNicInfo{}, err
 	} else {
-		spew.Dump(nic)
+		loggerOf(nicHandler.Client).Dump("nic", nic)
 		return irs.VNicInfo{Id: nic.Mac}, nil
 	}
 }
@@ -65,13 +65,51 @@ func (nicHandler *ClouditNicHandler) ListVNic() ([]*irs.VNicInfo, error) {
 		return nil, err
 	} else {
 		for i, nic := range *vNicList {
-			fmt.Println("[" + strconv.Itoa(i) + "]")
-			spew.Dump(nic)
+			loggerOf(nicHandler.Client).Dump("nic["+strconv.Itoa(i)+"]", nic)
 		}
 		return nil, nil
 	}
 }
 
+// NIC은 VM 단위로만 조회할 수 있으므로 전체 VM의 NIC을 조회 함. NIC은 Name이 없으므로 NameFilter는 VM Name에 적용 함.
+// Cloudit API는 페이징, Tag를 지원하지 않으므로 전체 조회 후 페이징 함.
+func (nicHandler *ClouditNicHandler) ListVNicPage(listReqInfo irs.ListReqInfo) (irs.VNicPageInfo, error) {
+	if len(listReqInfo.TagFilter) > 0 {
+		return irs.VNicPageInfo{}, errors.New("Cloudit driver does not support TagFilter")
+	}
+	nicHandler.Client.TokenID = nicHandler.CredentialInfo.AuthToken
+	authHeader := nicHandler.Client.AuthenticatedHeaders()
+
+	requestOpts := client.RequestOpts{
+		MoreHeaders: authHeader,
+	}
+
+	serverList, err := server.List(nicHandler.Client, &requestOpts)
+	if err != nil {
+		return irs.VNicPageInfo{}, err
+	}
+
+	var vNicList []*irs.VNicInfo
+	for _, vm := range *serverList {
+		if !listReqInfo.MatchName(vm.Name) {
+			continue
+		}
+		nicList, err := nic.List(nicHandler.Client, vm.ID, &requestOpts)
+		if err != nil {
+			return irs.VNicPageInfo{}, err
+		}
+		for _, vmNic := range *nicList {
+			vNicList = append(vNicList, &irs.VNicInfo{Id: vmNic.Mac, Name: vmNic.VmName + "-" + vmNic.Dev})
+		}
+	}
+
+	start, end, nextToken, err := listReqInfo.LocalPage(len(vNicList))
+	if err != nil {
+		return irs.VNicPageInfo{}, err
+	}
+	return irs.VNicPageInfo{VNicInfoList: vNicList[start:end], NextToken: nextToken}, nil
+}
+
 func (nicHandler *ClouditNicHandler) GetVNic(vNicID string) (irs.VNicInfo, error) {
 	nicHandler.Client.TokenID = nicHandler.CredentialInfo.AuthToken
 	authHeader := nicHandler.Client.AuthenticatedHeaders()
@@ -84,7 +122,7 @@ func (nicHandler *ClouditNicHandler) GetVNic(vNicID string) (irs.VNicInfo, error
 	if vNic, err := nic.Get(nicHandler.Client, serverId, vNicID, &requestOpts); err != nil {
 		return irs.VNicInfo{}, err
 	} else {
-		spew.Dump(vNic)
+		loggerOf(nicHandler.Client).Dump("vNic", vNic)
 		return irs.VNicInfo{Id: vNic.Mac}, nil
 	}
 }
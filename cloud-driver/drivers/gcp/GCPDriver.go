@@ -13,8 +13,7 @@ package gcp
 import (
 	"context"
 	"io/ioutil"
-	"log"
-	"time"
+	"net/http"
 
 	idrv "../../interfaces"
 	icon "../../interfaces/connect"
@@ -33,16 +32,17 @@ func (GCPDriver) GetDriverVersion() string {
 	return "GCP DRIVER Version 1.0"
 }
 
+// GCPCloudConnection이 생성하는 Handler만 지원 함.
+// CreatePublicIP, DeletePublicIP, ListPublicIP는 아직 Stub이므로 지원하지 않음.
 func (GCPDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 	var drvCapabilityInfo idrv.DriverCapabilityInfo
 
-	drvCapabilityInfo.ImageHandler = false
-	drvCapabilityInfo.VNetworkHandler = false
-	drvCapabilityInfo.SecurityHandler = false
-	drvCapabilityInfo.KeyPairHandler = false
-	drvCapabilityInfo.VNicHandler = false
-	drvCapabilityInfo.PublicIPHandler = false
-	drvCapabilityInfo.VMHandler = true
+	listReqFields := []string{idrv.ListPageSize, idrv.ListNextToken, idrv.ListNameFilter, idrv.ListTagFilter}
+	vmOperations := []string{"StartVM", "StartVMs", "SuspendVM", "ResumeVM", "RebootVM", "TerminateVM", "ChangeVMSpec", "ListVMStatus", "GetVMStatus", "ListVM", "ListVMPage", "GetVM"}
+
+	drvCapabilityInfo.ImageHandler = idrv.HandlerCapabilityInfo{Operations: []string{"CreateImage", "ListImage", "ListImagePage", "GetImage", "DeleteImage"}, ReqFields: append(listReqFields, idrv.ImageFilterOwner, idrv.ImageFilterGuestOS, idrv.ImageFilterArchitecture, idrv.ImageFilterNamePattern, idrv.ImageFilterVisibility)}
+	drvCapabilityInfo.PublicIPHandler = idrv.HandlerCapabilityInfo{Operations: []string{"ListPublicIPPage", "GetPublicIP", "AssociatePublicIP", "DisassociatePublicIP"}, ReqFields: listReqFields}
+	drvCapabilityInfo.VMHandler = idrv.HandlerCapabilityInfo{Operations: vmOperations, ReqFields: listReqFields}
 
 	return drvCapabilityInfo
 }
@@ -53,11 +53,15 @@ func (driver *GCPDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (icon.
 	// 3. create CloudConnection Instance of "connect/TDA_CloudConnection".
 	// 4. return CloudConnection Interface of TDA_CloudConnection.
 
-	Ctx, VMClient, err := getVMClient(connectionInfo.CredentialInfo)
+	transport := idrv.NewHTTPTransport()
+	VMClient, err := getVMClient(connectionInfo.CredentialInfo, transport)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
+	// Handler 요청은 Close()에서 취소 됨.
+	Ctx, CtxCancel := context.WithCancel(context.Background())
+
 	iConn := gcpcon.GCPCloudConnection{
 		Region:              connectionInfo.RegionInfo,
 		Credential:          connectionInfo.CredentialInfo,
@@ -69,29 +73,36 @@ func (driver *GCPDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (icon.
 		VNetClient:          VMClient,
 		VNicClient:          VMClient,
 		SubnetClient:        VMClient,
+
+		CtxCancel:     CtxCancel,
+		HTTPTransport: transport,
 	}
 	return &iConn, nil
 }
 
-func getVMClient(credential idrv.CredentialInfo) (context.Context, *compute.Service, error) {
+// 모든 Handler가 하나의 compute.Service를 공유 함.
+func getVMClient(credential idrv.CredentialInfo, transport *http.Transport) (*compute.Service, error) {
 
 	// GCP 는  ClientSecret에
 	// filepath를 전달 해서 credential.ClientSecret에 넣을꺼임
 	data, err := ioutil.ReadFile(credential.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
 	authURL := "https://www.googleapis.com/auth/compute"
 	conf, err := google.JWTConfigFromJSON(data, authURL)
 
 	if err != nil {
-		log.Fatal(err)
-		return nil, nil, err
+		return nil, err
 	}
-	client := conf.Client(oauth2.NoContext)
-
-	vmClient, err := compute.New(client)
+	// token 요청과 API 요청 모두 transport를 사용 함.
+	tokenCtx := context.WithValue(context.Background(), oauth2.HTTPClient, &http.Client{Transport: transport})
+	client := conf.Client(tokenCtx)
 
-	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
-
-	return ctx, vmClient, nil
+	return compute.New(client)
 }
 
 var TestDriver GCPDriver
+
+// checked by the driver manager before using TestDriver.
+var DriverManifest = idrv.NewDriverManifest("GCP", &TestDriver)
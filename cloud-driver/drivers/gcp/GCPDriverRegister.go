@@ -0,0 +1,20 @@
+// Proof of Concepts of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This registers the GCP Driver into the static driver registry.
+// The plugin(.so) build uses only GCPDriver.go and its exported TestDriver.
+//
+// by hyokyung.kim@innogrid.co.kr, 2019.07.
+
+package gcp
+
+import (
+	registry "../../driver-registry"
+)
+
+func init() {
+	registry.Register("gcp", &TestDriver)
+}
@@ -0,0 +1,2 @@
+rm -rf /tmp/GCPDriverServer
+go build -o /tmp/GCPDriverServer $CB_SPIDER_ROOT/cloud-driver/drivers/gcp/grpc-server
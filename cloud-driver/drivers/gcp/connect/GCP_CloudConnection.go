@@ -12,14 +12,17 @@ package connect
 
 import (
 	"context"
-	"fmt"
+	"net/http"
 
 	idrv "../../../interfaces"
 	irs "../../../interfaces/resources"
+	"../../../logger"
 	gcprs "../../gcp/resources"
 	compute "google.golang.org/api/compute/v1"
 )
 
+var cblogger = logger.New("GCP", "Connect")
+
 type GCPCloudConnection struct {
 	Region              idrv.RegionInfo
 	Credential          idrv.CredentialInfo
@@ -31,22 +34,25 @@ type GCPCloudConnection struct {
 	VNetClient          *compute.Service
 	VNicClient          *compute.Service
 	SubnetClient        *compute.Service
+
+	CtxCancel     context.CancelFunc
+	HTTPTransport *http.Transport // 모든 Client가 공유, Close()에서 해제 함.
 }
 
 // func (cloudConn *GCPCloudConnection) CreateVNetworkHandler() (irs.VNetworkHandler, error) {
-// 	fmt.Println("GCP Cloud Driver: called CreateVNetworkHandler()!")
+// 	cblogger.Debug("called CreateVNetworkHandler()")
 // 	vNetHandler := gcprs.GCPVNetworkHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.VNetClient}
 // 	return &vNetHandler, nil
 // }
 
-// func (cloudConn *GCPCloudConnection) CreateImageHandler() (irs.ImageHandler, error) {
-// 	fmt.Println("GCP Cloud Driver: called CreateImageHandler()!")
-// 	imageHandler := gcprs.GCPImageHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.ImageClient}
-// 	return &imageHandler, nil
-// }
+func (cloudConn *GCPCloudConnection) CreateImageHandler() (irs.ImageHandler, error) {
+	cblogger.Debug("called CreateImageHandler()")
+	imageHandler := gcprs.GCPImageHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.ImageClient, cloudConn.Credential}
+	return &imageHandler, nil
+}
 
 // func (cloudConn *GCPCloudConnection) CreateSecurityHandler() (irs.SecurityHandler, error) {
-// 	fmt.Println("GCP Cloud Driver: called CreateSecurityHandler()!")
+// 	cblogger.Debug("called CreateSecurityHandler()")
 // 	sgHandler := gcprs.GCPSecurityHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.SecurityGroupClient}
 // 	return &sgHandler, nil
 // }
@@ -55,25 +61,55 @@ type GCPCloudConnection struct {
 // }
 
 // func (cloudConn *GCPCloudConnection) CreateVNicHandler() (irs.VNicHandler, error) {
-// 	fmt.Println("GCP Cloud Driver: called CreateVNicHandler()!")
+// 	cblogger.Debug("called CreateVNicHandler()")
 // 	vNicHandler := gcprs.GCPVNicHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.VNicClient, cloudConn.SubnetClient}
 // 	return &vNicHandler, nil
 // }
 func (cloudConn *GCPCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
-	fmt.Println("GCP Cloud Driver: called CreatePublicIPHandler()!")
-	publicIPHandler := gcprs.GCPPublicIPHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.PublicIPClient}
+	cblogger.Debug("called CreatePublicIPHandler()")
+	publicIPHandler := gcprs.GCPPublicIPHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.PublicIPClient, cloudConn.Credential}
 	return &publicIPHandler, nil
 }
 
 func (cloudConn *GCPCloudConnection) CreateVMHandler() (irs.VMHandler, error) {
-	fmt.Println("GCP Cloud Driver: called CreateVMHandler()!")
+	cblogger.Debug("called CreateVMHandler()")
 	vmHandler := gcprs.GCPVMHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.VMClient, cloudConn.Credential}
 	return &vmHandler, nil
 }
 
-func (GCPCloudConnection) IsConnected() (bool, error) {
+// VNetwork, Security, KeyPair, VNic Handler는 아직 구현되지 않음.
+func (GCPCloudConnection) CreateVNetworkHandler() (irs.VNetworkHandler, error) {
+	return nil, irs.NotSupported("GCP driver does not support VNetworkHandler")
+}
+
+func (GCPCloudConnection) CreateSecurityHandler() (irs.SecurityHandler, error) {
+	return nil, irs.NotSupported("GCP driver does not support SecurityHandler")
+}
+
+func (GCPCloudConnection) CreateKeyPairHandler() (irs.KeyPairHandler, error) {
+	return nil, irs.NotSupported("GCP driver does not support KeyPairHandler")
+}
+
+func (GCPCloudConnection) CreateVNicHandler() (irs.VNicHandler, error) {
+	return nil, irs.NotSupported("GCP driver does not support VNicHandler")
+}
+
+// IsConnected checks the credential with zones.get of the connection zone.
+func (cloudConn *GCPCloudConnection) IsConnected() (bool, error) {
+	_, err := cloudConn.VMClient.Zones.Get(cloudConn.Credential.ProjectID, cloudConn.Region.Zone).Context(cloudConn.Ctx).Do()
+	if err != nil {
+		return false, irs.ConnectionError(err)
+	}
 	return true, nil
 }
-func (GCPCloudConnection) Close() error {
+
+// Close cancels in-flight requests of the handlers and releases idle HTTP connections.
+func (cloudConn *GCPCloudConnection) Close() error {
+	if cloudConn.CtxCancel != nil {
+		cloudConn.CtxCancel()
+	}
+	if cloudConn.HTTPTransport != nil {
+		cloudConn.HTTPTransport.CloseIdleConnections()
+	}
 	return nil
 }
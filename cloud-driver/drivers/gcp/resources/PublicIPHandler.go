@@ -3,8 +3,10 @@ package resources
 import (
 	"context"
 	"encoding/json"
-	"log"
+	"errors"
+	"fmt"
 	"strings"
+	"time"
 
 	idrv "../../../interfaces"
 	irs "../../../interfaces/resources"
@@ -42,7 +44,7 @@ func (publicIpHandler *GCPPublicIPHandler) ListPublicIP() ([]*irs.PublicIPInfo,
 
 	list, err := publicIpHandler.Client.Addresses.List(projectID, region).Do()
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 	for _, item := range list.Items {
 
@@ -50,17 +52,48 @@ func (publicIpHandler *GCPPublicIPHandler) ListPublicIP() ([]*irs.PublicIPInfo,
 	return nil, nil
 }
 
+// GCP의 PageToken/MaxResults로 페이징 함. (MaxResults 범위: 1~500)
+func (publicIpHandler *GCPPublicIPHandler) ListPublicIPPage(listReqInfo irs.ListReqInfo) (irs.PublicIPPageInfo, error) {
+	projectID := publicIpHandler.Credential.ProjectID
+	region := publicIpHandler.Region.Region
+
+	req := publicIpHandler.Client.Addresses.List(projectID, region).PageToken(listReqInfo.NextToken)
+	filter, matchNameLocal := listFilter(listReqInfo.NameFilter, listReqInfo.TagFilter)
+	if filter != "" {
+		req = req.Filter(filter)
+	}
+	if listReqInfo.PageSize > 0 {
+		req = req.MaxResults(pageSizeRange(listReqInfo.PageSize, 500))
+	}
+
+	addressList, err := req.Context(publicIpHandler.Ctx).Do()
+	if err != nil {
+		return irs.PublicIPPageInfo{}, err
+	}
+
+	publicIPPageInfo := irs.PublicIPPageInfo{NextToken: addressList.NextPageToken}
+	for _, address := range addressList.Items {
+		if matchNameLocal && !listReqInfo.MatchName(address.Name) {
+			continue
+		}
+		publicIPInfo := mappingAddressInfo(address)
+		publicIPPageInfo.PublicIPInfoList = append(publicIPPageInfo.PublicIPInfoList, &publicIPInfo)
+	}
+
+	return publicIPPageInfo, nil
+}
+
 func (publicIpHandler *GCPPublicIPHandler) GetPublicIP(publicIPID string) (irs.PublicIPInfo, error) {
 	projectID := publicIpHandler.Credential.projectID
 	region := publicIpHandler.Region.region
 	name := publicIPID
 	info, err := publicIpHandler.Client.Addresses.Get(projectID, region, name).Do()
 	if err != nil {
-		log.Fatal(err)
+		return irs.PublicIPInfo{}, err
 	}
 	infoByte, err := info.MarshalJSON()
 	if err != nil {
-		log.Fatal(err)
+		return irs.PublicIPInfo{}, err
 	}
 
 	var publicInfo irs.PublicIPInfo
@@ -70,7 +103,7 @@ func (publicIpHandler *GCPPublicIPHandler) GetPublicIP(publicIPID string) (irs.P
 	vmArr := strings.Split(users, "/")
 	&publicInfo.InstanceId = vmArr[len(vmArr)-1]
 	if err != nil {
-		log.Fatal(err)
+		return irs.PublicIPInfo{}, err
 	}
 
 	return publicInfo, err
@@ -87,3 +120,194 @@ func (*GCPPublicIPHandler) mappingPublicIpInfo(infos []byte) (irs.PublicIPInfo,
 
 	return publicInfo
 }
+
+// GCP는 Name을 ID로 사용 함.
+func mappingAddressInfo(address *compute.Address) irs.PublicIPInfo {
+	regionArr := strings.Split(address.Region, "/")
+	publicIPInfo := irs.PublicIPInfo{
+		Name:              address.Name,
+		Id:                address.Name,
+		PublicIp:          address.Address,
+		Region:            regionArr[len(regionArr)-1],
+		CreationTimestamp: address.CreationTimestamp,
+		Address:           address.Address,
+		NetworkTier:       address.NetworkTier,
+		AddressType:       address.AddressType,
+		Status:            address.Status,
+	}
+	if len(address.Users) > 0 {
+		vmArr := strings.Split(address.Users[0], "/")
+		publicIPInfo.InstanceId = vmArr[len(vmArr)-1]
+	}
+	return publicIPInfo
+}
+
+// 삭제한 AccessConfig. 실패 시 복원에 사용 함.
+type removedAccessConfig struct {
+	zone         string
+	vmName       string
+	nicName      string
+	accessConfig *compute.AccessConfig
+}
+
+// GCP는 VM NIC의 AccessConfig에 고정 IP(Address)를 연결하며, NIC당 AccessConfig는 하나만 허용 됨.
+// 대상 VM을 먼저 확인하고, 연결에 실패하면 삭제한 AccessConfig(다른 VM의 연결 포함)를 복원 함.
+// vmID는 VM Name
+func (publicIpHandler *GCPPublicIPHandler) AssociatePublicIP(publicIPID string, vmID string) (bool, error) {
+	projectID := publicIpHandler.Credential.ProjectID
+	region := publicIpHandler.Region.Region
+	zone := publicIpHandler.Region.Zone
+
+	address, err := publicIpHandler.Client.Addresses.Get(projectID, region, publicIPID).Do()
+	if err != nil {
+		return false, err
+	}
+
+	vm, err := publicIpHandler.Client.Instances.Get(projectID, zone, vmID).Do()
+	if err != nil {
+		return false, err
+	}
+	if len(vm.NetworkInterfaces) == 0 {
+		return false, irs.InvalidArgument("VM %s has no network interface", vmID)
+	}
+	nic := vm.NetworkInterfaces[0]
+
+	// 다른 VM에 연결되어 있으면 먼저 해제
+	removed, err := publicIpHandler.detachAddress(address)
+	if err != nil {
+		return false, publicIpHandler.restoreAccessConfigs(removed, err)
+	}
+
+	// 기존 Ephemeral IP AccessConfig 제거
+	for _, accessConfig := range nic.AccessConfigs {
+		if err := publicIpHandler.deleteAccessConfig(zone, vmID, nic.Name, accessConfig); err != nil {
+			return false, publicIpHandler.restoreAccessConfigs(removed, err)
+		}
+		removed = append(removed, removedAccessConfig{zone: zone, vmName: vmID, nicName: nic.Name, accessConfig: accessConfig})
+	}
+
+	accessConfig := &compute.AccessConfig{
+		Type:  "ONE_TO_ONE_NAT",
+		Name:  "External NAT",
+		NatIP: address.Address,
+	}
+	if err := publicIpHandler.addAccessConfig(zone, vmID, nic.Name, accessConfig); err != nil {
+		return false, publicIpHandler.restoreAccessConfigs(removed, err)
+	}
+	return true, nil
+}
+
+func (publicIpHandler *GCPPublicIPHandler) DisassociatePublicIP(publicIPID string) (bool, error) {
+	projectID := publicIpHandler.Credential.ProjectID
+	region := publicIpHandler.Region.Region
+
+	address, err := publicIpHandler.Client.Addresses.Get(projectID, region, publicIPID).Do()
+	if err != nil {
+		return false, err
+	}
+	if _, err := publicIpHandler.detachAddress(address); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// address가 연결된 VM에서 address의 AccessConfig를 삭제하고, 삭제한 AccessConfig를 반환 함.
+func (publicIpHandler *GCPPublicIPHandler) detachAddress(address *compute.Address) ([]removedAccessConfig, error) {
+	if len(address.Users) == 0 {
+		return nil, nil
+	}
+
+	// Users 형식 : https://www.googleapis.com/compute/v1/projects/{project}/zones/{zone}/instances/{name}
+	userArr := strings.Split(address.Users[0], "/")
+	if len(userArr) < 4 {
+		return nil, errors.New("invalid address user " + address.Users[0])
+	}
+	zone, vmName := userArr[len(userArr)-3], userArr[len(userArr)-1]
+
+	vm, err := publicIpHandler.Client.Instances.Get(publicIpHandler.Credential.ProjectID, zone, vmName).Do()
+	if err != nil {
+		return nil, err
+	}
+	for _, nic := range vm.NetworkInterfaces {
+		for _, accessConfig := range nic.AccessConfigs {
+			if accessConfig.NatIP != address.Address {
+				continue
+			}
+			if err := publicIpHandler.deleteAccessConfig(zone, vmName, nic.Name, accessConfig); err != nil {
+				return nil, err
+			}
+			return []removedAccessConfig{{zone: zone, vmName: vmName, nicName: nic.Name, accessConfig: accessConfig}}, nil
+		}
+	}
+	return nil, nil
+}
+
+func (publicIpHandler *GCPPublicIPHandler) deleteAccessConfig(zone string, vmName string, nicName string, accessConfig *compute.AccessConfig) error {
+	projectID := publicIpHandler.Credential.ProjectID
+
+	op, err := publicIpHandler.Client.Instances.DeleteAccessConfig(projectID, zone, vmName, accessConfig.Name, nicName).Do()
+	if err != nil {
+		return err
+	}
+	return waitZoneOperation(publicIpHandler.Client, projectID, zone, op)
+}
+
+// 삭제한 AccessConfig를 역순으로 다시 추가하고, 복원도 실패하면 그 원인을 err에 덧붙여 반환 함.
+// 해제된 Ephemeral IP는 다시 할당받을 수 없으므로 새 Ephemeral IP로 복원 함.
+func (publicIpHandler *GCPPublicIPHandler) restoreAccessConfigs(removed []removedAccessConfig, err error) error {
+	projectID := publicIpHandler.Credential.ProjectID
+
+	var restoreErrs []string
+	for i := len(removed) - 1; i >= 0; i-- {
+		r := removed[i]
+		accessConfig := &compute.AccessConfig{
+			Type:        r.accessConfig.Type,
+			Name:        r.accessConfig.Name,
+			NatIP:       r.accessConfig.NatIP,
+			NetworkTier: r.accessConfig.NetworkTier,
+		}
+		restoreErr := publicIpHandler.addAccessConfig(r.zone, r.vmName, r.nicName, accessConfig)
+		if restoreErr != nil && accessConfig.NatIP != "" {
+			accessConfig.NatIP = ""
+			restoreErr = publicIpHandler.addAccessConfig(r.zone, r.vmName, r.nicName, accessConfig)
+		}
+		if restoreErr != nil {
+			cblogger.Errorf("failed to restore the access config %s of %s: %v", r.accessConfig.Name, r.vmName, restoreErr)
+			restoreErrs = append(restoreErrs, r.vmName+": "+restoreErr.Error())
+		}
+	}
+	if len(restoreErrs) > 0 {
+		return fmt.Errorf("%w, and failed to restore the access configs: %s", err, strings.Join(restoreErrs, "; "))
+	}
+	return err
+}
+
+func (publicIpHandler *GCPPublicIPHandler) addAccessConfig(zone string, vmName string, nicName string, accessConfig *compute.AccessConfig) error {
+	projectID := publicIpHandler.Credential.ProjectID
+
+	op, err := publicIpHandler.Client.Instances.AddAccessConfig(projectID, zone, vmName, nicName, accessConfig).Do()
+	if err != nil {
+		return err
+	}
+	return waitZoneOperation(publicIpHandler.Client, projectID, zone, op)
+}
+
+// Zone Operation이 완료될 때까지 operationTimeout 동안 대기 함.
+func waitZoneOperation(client *compute.Service, projectID string, zone string, op *compute.Operation) error {
+	deadline := time.Now().Add(operationTimeout)
+	for op.Status != "DONE" {
+		if time.Now().After(deadline) {
+			return irs.NewCloudError(irs.TimeoutError, "operation %s is not done in %v", op.Name, operationTimeout)
+		}
+		time.Sleep(time.Second)
+		var err error
+		op, err = client.ZoneOperations.Get(projectID, zone, op.Name).Do()
+		if err != nil {
+			return err
+		}
+	}
+	if op.Error != nil && len(op.Error.Errors) > 0 {
+		return errors.New(op.Error.Errors[0].Message)
+	}
+	return nil
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/Azure/go-autorest/autorest/to"
 	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
-	"github.com/davecgh/go-spew/spew"
 	"strings"
 )
 
@@ -184,7 +183,7 @@ func (securityHandler *AzureSecurityHandler) ListSecurity() ([]*irs.SecurityInfo
 		securityList = append(securityList, securityInfo)
 	}
 
-	spew.Dump(securityList)
+	cblogger.Dump("securityList", securityList)
 	return nil, nil
 }
 
@@ -197,7 +196,7 @@ func (securityHandler *AzureSecurityHandler) GetSecurity(securityID string) (irs
 
 	securityInfo := new(SecurityInfo).setter(security)
 
-	spew.Dump(securityInfo)
+	cblogger.Dump("securityInfo", securityInfo)
 	return irs.SecurityInfo{}, nil
 }
 
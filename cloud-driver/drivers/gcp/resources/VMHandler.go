@@ -14,15 +14,19 @@ import (
 	"context"
 	_ "errors"
 	"fmt"
-	"log"
+	"sort"
+	"strings"
 
 	compute "google.golang.org/api/compute/v1"
 
 	idrv "../../../interfaces"
 	irs "../../../interfaces/resources"
+	"../../../logger"
 	_ "github.com/Azure/go-autorest/autorest/to"
 )
 
+var cblogger = logger.New("GCP", "Resources")
+
 type GCPVMHandler struct {
 	Region     idrv.RegionInfo
 	Ctx        context.Context
@@ -81,12 +85,10 @@ func (vmHandler *GCPVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, err
 	}
 
 	op, err := vmHandler.Client.Instances.Insert(projectID, zone, instance).Do()
-	js, err := op.MarshalJSON()
 	if err != nil {
-		log.Fatal(err)
+		return irs.VMInfo{}, err
 	}
-	fmt.Println("Insert vm to marshal Json : ", string(js))
-	log.Printf("Got compute.Operation, err: %#v, %v", op, err)
+	cblogger.Dump("Insert vm operation", op)
 
 	// 이게 시작하는  api Start 내부 매개변수로 projectID, zone, InstanceID
 	//vm, err := vmHandler.Client.Instances.Start(project string, zone string, instance string)
@@ -99,6 +101,11 @@ func (vmHandler *GCPVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, err
 	return vmInfo, nil
 }
 
+// 일괄 생성 API가 없으므로 StartVM을 병렬로 호출 함.
+func (vmHandler *GCPVMHandler) StartVMs(vmReqInfo irs.VMReqInfo, count int, minCount int) ([]*irs.VMBatchResult, error) {
+	return irs.StartVMsConcurrently(vmHandler, vmReqInfo, count, minCount)
+}
+
 // stop이라고 보면 될듯
 func (vmHandler *GCPVMHandler) SuspendVM(vmID string) {
 	projectID := vmHandler.Credential.ProjectID
@@ -111,7 +118,7 @@ func (vmHandler *GCPVMHandler) SuspendVM(vmID string) {
 		panic(err)
 	}
 
-	fmt.Println("instance stop status :", inst.Status)
+	cblogger.Info("instance stop status : ", inst.Status)
 }
 
 func (vmHandler *GCPVMHandler) ResumeVM(vmID string) {
@@ -126,7 +133,7 @@ func (vmHandler *GCPVMHandler) ResumeVM(vmID string) {
 		panic(err)
 	}
 
-	fmt.Println("instance resume status :", inst.Status)
+	cblogger.Info("instance resume status : ", inst.Status)
 
 }
 
@@ -147,7 +154,79 @@ func (vmHandler *GCPVMHandler) TerminateVM(vmID string) {
 		panic(err)
 	}
 
-	fmt.Println("instance status :", inst.Status)
+	cblogger.Info("instance terminate status : ", inst.Status)
+}
+
+// GCE는 중지된 인스턴스만 Machine Type을 변경할 수 있으므로 실행 중이면 중지 후 변경하고 다시 시작 함.
+// 중지 후 실패하면 인스턴스를 다시 시작하고 실패 원인을 반환 함.
+// 진행 상태는 GetVMStatus로 확인 가능 함. (STOPPING -> TERMINATED -> STAGING -> RUNNING)
+func (vmHandler *GCPVMHandler) ChangeVMSpec(vmID string, specID string) (irs.VMInfo, error) {
+	projectID := vmHandler.Credential.ProjectID
+	zone := vmHandler.Region.Zone
+	ctx := vmHandler.Ctx
+
+	vm, err := vmHandler.Client.Instances.Get(projectID, zone, vmID).Context(ctx).Do()
+	if err != nil {
+		return irs.VMInfo{}, err
+	}
+
+	wasRunning := vm.Status == "RUNNING"
+	if wasRunning {
+		op, err := vmHandler.Client.Instances.Stop(projectID, zone, vmID).Context(ctx).Do()
+		if err != nil {
+			return irs.VMInfo{}, err
+		}
+		if err := waitZoneOperation(vmHandler.Client, projectID, zone, op); err != nil {
+			return irs.VMInfo{}, vmHandler.restartInstance(vmID, err)
+		}
+	}
+
+	machineType := &compute.InstancesSetMachineTypeRequest{
+		MachineType: "zones/" + zone + "/machineTypes/" + specID,
+	}
+	op, err := vmHandler.Client.Instances.SetMachineType(projectID, zone, vmID, machineType).Context(ctx).Do()
+	if err == nil {
+		err = waitZoneOperation(vmHandler.Client, projectID, zone, op)
+	}
+	if err != nil {
+		if wasRunning {
+			err = vmHandler.restartInstance(vmID, err)
+		}
+		return irs.VMInfo{}, err
+	}
+
+	if wasRunning {
+		if err := vmHandler.startInstance(vmID); err != nil {
+			return irs.VMInfo{}, err
+		}
+	}
+
+	vm, err = vmHandler.Client.Instances.Get(projectID, zone, vmID).Context(ctx).Do()
+	if err != nil {
+		return irs.VMInfo{}, err
+	}
+	return mappingServerInfo(vm), nil
+}
+
+// restartInstance starts the instance stopped by a failed ChangeVMSpec, and returns err with the error of the start.
+func (vmHandler *GCPVMHandler) restartInstance(vmID string, err error) error {
+	if startErr := vmHandler.startInstance(vmID); startErr != nil {
+		cblogger.Error(startErr)
+		return fmt.Errorf("%w, and failed to restart %s: %v", err, vmID, startErr)
+	}
+	return err
+}
+
+// startInstance starts the stopped instance and waits until the operation is done.
+func (vmHandler *GCPVMHandler) startInstance(vmID string) error {
+	projectID := vmHandler.Credential.ProjectID
+	zone := vmHandler.Region.Zone
+
+	op, err := vmHandler.Client.Instances.Start(projectID, zone, vmID).Context(vmHandler.Ctx).Do()
+	if err != nil {
+		return err
+	}
+	return waitZoneOperation(vmHandler.Client, projectID, zone, op)
 }
 
 func (vmHandler *GCPVMHandler) ListVMStatus() []*irs.VMStatusInfo {
@@ -208,6 +287,37 @@ func (vmHandler *GCPVMHandler) ListVM() []*irs.VMInfo {
 	return vmList
 }
 
+// GCP의 PageToken/MaxResults로 페이징 함. (MaxResults 범위: 1~500)
+func (vmHandler *GCPVMHandler) ListVMPage(listReqInfo irs.ListReqInfo) (irs.VMPageInfo, error) {
+	projectID := vmHandler.Credential.ProjectID
+	zone := vmHandler.Region.Zone
+
+	req := vmHandler.Client.Instances.List(projectID, zone).PageToken(listReqInfo.NextToken)
+	filter, matchNameLocal := listFilter(listReqInfo.NameFilter, listReqInfo.TagFilter)
+	if filter != "" {
+		req = req.Filter(filter)
+	}
+	if listReqInfo.PageSize > 0 {
+		req = req.MaxResults(pageSizeRange(listReqInfo.PageSize, 500))
+	}
+
+	serverList, err := req.Context(vmHandler.Ctx).Do()
+	if err != nil {
+		return irs.VMPageInfo{}, err
+	}
+
+	vmPageInfo := irs.VMPageInfo{NextToken: serverList.NextPageToken}
+	for _, server := range serverList.Items {
+		if matchNameLocal && !listReqInfo.MatchName(server.Name) {
+			continue
+		}
+		vmInfo := mappingServerInfo(server)
+		vmPageInfo.VMInfoList = append(vmPageInfo.VMInfoList, &vmInfo)
+	}
+
+	return vmPageInfo, nil
+}
+
 func (vmHandler *GCPVMHandler) GetVM(vmName string) irs.VMInfo {
 	projectID := vmHandler.Credential.ProjectID
 	zone := vmHandler.Region.Zone
@@ -267,3 +377,28 @@ func mappingServerInfo(server *compute.Instance) irs.VMInfo {
 
 	return vmInfo
 }
+
+// GCP List Filter에서 정규식(eq)은 단일 식에만 사용할 수 있으므로,
+// Label 필터가 있으면 Name은 조회 후 필터링 함. (이 경우 페이지 크기가 PageSize보다 작을 수 있음)
+func listFilter(namePattern string, labels map[string]string) (string, bool) {
+	if len(labels) == 0 {
+		if namePattern == "" {
+			return "", false
+		}
+		return "name eq " + wildcardToRegexp(namePattern), false
+	}
+
+	var exprs []string
+	for k, v := range labels {
+		exprs = append(exprs, fmt.Sprintf("(labels.%s = \"%s\")", k, v))
+	}
+	sort.Strings(exprs)
+	return strings.Join(exprs, " "), namePattern != ""
+}
+
+func pageSizeRange(pageSize int, max int64) int64 {
+	if int64(pageSize) > max {
+		return max
+	}
+	return int64(pageSize)
+}
@@ -132,7 +132,7 @@ package resources
 // 		vNetList = append(vNetList, vNetInfo)
 // 	}
 
-// 	spew.Dump(vNetList)
+// 	cblogger.Dump("vNetList", vNetList)
 // 	return nil, nil
 // }
 
@@ -145,7 +145,7 @@ package resources
 
 // 	vNetInfo := new(VNetworkInfo).setter(vNetwork)
 
-// 	spew.Dump(vNetInfo)
+// 	cblogger.Dump("vNetInfo", vNetInfo)
 // 	return irs.VNetworkInfo{}, nil
 // }
 
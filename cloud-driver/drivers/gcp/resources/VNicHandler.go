@@ -7,7 +7,6 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
 	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
-	"github.com/davecgh/go-spew/spew"
 	"strings"
 )
 
@@ -155,7 +154,7 @@ func (vNicHandler *AzureVNicHandler) ListVNic() ([]*irs.VNicInfo, error) {
 		vNicList = append(vNicList, vNicInfo)
 	}
 
-	spew.Dump(vNicList)
+	cblogger.Dump("vNicList", vNicList)
 	return nil, nil
 }
 
@@ -168,7 +167,7 @@ func (vNicHandler *AzureVNicHandler) GetVNic(vNicID string) (irs.VNicInfo, error
 
 	vNicInfo := new(VNicInfo).setter(vNic)
 
-	spew.Dump(vNicInfo)
+	cblogger.Dump("vNicInfo", vNicInfo)
 	return irs.VNicInfo{}, nil
 }
 
@@ -16,6 +16,7 @@ import (
 	icon "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/connect"
 	"github.com/rackspace/gophercloud"
 	"github.com/rackspace/gophercloud/openstack"
+	"net/http"
 )
 
 type OpenStackDriver struct{}
@@ -24,16 +25,21 @@ func (OpenStackDriver) GetDriverVersion() string {
 	return "OPENSTACK DRIVER Version 1.0"
 }
 
+// List{Resource}와 Image, VM 외의 Get{Resource}는 Stub이므로 지원하지 않으며 List{Resource}Page를 사용 함.
 func (OpenStackDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 	var drvCapabilityInfo idrv.DriverCapabilityInfo
 
-	drvCapabilityInfo.ImageHandler = true
-	drvCapabilityInfo.VNetworkHandler = true
-	drvCapabilityInfo.SecurityHandler = true
-	drvCapabilityInfo.KeyPairHandler = true
-	drvCapabilityInfo.VNicHandler = false
-	drvCapabilityInfo.PublicIPHandler = true
-	drvCapabilityInfo.VMHandler = true
+	listReqFields := []string{idrv.ListPageSize, idrv.ListNextToken, idrv.ListNameFilter}
+	vmOperations := []string{"StartVM", "StartVMs", "SuspendVM", "ResumeVM", "RebootVM", "TerminateVM", "ChangeVMSpec", "ListVMStatus", "GetVMStatus", "ListVM", "ListVMPage", "GetVM"}
+
+	// VM, Image는 TagFilter를 Metadata로 대신 필터링 함.
+	drvCapabilityInfo.ImageHandler = idrv.HandlerCapabilityInfo{Operations: []string{"CreateImage", "ListImage", "ListImagePage", "GetImage", "DeleteImage"}, ReqFields: append(listReqFields, idrv.ListTagFilter, idrv.ImageFilterGuestOS, idrv.ImageFilterArchitecture, idrv.ImageFilterNamePattern)}
+	drvCapabilityInfo.VNetworkHandler = idrv.HandlerCapabilityInfo{Operations: []string{"CreateVNetwork", "ListVNetworkPage", "DeleteVNetwork"}, ReqFields: listReqFields}
+	drvCapabilityInfo.SecurityHandler = idrv.HandlerCapabilityInfo{Operations: []string{"CreateSecurity", "ListSecurityPage", "DeleteSecurity"}, ReqFields: listReqFields}
+	drvCapabilityInfo.KeyPairHandler = idrv.HandlerCapabilityInfo{Operations: []string{"CreateKey", "ListKeyPage", "DeleteKey"}, ReqFields: listReqFields}
+	drvCapabilityInfo.VNicHandler = idrv.HandlerCapabilityInfo{Operations: []string{"CreateVNic", "ListVNicPage", "DeleteVNic"}, ReqFields: listReqFields}
+	drvCapabilityInfo.PublicIPHandler = idrv.HandlerCapabilityInfo{Operations: []string{"CreatePublicIP", "ListPublicIPPage", "DeletePublicIP", "AssociatePublicIP", "DisassociatePublicIP"}, ReqFields: listReqFields}
+	drvCapabilityInfo.VMHandler = idrv.HandlerCapabilityInfo{Operations: vmOperations, ReqFields: append(listReqFields, idrv.ListTagFilter)}
 
 	return drvCapabilityInfo
 }
@@ -60,22 +66,33 @@ func (driver *OpenStackDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo)
 	// 3. create CloudConnection Instance of "connect/TDA_CloudConnection".
 	// 4. return CloudConnection Interface of TDA_CloudConnection.
 
-	// sample code, do not user like this^^
+	// Compute, Network Client는 하나의 인증된 ProviderClient를 공유 함.
+	// 캐시된 Connection의 Token이 만료되면 재인증 함(AllowReauth).
+	transport := idrv.NewHTTPTransport()
 
-	Client, err := getServiceClient(connectionInfo)
+	provider, err := getProviderClient(connectionInfo, transport)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	ImageClient, err := getImageClient(connectionInfo)
+	Client, err := getServiceClient(connectionInfo, provider)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	NetworkClient, err := getNetworkClient(connectionInfo)
+	ImageClient, err := getImageClient(connectionInfo, transport)
 	if err != nil {
-		panic(err)
+		return nil, err
+	}
+	NetworkClient, err := getNetworkClient(connectionInfo, provider)
+	if err != nil {
+		return nil, err
 	}
 
-	iConn := oscon.OpenStackCloudConnection{Client, ImageClient, NetworkClient}
+	iConn := oscon.OpenStackCloudConnection{
+		Client:        Client,
+		ImageClient:   ImageClient,
+		NetworkClient: NetworkClient,
+		HTTPTransport: transport,
+	}
 
 	return &iConn, nil // return type: (icon.CloudConnection, error)
 }
@@ -113,8 +130,7 @@ func (driver *OpenStackDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo)
 	} `yaml:"openstack"`
 }*/
 
-// moved by powerkim, 2019.07.29.
-func getServiceClient(connInfo idrv.ConnectionInfo) (*gophercloud.ServiceClient, error) {
+func getProviderClient(connInfo idrv.ConnectionInfo, transport *http.Transport) (*gophercloud.ProviderClient, error) {
 
 	authOpts := gophercloud.AuthOptions{
 		IdentityEndpoint: connInfo.CredentialInfo.IdentityEndpoint,
@@ -122,13 +138,26 @@ func getServiceClient(connInfo idrv.ConnectionInfo) (*gophercloud.ServiceClient,
 		Password:         connInfo.CredentialInfo.Password,
 		DomainName:       connInfo.CredentialInfo.DomainName,
 		TenantID:         connInfo.CredentialInfo.ProjectID,
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.NewClient(authOpts.IdentityEndpoint)
+	if err != nil {
+		return nil, err
 	}
+	provider.HTTPClient = http.Client{Transport: transport}
 
-	provider, err := openstack.AuthenticatedClient(authOpts)
+	err = openstack.Authenticate(provider, authOpts)
 	if err != nil {
 		return nil, err
 	}
 
+	return provider, nil
+}
+
+// moved by powerkim, 2019.07.29.
+func getServiceClient(connInfo idrv.ConnectionInfo, provider *gophercloud.ProviderClient) (*gophercloud.ServiceClient, error) {
+
 	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
 		Region: connInfo.RegionInfo.Region,
 	})
@@ -139,18 +168,27 @@ func getServiceClient(connInfo idrv.ConnectionInfo) (*gophercloud.ServiceClient,
 	return client, err
 }
 
-func getImageClient(connInfo idrv.ConnectionInfo) (*gophercloud.ServiceClient, error) {
+// Image Service는 Keystone V3 인증을 사용 함.
+func getImageClient(connInfo idrv.ConnectionInfo, transport *http.Transport) (*gophercloud.ServiceClient, error) {
 
 	client, err := openstack.NewClient(connInfo.CredentialInfo.IdentityEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	client.HTTPClient = http.Client{Transport: transport}
 
 	authOpts := gophercloud.AuthOptions{
 		//IdentityEndpoint: connInfo.CredentialInfo.IdentityEndpoint,
-		Username:   connInfo.CredentialInfo.Username,
-		Password:   connInfo.CredentialInfo.Password,
-		DomainName: connInfo.CredentialInfo.DomainName,
-		TenantID:   connInfo.CredentialInfo.ProjectID,
+		Username:    connInfo.CredentialInfo.Username,
+		Password:    connInfo.CredentialInfo.Password,
+		DomainName:  connInfo.CredentialInfo.DomainName,
+		TenantID:    connInfo.CredentialInfo.ProjectID,
+		AllowReauth: true,
 	}
 	err = openstack.AuthenticateV3(client, authOpts)
+	if err != nil {
+		return nil, err
+	}
 
 	c, err := openstack.NewImageServiceV2(client, gophercloud.EndpointOpts{
 		Region: connInfo.RegionInfo.Region,
@@ -162,20 +200,7 @@ func getImageClient(connInfo idrv.ConnectionInfo) (*gophercloud.ServiceClient, e
 	return c, err
 }
 
-func getNetworkClient(connInfo idrv.ConnectionInfo) (*gophercloud.ServiceClient, error) {
-
-	authOpts := gophercloud.AuthOptions{
-		IdentityEndpoint: connInfo.CredentialInfo.IdentityEndpoint,
-		Username:         connInfo.CredentialInfo.Username,
-		Password:         connInfo.CredentialInfo.Password,
-		DomainName:       connInfo.CredentialInfo.DomainName,
-		TenantID:         connInfo.CredentialInfo.ProjectID,
-	}
-
-	provider, err := openstack.AuthenticatedClient(authOpts)
-	if err != nil {
-		return nil, err
-	}
+func getNetworkClient(connInfo idrv.ConnectionInfo, provider *gophercloud.ProviderClient) (*gophercloud.ServiceClient, error) {
 
 	client, err := openstack.NewNetworkV2(provider, gophercloud.EndpointOpts{
 		Name:   "neutron",
@@ -189,3 +214,6 @@ func getNetworkClient(connInfo idrv.ConnectionInfo) (*gophercloud.ServiceClient,
 }
 
 var TestDriver OpenStackDriver
+
+// checked by the driver manager before using TestDriver.
+var DriverManifest = idrv.NewDriverManifest("OpenStack", &TestDriver)
@@ -0,0 +1,20 @@
+// Proof of Concepts of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This registers the OpenStack Driver into the static driver registry.
+// The plugin(.so) build uses only OpenStackDriver.go and its exported TestDriver.
+//
+// by hyokyung.kim@innogrid.co.kr, 2019.07.
+
+package openstack
+
+import (
+	registry "github.com/cloud-barista/poc-cb-spider/cloud-driver/driver-registry"
+)
+
+func init() {
+	registry.Register("openstack", &TestDriver)
+}
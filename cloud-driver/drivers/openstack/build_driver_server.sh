@@ -0,0 +1,2 @@
+rm -rf /tmp/OpenStackDriverServer
+go build -o /tmp/OpenStackDriverServer $CB_SPIDER_ROOT/cloud-driver/drivers/openstack/grpc-server
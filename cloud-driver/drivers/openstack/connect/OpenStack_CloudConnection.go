@@ -11,48 +11,55 @@
 package connect
 
 import (
-	"fmt"
 	osrs "github.com/cloud-barista/poc-cb-spider/cloud-driver/drivers/openstack/resources"
 	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+	"github.com/cloud-barista/poc-cb-spider/cloud-driver/logger"
 	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/openstack"
+	tokens3 "github.com/rackspace/gophercloud/openstack/identity/v3/tokens"
+	"net/http"
 )
 
+var cblogger = logger.New("OpenStack", "Connect")
+
 // modified by powerkim, 2019.07.29
 type OpenStackCloudConnection struct {
 	Client        *gophercloud.ServiceClient
 	ImageClient   *gophercloud.ServiceClient
 	NetworkClient *gophercloud.ServiceClient
+
+	HTTPTransport *http.Transport // 모든 Client가 공유, Close()에서 해제 함.
 }
 
 func (cloudConn *OpenStackCloudConnection) CreateVNetworkHandler() (irs.VNetworkHandler, error) {
-	fmt.Println("OpenStack Cloud Driver: called CreateVNetworkHandler()!")
+	cblogger.Debug("called CreateVNetworkHandler()")
 	vNetworkHandler := osrs.OpenStackVNetworkHandler{cloudConn.NetworkClient}
 	return &vNetworkHandler, nil
 }
 
 func (cloudConn *OpenStackCloudConnection) CreateImageHandler() (irs.ImageHandler, error) {
-	fmt.Println("OpenStack Cloud Driver: called CreateImageHandler()!")
+	cblogger.Debug("called CreateImageHandler()")
 	imageHandler := osrs.OpenStackImageHandler{cloudConn.Client, cloudConn.ImageClient}
 	return &imageHandler, nil
 }
 
 func (cloudConn OpenStackCloudConnection) CreateSecurityHandler() (irs.SecurityHandler, error) {
-	fmt.Println("OpenStack Cloud Driver: called CreateSecurityHandler()!")
+	cblogger.Debug("called CreateSecurityHandler()")
 	securityHandler := osrs.OpenStackSecurityHandler{cloudConn.Client}
 	return &securityHandler, nil
 }
 func (cloudConn *OpenStackCloudConnection) CreateKeyPairHandler() (irs.KeyPairHandler, error) {
-	fmt.Println("OpenStack Cloud Driver: called CreateKeyPairHandler()!")
+	cblogger.Debug("called CreateKeyPairHandler()")
 	keypairHandler := osrs.OpenStackKeyPairHandler{cloudConn.Client}
 	return &keypairHandler, nil
 }
 func (cloudConn *OpenStackCloudConnection) CreateVNicHandler() (irs.VNicHandler, error) {
-	fmt.Println("OpenStack Cloud Driver: called CreateVNicHandler()!")
+	cblogger.Debug("called CreateVNicHandler()")
 	vNicHandler := osrs.OpenStackVNicworkHandler{cloudConn.NetworkClient}
 	return &vNicHandler, nil
 }
 func (cloudConn OpenStackCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
-	fmt.Println("OpenStack Cloud Driver: called CreatePublicIPHandler()!")
+	cblogger.Debug("called CreatePublicIPHandler()")
 	publicIPHandler := osrs.OpenStackPublicIPHandler{cloudConn.Client}
 	return &publicIPHandler, nil
 }
@@ -70,14 +77,38 @@ func (cloudConn *OpenStackCloudConnection) CreateVMHandler() (irs.VMHandler, err
 	//              panic(err)
 	//     }
 
-	fmt.Println("OpenStack Cloud Driver: called CreateVMHandler()!")
+	cblogger.Debug("called CreateVMHandler()")
 	vmHandler := osrs.OpenStackVMHandler{cloudConn.Client}
 	return &vmHandler, nil
 }
 
-func (OpenStackCloudConnection) IsConnected() (bool, error) {
+// IsConnected validates the token of the Compute client with Keystone V3.
+// An expired token is re-authenticated with the credential(AllowReauth), so only an invalid credential fails.
+func (cloudConn *OpenStackCloudConnection) IsConnected() (bool, error) {
+	provider := cloudConn.Client.ProviderClient
+	identityClient := openstack.NewIdentityV3(provider)
+
+	valid, err := tokens3.Validate(identityClient, provider.TokenID)
+	if err != nil {
+		return false, irs.ConnectionError(err)
+	}
+	if valid {
+		return true, nil
+	}
+
+	if provider.ReauthFunc == nil {
+		return false, irs.Unauthenticated("OpenStack token has expired")
+	}
+	if err := provider.ReauthFunc(); err != nil {
+		return false, irs.ConnectionError(err)
+	}
 	return true, nil
 }
-func (OpenStackCloudConnection) Close() error {
+
+// Close releases idle HTTP connections of the Compute, Image and Network clients.
+func (cloudConn *OpenStackCloudConnection) Close() error {
+	if cloudConn.HTTPTransport != nil {
+		cloudConn.HTTPTransport.CloseIdleConnections()
+	}
 	return nil
 }
@@ -5,13 +5,14 @@ import (
 	"errors"
 	"fmt"
 	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
-	"github.com/davecgh/go-spew/spew"
 	"github.com/rackspace/gophercloud"
 	"github.com/rackspace/gophercloud/openstack/compute/v2/images"
 	imgsvc "github.com/rackspace/gophercloud/openstack/imageservice/v2/images"
 	"github.com/rackspace/gophercloud/pagination"
 	"io/ioutil"
 	"os"
+	"strings"
+	"time"
 )
 
 type OpenStackImageHandler struct {
@@ -19,33 +20,6 @@ type OpenStackImageHandler struct {
 	ImageClient *gophercloud.ServiceClient
 }
 
-// @TODO: ImageInfo 리소스 프로퍼티 정의 필요
-type ImageInfo struct {
-	ID       string
-	Created  string
-	MinDisk  int
-	MinRAM   int
-	Name     string
-	Progress int
-	Status   string
-	Updated  string
-	Metadata map[string]string
-}
-
-func (imageInfo *ImageInfo) setter(results images.Image) *ImageInfo {
-	imageInfo.ID = results.ID
-	imageInfo.Created = results.Created
-	imageInfo.MinDisk = results.MinDisk
-	imageInfo.MinRAM = results.MinRAM
-	imageInfo.Name = results.Name
-	imageInfo.Progress = results.Progress
-	imageInfo.Status = results.Status
-	imageInfo.Updated = results.Updated
-	imageInfo.Metadata = results.Metadata
-
-	return imageInfo
-}
-
 func (imageHandler *OpenStackImageHandler) CreateImage(imageReqInfo irs.ImageReqInfo) (irs.ImageInfo, error) {
 
 	// @TODO: Image 생성 요청 파라미터 정의 필요
@@ -81,7 +55,7 @@ func (imageHandler *OpenStackImageHandler) CreateImage(imageReqInfo irs.ImageReq
 	if err != nil {
 		return irs.ImageInfo{}, err
 	}
-	spew.Dump(image)
+	cblogger.Dump("image", image)
 
 	// Upload Image file
 	imageBytes, err := ioutil.ReadFile(rootPath + "/image/mcb_custom_image.iso")
@@ -92,7 +66,7 @@ func (imageHandler *OpenStackImageHandler) CreateImage(imageReqInfo irs.ImageReq
 	if result.Err != nil {
 		return irs.ImageInfo{}, err
 	}
-	fmt.Println(result)
+	cblogger.Dump("upload result", result)
 
 	imageInfo := irs.ImageInfo{
 		Id:   image.ID,
@@ -101,10 +75,16 @@ func (imageHandler *OpenStackImageHandler) CreateImage(imageReqInfo irs.ImageReq
 	return imageInfo, nil
 }
 
-func (imageHandler *OpenStackImageHandler) ListImage() ([]*irs.ImageInfo, error) {
-	var imageList []*ImageInfo
+// Nova Image API는 Name 완전 일치 필터만 지원하므로 Wildcard가 없는 NamePattern만 서버에서 필터링 함.
+func (imageHandler *OpenStackImageHandler) ListImage(imageFilterInfo irs.ImageFilterInfo) ([]*irs.ImageInfo, error) {
+	var imageList []*irs.ImageInfo
 
-	pager := images.ListDetail(imageHandler.Client, images.ListOpts{})
+	listOpts := images.ListOpts{}
+	if imageFilterInfo.NamePattern != "" && !strings.ContainsAny(imageFilterInfo.NamePattern, "*?") {
+		listOpts.Name = imageFilterInfo.NamePattern
+	}
+
+	pager := images.ListDetail(imageHandler.Client, listOpts)
 	err := pager.EachPage(func(page pagination.Page) (bool, error) {
 		// Get Image
 		list, err := images.ExtractImages(page)
@@ -113,8 +93,10 @@ func (imageHandler *OpenStackImageHandler) ListImage() ([]*irs.ImageInfo, error)
 		}
 		// Add to List
 		for _, img := range list {
-			imageInfo := new(ImageInfo).setter(img)
-			imageList = append(imageList, imageInfo)
+			imageInfo := mappingImageInfo(img)
+			if imageFilterInfo.Match(imageInfo) {
+				imageList = append(imageList, &imageInfo)
+			}
 		}
 		return true, nil
 	})
@@ -122,8 +104,54 @@ func (imageHandler *OpenStackImageHandler) ListImage() ([]*irs.ImageInfo, error)
 		return nil, err
 	}
 
-	spew.Dump(imageList)
-	return nil, nil
+	return imageList, nil
+}
+
+// PageSize가 있으면 Marker(이전 페이지 마지막 항목 ID)와 Limit으로 한 페이지만 조회 함.
+// NameFilter는 imageFilterInfo.NamePattern이 없을 때만 사용 하고, TagFilter는 Metadata로 대신 필터링 함.
+func (imageHandler *OpenStackImageHandler) ListImagePage(imageFilterInfo irs.ImageFilterInfo, listReqInfo irs.ListReqInfo) (irs.ImagePageInfo, error) {
+	if imageFilterInfo.NamePattern == "" {
+		imageFilterInfo.NamePattern = listReqInfo.NameFilter
+	}
+
+	listOpts := images.ListOpts{
+		Marker: listReqInfo.NextToken,
+		Limit:  listReqInfo.PageSize,
+	}
+	if imageFilterInfo.NamePattern != "" && !strings.ContainsAny(imageFilterInfo.NamePattern, "*?") {
+		listOpts.Name = imageFilterInfo.NamePattern
+	}
+
+	imagePageInfo := irs.ImagePageInfo{}
+	var lastID string
+	var count int
+
+	pager := images.ListDetail(imageHandler.Client, listOpts)
+	err := pager.EachPage(func(page pagination.Page) (bool, error) {
+		// Get Image
+		list, err := images.ExtractImages(page)
+		if err != nil {
+			return false, err
+		}
+		// Add to List
+		for _, img := range list {
+			lastID = img.ID
+			count++
+			imageInfo := mappingImageInfo(img)
+			if imageFilterInfo.Match(imageInfo) && listReqInfo.MatchTags(img.Metadata) {
+				imagePageInfo.ImageInfoList = append(imagePageInfo.ImageInfoList, &imageInfo)
+			}
+		}
+		return listReqInfo.PageSize == 0, nil
+	})
+	if err != nil {
+		return irs.ImagePageInfo{}, err
+	}
+
+	if listReqInfo.PageSize > 0 && count >= listReqInfo.PageSize {
+		imagePageInfo.NextToken = lastID
+	}
+	return imagePageInfo, nil
 }
 
 func (imageHandler *OpenStackImageHandler) GetImage(imageID string) (irs.ImageInfo, error) {
@@ -132,10 +160,7 @@ func (imageHandler *OpenStackImageHandler) GetImage(imageID string) (irs.ImageIn
 		return irs.ImageInfo{}, err
 	}
 
-	imageInfo := new(ImageInfo).setter(*image)
-
-	spew.Dump(imageInfo)
-	return irs.ImageInfo{}, nil
+	return mappingImageInfo(*image), nil
 }
 
 func (imageHandler *OpenStackImageHandler) DeleteImage(imageID string) (bool, error) {
@@ -145,3 +170,25 @@ func (imageHandler *OpenStackImageHandler) DeleteImage(imageID string) (bool, er
 	}
 	return true, nil
 }
+
+// OS, Architecture는 Glance 이미지 속성(os_distro, architecture)이 Metadata로 노출된 경우에만 알 수 있음.
+func mappingImageInfo(image images.Image) irs.ImageInfo {
+	imageInfo := irs.ImageInfo{
+		Id:   image.ID,
+		Name: image.Name,
+		// Nova API는 이미지 크기를 제공하지 않으므로 최소 디스크 크기를 사용 함.
+		SizeGB:       int64(image.MinDisk),
+		GuestOS:      irs.NormalizeGuestOS(image.Metadata["os_distro"]),
+		Architecture: irs.NormalizeArchitecture(image.Metadata["architecture"]),
+	}
+
+	if imageInfo.GuestOS == "" {
+		imageInfo.GuestOS = irs.NormalizeGuestOS(image.Name)
+	}
+
+	if creationTime, err := time.Parse(time.RFC3339, image.Created); err == nil {
+		imageInfo.CreationTime = creationTime
+	}
+
+	return imageInfo
+}
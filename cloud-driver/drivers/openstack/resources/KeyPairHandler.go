@@ -1,8 +1,8 @@
 package resources
 
 import (
+	"errors"
 	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
-	"github.com/davecgh/go-spew/spew"
 	"github.com/rackspace/gophercloud"
 	"github.com/rackspace/gophercloud/openstack/compute/v2/extensions/keypairs"
 	"github.com/rackspace/gophercloud/pagination"
@@ -41,7 +41,7 @@ func (keyPairHandler *OpenStackKeyPairHandler) CreateKey(keyPairReqInfo irs.KeyP
 		return irs.KeyPairInfo{}, err
 	}
 
-	spew.Dump(keyPairInfo)
+	cblogger.Dump("keyPairInfo", keyPairInfo)
 	return irs.KeyPairInfo{Name: keyPairInfo.Name}, nil
 }
 
@@ -66,10 +66,44 @@ func (keyPairHandler *OpenStackKeyPairHandler) ListKey() ([]*irs.KeyPairInfo, er
 		return nil, err
 	}
 
-	spew.Dump(keyPairList)
+	cblogger.Dump("keyPairList", keyPairList)
 	return nil, nil
 }
 
+// 페이징을 지원하지 않는 API이므로 전체 조회 후 페이징 함.
+func (keyPairHandler *OpenStackKeyPairHandler) ListKeyPage(listReqInfo irs.ListReqInfo) (irs.KeyPairPageInfo, error) {
+	if len(listReqInfo.TagFilter) > 0 {
+		return irs.KeyPairPageInfo{}, errors.New("OpenStack driver does not support TagFilter on key pairs")
+	}
+
+	var keyPairList []*irs.KeyPairInfo
+
+	pager := keypairs.List(keyPairHandler.Client)
+	err := pager.EachPage(func(page pagination.Page) (bool, error) {
+		// Get KeyPair
+		list, err := keypairs.ExtractKeyPairs(page)
+		if err != nil {
+			return false, err
+		}
+		// Add to List
+		for _, k := range list {
+			if listReqInfo.MatchName(k.Name) {
+				keyPairList = append(keyPairList, &irs.KeyPairInfo{Name: k.Name, Id: k.Name, Fingerprint: k.Fingerprint})
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return irs.KeyPairPageInfo{}, err
+	}
+
+	start, end, nextToken, err := listReqInfo.LocalPage(len(keyPairList))
+	if err != nil {
+		return irs.KeyPairPageInfo{}, err
+	}
+	return irs.KeyPairPageInfo{KeyPairInfoList: keyPairList[start:end], NextToken: nextToken}, nil
+}
+
 func (keyPairHandler *OpenStackKeyPairHandler) GetKey(keyPairID string) (irs.KeyPairInfo, error) {
 	keyPair, err := keypairs.Get(keyPairHandler.Client, keyPairID).Extract()
 	if err != nil {
@@ -78,7 +112,7 @@ func (keyPairHandler *OpenStackKeyPairHandler) GetKey(keyPairID string) (irs.Key
 
 	keyPairInfo := new(KeyPairInfo).setter(*keyPair)
 
-	spew.Dump(keyPairInfo)
+	cblogger.Dump("keyPairInfo", keyPairInfo)
 	return irs.KeyPairInfo{}, nil
 }
 
@@ -1,8 +1,8 @@
 package resources
 
 import (
+	"errors"
 	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
-	"github.com/davecgh/go-spew/spew"
 	"github.com/rackspace/gophercloud"
 	"github.com/rackspace/gophercloud/openstack/compute/v2/extensions/floatingip"
 	"github.com/rackspace/gophercloud/pagination"
@@ -49,7 +49,7 @@ func (publicIPHandler *OpenStackPublicIPHandler) CreatePublicIP(publicIPReqInfo
 		return irs.PublicIPInfo{}, err
 	}
 
-	spew.Dump(publicIPInfo)
+	cblogger.Dump("publicIPInfo", publicIPInfo)
 	return irs.PublicIPInfo{Id: publicIPInfo.ID}, nil
 }
 
@@ -74,10 +74,45 @@ func (publicIPHandler *OpenStackPublicIPHandler) ListPublicIP() ([]*irs.PublicIP
 		return nil, err
 	}
 
-	spew.Dump(publicIPList)
+	cblogger.Dump("publicIPList", publicIPList)
 	return nil, nil
 }
 
+// 페이징을 지원하지 않는 API이므로 전체 조회 후 페이징 함.
+// Floating IP는 Name이 없으므로 NameFilter는 IP 주소에 적용 함.
+func (publicIPHandler *OpenStackPublicIPHandler) ListPublicIPPage(listReqInfo irs.ListReqInfo) (irs.PublicIPPageInfo, error) {
+	if len(listReqInfo.TagFilter) > 0 {
+		return irs.PublicIPPageInfo{}, errors.New("OpenStack driver does not support TagFilter on floating IPs")
+	}
+
+	var publicIPList []*irs.PublicIPInfo
+
+	pager := floatingip.List(publicIPHandler.Client)
+	err := pager.EachPage(func(page pagination.Page) (bool, error) {
+		// Get PublicIP
+		list, err := floatingip.ExtractFloatingIPs(page)
+		if err != nil {
+			return false, err
+		}
+		// Add to List
+		for _, p := range list {
+			if listReqInfo.MatchName(p.IP) {
+				publicIPList = append(publicIPList, &irs.PublicIPInfo{Id: p.ID, PublicIp: p.IP, InstanceId: p.InstanceID})
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return irs.PublicIPPageInfo{}, err
+	}
+
+	start, end, nextToken, err := listReqInfo.LocalPage(len(publicIPList))
+	if err != nil {
+		return irs.PublicIPPageInfo{}, err
+	}
+	return irs.PublicIPPageInfo{PublicIPInfoList: publicIPList[start:end], NextToken: nextToken}, nil
+}
+
 func (publicIPHandler *OpenStackPublicIPHandler) GetPublicIP(publicIPID string) (irs.PublicIPInfo, error) {
 	floatingIP, err := floatingip.Get(publicIPHandler.Client, publicIPID).Extract()
 	if err != nil {
@@ -86,7 +121,7 @@ func (publicIPHandler *OpenStackPublicIPHandler) GetPublicIP(publicIPID string)
 
 	publicIPInfo := new(PublicIPInfo).setter(*floatingIP)
 
-	spew.Dump(publicIPInfo)
+	cblogger.Dump("publicIPInfo", publicIPInfo)
 	return irs.PublicIPInfo{Id: publicIPInfo.IP}, nil
 }
 
@@ -98,12 +133,45 @@ func (publicIPHandler *OpenStackPublicIPHandler) DeletePublicIP(publicIPID strin
 	return true, nil
 }
 
-func (publicIPHandler *OpenStackPublicIPHandler) AssociatePublicIP(serverID string, publicIPID string) (bool, error) {
+// publicIPID는 Floating IP ID, vmID는 Server ID
+func (publicIPHandler *OpenStackPublicIPHandler) AssociatePublicIP(publicIPID string, vmID string) (bool, error) {
+	floatingIP, err := floatingip.Get(publicIPHandler.Client, publicIPID).Extract()
+	if err != nil {
+		return false, err
+	}
+
+	// 다른 VM에 연결되어 있으면 먼저 해제
+	if floatingIP.InstanceID != "" && floatingIP.InstanceID != vmID {
+		if _, err := publicIPHandler.DisassociatePublicIP(publicIPID); err != nil {
+			return false, err
+		}
+	}
+
 	associateOpts := floatingip.AssociateOpts{
-		ServerID:   serverID,
-		FloatingIP: publicIPID,
+		ServerID:   vmID,
+		FloatingIP: floatingIP.IP,
+	}
+	err = floatingip.AssociateInstance(publicIPHandler.Client, associateOpts).ExtractErr()
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (publicIPHandler *OpenStackPublicIPHandler) DisassociatePublicIP(publicIPID string) (bool, error) {
+	floatingIP, err := floatingip.Get(publicIPHandler.Client, publicIPID).Extract()
+	if err != nil {
+		return false, err
+	}
+	if floatingIP.InstanceID == "" {
+		return true, nil
+	}
+
+	disassociateOpts := floatingip.AssociateOpts{
+		ServerID:   floatingIP.InstanceID,
+		FloatingIP: floatingIP.IP,
 	}
-	err := floatingip.AssociateInstance(publicIPHandler.Client, associateOpts).ExtractErr()
+	err = floatingip.DisassociateInstance(publicIPHandler.Client, disassociateOpts).ExtractErr()
 	if err != nil {
 		return false, err
 	}
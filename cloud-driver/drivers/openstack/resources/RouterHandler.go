@@ -2,7 +2,6 @@ package resources
 
 import (
 	//irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
-	"github.com/davecgh/go-spew/spew"
 	"github.com/rackspace/gophercloud"
 	"github.com/rackspace/gophercloud/openstack/networking/v2/extensions/layer3/routers"
 	"github.com/rackspace/gophercloud/pagination"
@@ -83,7 +82,7 @@ func (routerHandler *OpenStackRouterHandler) CreateRouter(routerReqInfo RouterRe
 		return RouterInfo{}, err
 	}
 
-	spew.Dump(router)
+	cblogger.Dump("router", router)
 	return RouterInfo{Id: router.ID, Name: router.Name}, nil
 }
 
@@ -107,7 +106,7 @@ func (routerHandler *OpenStackRouterHandler) ListRouter() ([]*RouterInfo, error)
 		return nil, err
 	}
 
-	spew.Dump(routerInfoList)
+	cblogger.Dump("routerInfoList", routerInfoList)
 	return nil, nil
 }
 
@@ -119,7 +118,7 @@ func (routerHandler *OpenStackRouterHandler) GetRouter(routerID string) (RouterI
 
 	routerInfo := new(RouterInfo).setter(*router)
 
-	spew.Dump(routerInfo)
+	cblogger.Dump("routerInfo", routerInfo)
 	return RouterInfo{}, nil
 }
 
@@ -142,7 +141,7 @@ func (routerHandler *OpenStackRouterHandler) AddInterface(interfaceReqInfo Inter
 		return InterfaceInfo{}, err
 	}
 
-	spew.Dump(ir)
+	cblogger.Dump("ir", ir)
 	return InterfaceInfo{}, nil
 }
 
@@ -157,6 +156,6 @@ func (routerHandler *OpenStackRouterHandler) DeleteInterface(routerID string, su
 		return false, err
 	}
 
-	spew.Dump(ir)
+	cblogger.Dump("ir", ir)
 	return true, nil
 }
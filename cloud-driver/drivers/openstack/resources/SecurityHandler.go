@@ -1,8 +1,8 @@
 package resources
 
 import (
+	"errors"
 	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
-	"github.com/davecgh/go-spew/spew"
 	"github.com/rackspace/gophercloud"
 	"github.com/rackspace/gophercloud/openstack/compute/v2/extensions/secgroups"
 	"github.com/rackspace/gophercloud/pagination"
@@ -148,7 +148,7 @@ func (securityHandler *OpenStackSecurityHandler) CreateSecurity(securityReqInfo
 		return irs.SecurityInfo{}, nil
 	}
 
-	spew.Dump(securityInfo)
+	cblogger.Dump("securityInfo", securityInfo)
 	return irs.SecurityInfo{Id: group.ID, Name: group.Name}, nil
 }
 
@@ -173,10 +173,44 @@ func (securityHandler *OpenStackSecurityHandler) ListSecurity() ([]*irs.Security
 		return nil, err
 	}
 
-	spew.Dump(securityList)
+	cblogger.Dump("securityList", securityList)
 	return nil, nil
 }
 
+// 페이징을 지원하지 않는 API이므로 전체 조회 후 페이징 함.
+func (securityHandler *OpenStackSecurityHandler) ListSecurityPage(listReqInfo irs.ListReqInfo) (irs.SecurityPageInfo, error) {
+	if len(listReqInfo.TagFilter) > 0 {
+		return irs.SecurityPageInfo{}, errors.New("OpenStack driver does not support TagFilter on security groups")
+	}
+
+	var securityList []*irs.SecurityInfo
+
+	pager := secgroups.List(securityHandler.Client)
+	err := pager.EachPage(func(page pagination.Page) (bool, error) {
+		// Get SecurityGroup
+		list, err := secgroups.ExtractSecurityGroups(page)
+		if err != nil {
+			return false, err
+		}
+		// Add to List
+		for _, s := range list {
+			if listReqInfo.MatchName(s.Name) {
+				securityList = append(securityList, &irs.SecurityInfo{Id: s.ID, Name: s.Name, Description: s.Description, OwnerID: s.TenantID})
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return irs.SecurityPageInfo{}, err
+	}
+
+	start, end, nextToken, err := listReqInfo.LocalPage(len(securityList))
+	if err != nil {
+		return irs.SecurityPageInfo{}, err
+	}
+	return irs.SecurityPageInfo{SecurityInfoList: securityList[start:end], NextToken: nextToken}, nil
+}
+
 func (securityHandler *OpenStackSecurityHandler) GetSecurity(securityID string) (irs.SecurityInfo, error) {
 	securityGroup, err := secgroups.Get(securityHandler.Client, securityID).Extract()
 	if err != nil {
@@ -185,7 +219,7 @@ func (securityHandler *OpenStackSecurityHandler) GetSecurity(securityID string)
 
 	securityInfo := new(SecurityInfo).setter(*securityGroup)
 
-	spew.Dump(securityInfo)
+	cblogger.Dump("securityInfo", securityInfo)
 	return irs.SecurityInfo{}, nil
 }
 
@@ -13,13 +13,20 @@ package resources
 import (
 	"fmt"
 	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+	"github.com/cloud-barista/poc-cb-spider/cloud-driver/logger"
 	"github.com/rackspace/gophercloud"
 	"github.com/rackspace/gophercloud/openstack/compute/v2/extensions/keypairs"
 	"github.com/rackspace/gophercloud/openstack/compute/v2/extensions/startstop"
 	"github.com/rackspace/gophercloud/openstack/compute/v2/servers"
 	"github.com/rackspace/gophercloud/pagination"
+	"regexp"
+	"strings"
 )
 
+var cblogger = logger.New("OpenStack", "Resources")
+
+const resizeTimeoutSec = 600
+
 // modified by powerkim, 2019.07.29
 type OpenStackVMHandler struct {
 	Client *gophercloud.ServiceClient
@@ -57,6 +64,11 @@ func (vmHandler *OpenStackVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInf
 	return vmInfo, nil
 }
 
+// 일괄 생성 API가 없으므로 StartVM을 병렬로 호출 함.
+func (vmHandler *OpenStackVMHandler) StartVMs(vmReqInfo irs.VMReqInfo, count int, minCount int) ([]*irs.VMBatchResult, error) {
+	return irs.StartVMsConcurrently(vmHandler, vmReqInfo, count, minCount)
+}
+
 func (vmHandler *OpenStackVMHandler) SuspendVM(vmID string) {
 	err := startstop.Stop(vmHandler.Client, vmID).Err
 	if err != nil {
@@ -90,6 +102,34 @@ func (vmHandler *OpenStackVMHandler) TerminateVM(vmID string) {
 	}
 }
 
+// Nova Resize 후 VERIFY_RESIZE 상태가 되면 Confirm 함. (중지된 VM은 중지 상태가 유지 됨)
+// 진행 상태는 GetVMStatus로 확인 가능 함. (RESIZE -> VERIFY_RESIZE -> ACTIVE 또는 SHUTOFF)
+func (vmHandler *OpenStackVMHandler) ChangeVMSpec(vmID string, specID string) (irs.VMInfo, error) {
+	resizeOpts := servers.ResizeOpts{
+		FlavorRef: specID,
+	}
+	err := servers.Resize(vmHandler.Client, vmID, resizeOpts).ExtractErr()
+	if err != nil {
+		return irs.VMInfo{}, err
+	}
+
+	err = servers.WaitForStatus(vmHandler.Client, vmID, "VERIFY_RESIZE", resizeTimeoutSec)
+	if err != nil {
+		return irs.VMInfo{}, err
+	}
+
+	err = servers.ConfirmResize(vmHandler.Client, vmID).ExtractErr()
+	if err != nil {
+		return irs.VMInfo{}, err
+	}
+
+	serverResult, err := servers.Get(vmHandler.Client, vmID).Extract()
+	if err != nil {
+		return irs.VMInfo{}, err
+	}
+	return mappingServerInfo(*serverResult), nil
+}
+
 func (vmHandler *OpenStackVMHandler) ListVMStatus() []*irs.VMStatusInfo {
 	var vmStatusList []*irs.VMStatusInfo
 
@@ -150,10 +190,54 @@ func (vmHandler *OpenStackVMHandler) ListVM() []*irs.VMInfo {
 	return vmList
 }
 
+// PageSize가 있으면 Marker(이전 페이지 마지막 항목 ID)와 Limit으로 한 페이지만 조회 함.
+// Nova의 Name 필터는 정규식이므로 Wildcard를 변환 하고, TagFilter는 Metadata로 대신 필터링 함.
+func (vmHandler *OpenStackVMHandler) ListVMPage(listReqInfo irs.ListReqInfo) (irs.VMPageInfo, error) {
+	listOpts := servers.ListOpts{
+		Marker: listReqInfo.NextToken,
+		Limit:  listReqInfo.PageSize,
+	}
+	if listReqInfo.NameFilter != "" {
+		listOpts.Name = wildcardToRegexp(listReqInfo.NameFilter)
+	}
+
+	vmPageInfo := irs.VMPageInfo{}
+	var lastID string
+	var count int
+
+	pager := servers.List(vmHandler.Client, listOpts)
+	err := pager.EachPage(func(page pagination.Page) (bool, error) {
+		// Get Servers
+		list, err := servers.ExtractServers(page)
+		if err != nil {
+			return false, err
+		}
+		// Add to List
+		for _, s := range list {
+			lastID = s.ID
+			count++
+			if !listReqInfo.MatchTags(metadataToTags(s.Metadata)) {
+				continue
+			}
+			vmInfo := mappingServerInfo(s)
+			vmPageInfo.VMInfoList = append(vmPageInfo.VMInfoList, &vmInfo)
+		}
+		return listReqInfo.PageSize == 0, nil
+	})
+	if err != nil {
+		return irs.VMPageInfo{}, err
+	}
+
+	if listReqInfo.PageSize > 0 && count >= listReqInfo.PageSize {
+		vmPageInfo.NextToken = lastID
+	}
+	return vmPageInfo, nil
+}
+
 func (vmHandler *OpenStackVMHandler) GetVM(vmID string) irs.VMInfo {
 	serverResult, err := servers.Get(vmHandler.Client, vmID).Extract()
 	if err != nil {
-		fmt.Println(err)
+		cblogger.Error(err)
 		return irs.VMInfo{}
 	}
 
@@ -193,3 +277,18 @@ func mappingServerInfo(server servers.Server) irs.VMInfo {
 
 	return vmInfo
 }
+
+func wildcardToRegexp(pattern string) string {
+	quoted := regexp.QuoteMeta(pattern)
+	quoted = strings.Replace(quoted, `\*`, ".*", -1)
+	quoted = strings.Replace(quoted, `\?`, ".", -1)
+	return "^" + quoted + "$"
+}
+
+func metadataToTags(metadata map[string]interface{}) map[string]string {
+	tags := make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		tags[k] = fmt.Sprint(v)
+	}
+	return tags
+}
@@ -1,12 +1,13 @@
 package resources
 
 import (
+	"errors"
 	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
-	"github.com/davecgh/go-spew/spew"
 	"github.com/rackspace/gophercloud"
 	"github.com/rackspace/gophercloud/openstack/networking/v2/networks"
 	"github.com/rackspace/gophercloud/openstack/networking/v2/subnets"
 	"github.com/rackspace/gophercloud/pagination"
+	"strings"
 )
 
 type OpenStackVNetworkHandler struct {
@@ -78,7 +79,7 @@ func (vNetworkHandler *OpenStackVNetworkHandler) CreateVNetwork(vNetworkReqInfo
 	if err != nil {
 		return irs.VNetworkInfo{}, err
 	}
-	spew.Dump(network)
+	cblogger.Dump("network", network)
 
 	// Set IPPool
 	var AllocationPool []subnets.AllocationPool
@@ -99,15 +100,36 @@ func (vNetworkHandler *OpenStackVNetworkHandler) CreateVNetwork(vNetworkReqInfo
 		AllocationPools: AllocationPool,
 		DNSNameservers:  reqInfo.DNSNameServer,
 	}
+	subnetCreateOptsList := []subnets.CreateOpts{subnetCreateOpts}
+
+	// 요청된 서브넷이 있으면 기본 서브넷 대신 생성 함. (IP Pool은 CIDR 전체)
+	if len(vNetworkReqInfo.SubnetReqInfoList) > 0 {
+		subnetCreateOptsList = nil
+		for _, subnetReqInfo := range vNetworkReqInfo.SubnetReqInfoList {
+			subnetCreateOptsList = append(subnetCreateOptsList, subnets.CreateOpts{
+				NetworkID:      network.ID,
+				CIDR:           subnetReqInfo.CIDR,
+				IPVersion:      reqInfo.IPVersion,
+				Name:           subnetReqInfo.Name,
+				DNSNameservers: reqInfo.DNSNameServer,
+			})
+		}
+	}
 
-	subnet, err := subnets.Create(vNetworkHandler.Client, subnetCreateOpts).Extract()
-	if err != nil {
-		return irs.VNetworkInfo{}, err
+	var subnetId string
+	for _, createOpts := range subnetCreateOptsList {
+		subnet, err := subnets.Create(vNetworkHandler.Client, createOpts).Extract()
+		if err != nil {
+			return irs.VNetworkInfo{}, err
+		}
+		cblogger.Dump("subnet", subnet)
+		if subnetId == "" {
+			subnetId = subnet.ID
+		}
 	}
-	spew.Dump(subnet)
 
 	// @TODO: 생성된 vNetwork 정보 리턴
-	return irs.VNetworkInfo{Id: network.ID, SubnetId: subnet.ID}, nil
+	return irs.VNetworkInfo{Name: network.Name, Id: network.ID, SubnetId: subnetId}, nil
 }
 
 func (vNetworkHandler *OpenStackVNetworkHandler) ListVNetwork() ([]*irs.VNetworkInfo, error) {
@@ -131,10 +153,61 @@ func (vNetworkHandler *OpenStackVNetworkHandler) ListVNetwork() ([]*irs.VNetwork
 		return nil, err
 	}
 
-	spew.Dump(vNetworkIList)
+	cblogger.Dump("vNetworkIList", vNetworkIList)
 	return nil, nil
 }
 
+// PageSize가 있으면 Marker(이전 페이지 마지막 항목 ID)와 Limit으로 한 페이지만 조회 함.
+// Neutron의 Name 필터는 완전 일치만 지원하므로 Wildcard가 있으면 조회 후 필터링 함.
+func (vNetworkHandler *OpenStackVNetworkHandler) ListVNetworkPage(listReqInfo irs.ListReqInfo) (irs.VNetworkPageInfo, error) {
+	if len(listReqInfo.TagFilter) > 0 {
+		return irs.VNetworkPageInfo{}, errors.New("OpenStack driver does not support TagFilter on networks")
+	}
+
+	listOpts := networks.ListOpts{
+		Marker: listReqInfo.NextToken,
+		Limit:  listReqInfo.PageSize,
+	}
+	if listReqInfo.NameFilter != "" && !strings.ContainsAny(listReqInfo.NameFilter, "*?") {
+		listOpts.Name = listReqInfo.NameFilter
+	}
+
+	vNetworkPageInfo := irs.VNetworkPageInfo{}
+	var lastID string
+	var count int
+
+	pager := networks.List(vNetworkHandler.Client, listOpts)
+	err := pager.EachPage(func(page pagination.Page) (bool, error) {
+		// Get vNetwork
+		list, err := networks.ExtractNetworks(page)
+		if err != nil {
+			return false, err
+		}
+		// Add to List
+		for _, n := range list {
+			lastID = n.ID
+			count++
+			if !listReqInfo.MatchName(n.Name) {
+				continue
+			}
+			vNetworkInfo := irs.VNetworkInfo{Id: n.ID, Name: n.Name}
+			if len(n.Subnets) > 0 {
+				vNetworkInfo.SubnetId = n.Subnets[0]
+			}
+			vNetworkPageInfo.VNetworkInfoList = append(vNetworkPageInfo.VNetworkInfoList, &vNetworkInfo)
+		}
+		return listReqInfo.PageSize == 0, nil
+	})
+	if err != nil {
+		return irs.VNetworkPageInfo{}, err
+	}
+
+	if listReqInfo.PageSize > 0 && count >= listReqInfo.PageSize {
+		vNetworkPageInfo.NextToken = lastID
+	}
+	return vNetworkPageInfo, nil
+}
+
 func (vNetworkHandler *OpenStackVNetworkHandler) GetVNetwork(vNetworkID string) (irs.VNetworkInfo, error) {
 	network, err := networks.Get(vNetworkHandler.Client, vNetworkID).Extract()
 	if err != nil {
@@ -143,7 +216,7 @@ func (vNetworkHandler *OpenStackVNetworkHandler) GetVNetwork(vNetworkID string)
 
 	if network != nil {
 		vNetworkInfo := new(VNetworkInfo).setter(*network)
-		spew.Dump(vNetworkInfo)
+		cblogger.Dump("vNetworkInfo", vNetworkInfo)
 	}
 
 	return irs.VNetworkInfo{}, nil
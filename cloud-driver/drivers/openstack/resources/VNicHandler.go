@@ -1,12 +1,13 @@
 package resources
 
 import (
+	"errors"
 	"github.com/Azure/go-autorest/autorest/to"
 	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
-	"github.com/davecgh/go-spew/spew"
 	"github.com/rackspace/gophercloud"
 	"github.com/rackspace/gophercloud/openstack/networking/v2/ports"
 	"github.com/rackspace/gophercloud/pagination"
+	"strings"
 )
 
 type OpenStackVNicworkHandler struct {
@@ -101,7 +102,7 @@ func (vNicHandler *OpenStackVNicworkHandler) CreateVNic(vNicReqInfo irs.VNicReqI
 		return irs.VNicInfo{}, err
 	}
 
-	spew.Dump(port)
+	cblogger.Dump("port", port)
 	return irs.VNicInfo{Id: port.ID, Name: port.Name}, nil
 }
 
@@ -126,10 +127,57 @@ func (vNicHandler *OpenStackVNicworkHandler) ListVNic() ([]*irs.VNicInfo, error)
 		return nil, err
 	}
 
-	spew.Dump(portList)
+	cblogger.Dump("portList", portList)
 	return nil, nil
 }
 
+// PageSize가 있으면 Marker(이전 페이지 마지막 항목 ID)와 Limit으로 한 페이지만 조회 함.
+// Neutron의 Name 필터는 완전 일치만 지원하므로 Wildcard가 있으면 조회 후 필터링 함.
+func (vNicHandler *OpenStackVNicworkHandler) ListVNicPage(listReqInfo irs.ListReqInfo) (irs.VNicPageInfo, error) {
+	if len(listReqInfo.TagFilter) > 0 {
+		return irs.VNicPageInfo{}, errors.New("OpenStack driver does not support TagFilter on ports")
+	}
+
+	listOpts := ports.ListOpts{
+		Marker: listReqInfo.NextToken,
+		Limit:  listReqInfo.PageSize,
+	}
+	if listReqInfo.NameFilter != "" && !strings.ContainsAny(listReqInfo.NameFilter, "*?") {
+		listOpts.Name = listReqInfo.NameFilter
+	}
+
+	vNicPageInfo := irs.VNicPageInfo{}
+	var lastID string
+	var count int
+
+	pager := ports.List(vNicHandler.Client, listOpts)
+	err := pager.EachPage(func(page pagination.Page) (bool, error) {
+		// Get Port
+		list, err := ports.ExtractPorts(page)
+		if err != nil {
+			return false, err
+		}
+		// Add to List
+		for _, p := range list {
+			lastID = p.ID
+			count++
+			if !listReqInfo.MatchName(p.Name) {
+				continue
+			}
+			vNicPageInfo.VNicInfoList = append(vNicPageInfo.VNicInfoList, &irs.VNicInfo{Id: p.ID, Name: p.Name})
+		}
+		return listReqInfo.PageSize == 0, nil
+	})
+	if err != nil {
+		return irs.VNicPageInfo{}, err
+	}
+
+	if listReqInfo.PageSize > 0 && count >= listReqInfo.PageSize {
+		vNicPageInfo.NextToken = lastID
+	}
+	return vNicPageInfo, nil
+}
+
 func (vNicHandler *OpenStackVNicworkHandler) GetVNic(vNicID string) (irs.VNicInfo, error) {
 	port, err := ports.Get(vNicHandler.Client, vNicID).Extract()
 	if err != nil {
@@ -138,7 +186,7 @@ func (vNicHandler *OpenStackVNicworkHandler) GetVNic(vNicID string) (irs.VNicInf
 
 	portInfo := new(PortInfo).setter(*port)
 
-	spew.Dump(portInfo)
+	cblogger.Dump("portInfo", portInfo)
 	return irs.VNicInfo{}, nil
 }
 
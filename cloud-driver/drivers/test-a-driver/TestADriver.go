@@ -25,8 +25,8 @@ func (TADCloudDriver) GetDriverVersion() string {
 }
 
 func (TADCloudDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
+	// do not support any handler.
 	var drvCapabilityInfo idrv.DriverCapabilityInfo
-	drvCapabilityInfo.VNetworkHandler = false
 
 	return drvCapabilityInfo
 }
@@ -44,3 +44,6 @@ func (TADCloudDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (icon.Clo
 }
 
 var TestDriver TADCloudDriver
+
+// checked by the driver manager before using TestDriver.
+var DriverManifest = idrv.NewDriverManifest("TestA", TestDriver)
@@ -12,14 +12,16 @@
 package connect
 
 import (
-	"fmt"
 	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+	"github.com/cloud-barista/poc-cb-spider/cloud-driver/logger"
 )
 
+var cblogger = logger.New("TestA", "Connect")
+
 type TADCloudConnection struct{}
 
 func (TADCloudConnection) CreateVNetworkHandler() (irs.VNetworkHandler, error) {
-        fmt.Println("TEST A Cloud Driver: called CreateVNetworkHandler()!")
+        cblogger.Debug("called CreateVNetworkHandler()")
         return nil, nil
 }
 
@@ -25,8 +25,8 @@ func (TBDCloudDriver) GetDriverVersion() string {
 }
 
 func (TBDCloudDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
+	// do not support any handler.
 	var drvCapabilityInfo idrv.DriverCapabilityInfo
-	drvCapabilityInfo.VNetworkHandler = false
 
 	return drvCapabilityInfo
 }
@@ -44,3 +44,6 @@ func (TBDCloudDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (icon.Clo
 }
 
 var TestDriver TBDCloudDriver
+
+// checked by the driver manager before using TestDriver.
+var DriverManifest = idrv.NewDriverManifest("TestB", TestDriver)
@@ -0,0 +1,294 @@
+// Cloud Driver gRPC transport of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the host side of the gRPC transport.
+// RemoteDriver satisfies idrv.CloudDriver, so callers do not know the driver runs in another process.
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package grpcdriver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"time"
+
+	cblog "github.com/cloud-barista/cb-log"
+	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
+	icon "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/connect"
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+	"github.com/sirupsen/logrus"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var cblogger *logrus.Logger
+
+func init() {
+	cblogger = cblog.GetLogger("gRPC Driver")
+}
+
+// Max wait time for the driver process to serve.
+const startTimeout = 10 * time.Second
+
+// CallTimeout is the max time of a call to the driver process, so a hung driver process does not block the caller forever.
+// Handler calls are also cancelled with the context of the caller, see idrv.ContextHandler. 0: no timeout
+var CallTimeout = 10 * time.Minute
+
+// callContext returns the context of a call to the driver process, ctx is the context of the caller or nil.
+func callContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if CallTimeout > 0 {
+		return context.WithTimeout(ctx, CallTimeout)
+	}
+	return context.WithCancel(ctx)
+}
+
+type RemoteDriver struct {
+	DriverPath string
+	Info       DriverInfoReply
+
+	cmd        *exec.Cmd
+	socketPath string
+	conn       *grpc.ClientConn
+
+	mutex   sync.Mutex
+	exitErr error // not nil after the driver process exited
+}
+
+// StartDriver starts the driver server binary as a subprocess and connects to it.
+func StartDriver(driverPath string) (*RemoteDriver, error) {
+	socketPath := filepath.Join(os.TempDir(), fmt.Sprintf("cb-spider-%s-%d.sock", filepath.Base(driverPath), time.Now().UnixNano()))
+
+	cmd := exec.Command(driverPath)
+	cmd.Env = append(os.Environ(), SocketEnv+"="+socketPath)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("%s: %v", driverPath, err)
+	}
+
+	driver := &RemoteDriver{DriverPath: driverPath, cmd: cmd, socketPath: socketPath}
+	go driver.wait()
+
+	conn, err := grpc.Dial(socketPath,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, "unix", addr)
+		}),
+		grpc.WithDefaultCallOptions(grpc.CallContentSubtype(jsonCodec{}.Name())),
+	)
+	if err != nil {
+		driver.Close()
+		return nil, err
+	}
+	driver.conn = conn
+
+	if err := driver.waitReady(); err != nil {
+		driver.Close()
+		return nil, err
+	}
+
+	// Go toolchain, module versions는 프로세스가 분리되므로 확인하지 않음.
+	if err := driver.Info.Manifest.CheckInterfaceVersion(); err != nil {
+		driver.Close()
+		return nil, fmt.Errorf("%s: %v", driverPath, err)
+	}
+
+	return driver, nil
+}
+
+func (driver *RemoteDriver) wait() {
+	err := driver.cmd.Wait()
+	if err == nil {
+		err = errors.New("exited")
+	}
+
+	driver.mutex.Lock()
+	driver.exitErr = fmt.Errorf("driver process %s: %v", driver.DriverPath, err)
+	driver.mutex.Unlock()
+	cblogger.Error(driver.exitErr)
+}
+
+// Exited returns the exit error if the driver process is not running.
+func (driver *RemoteDriver) Exited() error {
+	driver.mutex.Lock()
+	defer driver.mutex.Unlock()
+	return driver.exitErr
+}
+
+func (driver *RemoteDriver) waitReady() error {
+	deadline := time.Now().Add(startTimeout)
+	for {
+		if err := driver.Exited(); err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		err := driver.conn.Invoke(ctx, fullMethod("GetDriverInfo"), &Empty{}, &driver.Info)
+		cancel()
+		if err == nil {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("%s does not serve in %v: %v", driver.DriverPath, startTimeout, err)
+		}
+		time.Sleep(200 * time.Millisecond)
+	}
+}
+
+// Close stops the driver process.
+func (driver *RemoteDriver) Close() error {
+	if driver.conn != nil {
+		driver.conn.Close()
+	}
+	if driver.Exited() == nil {
+		driver.cmd.Process.Signal(os.Interrupt)
+	}
+	os.Remove(driver.socketPath)
+	return nil
+}
+
+func (driver *RemoteDriver) GetDriverVersion() string {
+	return driver.Info.DriverVersion
+}
+
+func (driver *RemoteDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
+	return driver.Info.Capability
+}
+
+func (driver *RemoteDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (icon.CloudConnection, error) {
+	if err := driver.Exited(); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := callContext(context.Background())
+	defer cancel()
+	reply := ConnectReply{}
+	err := driver.conn.Invoke(ctx, fullMethod("ConnectCloud"), &ConnectRequest{ConnectionInfo: connectionInfo}, &reply)
+	if err != nil {
+		return nil, driver.transportError(ctx, err)
+	}
+	return &remoteConnection{driver: driver, connectionID: reply.ConnectionID}, nil
+}
+
+// 프로세스가 종료되어 실패한 경우 종료 원인을 반환 함.
+func (driver *RemoteDriver) transportError(ctx context.Context, err error) error {
+	if exitErr := driver.Exited(); exitErr != nil {
+		return exitErr
+	}
+	switch ctx.Err() {
+	case context.DeadlineExceeded:
+		return irs.NewCloudError(irs.TimeoutError, "driver process %s: %v", driver.DriverPath, ctx.Err())
+	case context.Canceled:
+		return ctx.Err()
+	}
+	return err
+}
+
+type remoteConnection struct {
+	driver       *RemoteDriver
+	connectionID string
+	ctx          context.Context // of the caller, nil: none
+}
+
+// withContext returns a copy of conn whose calls are cancelled with ctx.
+func (conn *remoteConnection) withContext(ctx context.Context) *remoteConnection {
+	copied := *conn
+	copied.ctx = ctx
+	return &copied
+}
+
+// invoke calls handler.method of the driver process and decodes the results into results pointers.
+func (conn *remoteConnection) invoke(handler string, method string, args []interface{}, results ...interface{}) error {
+	if err := conn.driver.Exited(); err != nil {
+		return err
+	}
+
+	req := InvokeRequest{ConnectionID: conn.connectionID, Handler: handler, Method: method}
+	for _, arg := range args {
+		data, err := json.Marshal(arg)
+		if err != nil {
+			return err
+		}
+		req.Args = append(req.Args, data)
+	}
+
+	ctx, cancel := callContext(conn.ctx)
+	defer cancel()
+	reply := InvokeReply{}
+	if err := conn.driver.conn.Invoke(ctx, fullMethod("Invoke"), &req, &reply); err != nil {
+		return conn.driver.transportError(ctx, err)
+	}
+
+	for i := 0; i < len(results) && i < len(reply.Results); i++ {
+		if err := json.Unmarshal(reply.Results[i], results[i]); err != nil {
+			return err
+		}
+	}
+	if reply.Error != "" {
+		return &irs.CloudError{Code: irs.ErrorCode(reply.ErrorCode), Message: reply.Error}
+	}
+	return nil
+}
+
+func (conn *remoteConnection) CreateImageHandler() (irs.ImageHandler, error) {
+	return &remoteImageHandler{conn}, nil
+}
+
+func (conn *remoteConnection) CreateVNetworkHandler() (irs.VNetworkHandler, error) {
+	return &remoteVNetworkHandler{conn}, nil
+}
+
+func (conn *remoteConnection) CreateSecurityHandler() (irs.SecurityHandler, error) {
+	return &remoteSecurityHandler{conn}, nil
+}
+
+func (conn *remoteConnection) CreateKeyPairHandler() (irs.KeyPairHandler, error) {
+	return &remoteKeyPairHandler{conn}, nil
+}
+
+func (conn *remoteConnection) CreateVNicHandler() (irs.VNicHandler, error) {
+	return &remoteVNicHandler{conn}, nil
+}
+
+func (conn *remoteConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
+	return &remotePublicIPHandler{conn}, nil
+}
+
+func (conn *remoteConnection) CreateVMHandler() (irs.VMHandler, error) {
+	return &remoteVMHandler{conn}, nil
+}
+
+func (conn *remoteConnection) IsConnected() (bool, error) {
+	var connected bool
+	err := conn.invoke(connectionHandler, "IsConnected", nil, &connected)
+	return connected, err
+}
+
+func (conn *remoteConnection) Close() error {
+	if err := conn.driver.Exited(); err != nil {
+		return err
+	}
+	ctx, cancel := callContext(context.Background())
+	defer cancel()
+	err := conn.driver.conn.Invoke(ctx, fullMethod("CloseConnection"), &ConnectReply{ConnectionID: conn.connectionID}, &Empty{})
+	if err != nil {
+		return conn.driver.transportError(ctx, err)
+	}
+	return nil
+}
@@ -0,0 +1,357 @@
+// Cloud Driver gRPC transport of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the host side handlers of the gRPC transport.
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package grpcdriver
+
+import (
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+
+	"context"
+)
+
+type remoteImageHandler struct {
+	conn *remoteConnection
+}
+
+const imageHandler = "ImageHandler"
+
+func (handler *remoteImageHandler) CreateImage(imageReqInfo irs.ImageReqInfo) (irs.ImageInfo, error) {
+	var imageInfo irs.ImageInfo
+	err := handler.conn.invoke(imageHandler, "CreateImage", []interface{}{imageReqInfo}, &imageInfo)
+	return imageInfo, err
+}
+
+func (handler *remoteImageHandler) ListImage(imageFilterInfo irs.ImageFilterInfo) ([]*irs.ImageInfo, error) {
+	var imageList []*irs.ImageInfo
+	err := handler.conn.invoke(imageHandler, "ListImage", []interface{}{imageFilterInfo}, &imageList)
+	return imageList, err
+}
+
+func (handler *remoteImageHandler) ListImagePage(imageFilterInfo irs.ImageFilterInfo, listReqInfo irs.ListReqInfo) (irs.ImagePageInfo, error) {
+	var imagePageInfo irs.ImagePageInfo
+	err := handler.conn.invoke(imageHandler, "ListImagePage", []interface{}{imageFilterInfo, listReqInfo}, &imagePageInfo)
+	return imagePageInfo, err
+}
+
+func (handler *remoteImageHandler) GetImage(imageID string) (irs.ImageInfo, error) {
+	var imageInfo irs.ImageInfo
+	err := handler.conn.invoke(imageHandler, "GetImage", []interface{}{imageID}, &imageInfo)
+	return imageInfo, err
+}
+
+func (handler *remoteImageHandler) DeleteImage(imageID string) (bool, error) {
+	var result bool
+	err := handler.conn.invoke(imageHandler, "DeleteImage", []interface{}{imageID}, &result)
+	return result, err
+}
+
+type remoteVNetworkHandler struct {
+	conn *remoteConnection
+}
+
+const vNetworkHandler = "VNetworkHandler"
+
+func (handler *remoteVNetworkHandler) CreateVNetwork(vNetworkReqInfo irs.VNetworkReqInfo) (irs.VNetworkInfo, error) {
+	var vNetworkInfo irs.VNetworkInfo
+	// 1.0 드라이버는 SubnetReqInfoList를 무시하고 기본 서브넷을 생성 함.
+	if manifest := handler.conn.driver.Info.Manifest; len(vNetworkReqInfo.SubnetReqInfoList) > 0 && !manifest.AtLeast("1.1") {
+		return vNetworkInfo, irs.NotSupported("driver %s is built with interface version %s, SubnetReqInfoList needs 1.1",
+			manifest.DriverName, manifest.InterfaceVersion)
+	}
+	err := handler.conn.invoke(vNetworkHandler, "CreateVNetwork", []interface{}{vNetworkReqInfo}, &vNetworkInfo)
+	return vNetworkInfo, err
+}
+
+func (handler *remoteVNetworkHandler) ListVNetwork() ([]*irs.VNetworkInfo, error) {
+	var vNetworkList []*irs.VNetworkInfo
+	err := handler.conn.invoke(vNetworkHandler, "ListVNetwork", nil, &vNetworkList)
+	return vNetworkList, err
+}
+
+func (handler *remoteVNetworkHandler) ListVNetworkPage(listReqInfo irs.ListReqInfo) (irs.VNetworkPageInfo, error) {
+	var vNetworkPageInfo irs.VNetworkPageInfo
+	err := handler.conn.invoke(vNetworkHandler, "ListVNetworkPage", []interface{}{listReqInfo}, &vNetworkPageInfo)
+	return vNetworkPageInfo, err
+}
+
+func (handler *remoteVNetworkHandler) GetVNetwork(vNetworkID string) (irs.VNetworkInfo, error) {
+	var vNetworkInfo irs.VNetworkInfo
+	err := handler.conn.invoke(vNetworkHandler, "GetVNetwork", []interface{}{vNetworkID}, &vNetworkInfo)
+	return vNetworkInfo, err
+}
+
+func (handler *remoteVNetworkHandler) DeleteVNetwork(vNetworkID string) (bool, error) {
+	var result bool
+	err := handler.conn.invoke(vNetworkHandler, "DeleteVNetwork", []interface{}{vNetworkID}, &result)
+	return result, err
+}
+
+type remoteSecurityHandler struct {
+	conn *remoteConnection
+}
+
+const securityHandler = "SecurityHandler"
+
+func (handler *remoteSecurityHandler) CreateSecurity(securityReqInfo irs.SecurityReqInfo) (irs.SecurityInfo, error) {
+	var securityInfo irs.SecurityInfo
+	err := handler.conn.invoke(securityHandler, "CreateSecurity", []interface{}{securityReqInfo}, &securityInfo)
+	return securityInfo, err
+}
+
+func (handler *remoteSecurityHandler) ListSecurity() ([]*irs.SecurityInfo, error) {
+	var securityList []*irs.SecurityInfo
+	err := handler.conn.invoke(securityHandler, "ListSecurity", nil, &securityList)
+	return securityList, err
+}
+
+func (handler *remoteSecurityHandler) ListSecurityPage(listReqInfo irs.ListReqInfo) (irs.SecurityPageInfo, error) {
+	var securityPageInfo irs.SecurityPageInfo
+	err := handler.conn.invoke(securityHandler, "ListSecurityPage", []interface{}{listReqInfo}, &securityPageInfo)
+	return securityPageInfo, err
+}
+
+func (handler *remoteSecurityHandler) GetSecurity(securityID string) (irs.SecurityInfo, error) {
+	var securityInfo irs.SecurityInfo
+	err := handler.conn.invoke(securityHandler, "GetSecurity", []interface{}{securityID}, &securityInfo)
+	return securityInfo, err
+}
+
+func (handler *remoteSecurityHandler) DeleteSecurity(securityID string) (bool, error) {
+	var result bool
+	err := handler.conn.invoke(securityHandler, "DeleteSecurity", []interface{}{securityID}, &result)
+	return result, err
+}
+
+type remoteKeyPairHandler struct {
+	conn *remoteConnection
+}
+
+const keyPairHandler = "KeyPairHandler"
+
+func (handler *remoteKeyPairHandler) CreateKey(keyPairReqInfo irs.KeyPairReqInfo) (irs.KeyPairInfo, error) {
+	var keyPairInfo irs.KeyPairInfo
+	err := handler.conn.invoke(keyPairHandler, "CreateKey", []interface{}{keyPairReqInfo}, &keyPairInfo)
+	return keyPairInfo, err
+}
+
+func (handler *remoteKeyPairHandler) ListKey() ([]*irs.KeyPairInfo, error) {
+	var keyPairList []*irs.KeyPairInfo
+	err := handler.conn.invoke(keyPairHandler, "ListKey", nil, &keyPairList)
+	return keyPairList, err
+}
+
+func (handler *remoteKeyPairHandler) ListKeyPage(listReqInfo irs.ListReqInfo) (irs.KeyPairPageInfo, error) {
+	var keyPairPageInfo irs.KeyPairPageInfo
+	err := handler.conn.invoke(keyPairHandler, "ListKeyPage", []interface{}{listReqInfo}, &keyPairPageInfo)
+	return keyPairPageInfo, err
+}
+
+func (handler *remoteKeyPairHandler) GetKey(keyPairID string) (irs.KeyPairInfo, error) {
+	var keyPairInfo irs.KeyPairInfo
+	err := handler.conn.invoke(keyPairHandler, "GetKey", []interface{}{keyPairID}, &keyPairInfo)
+	return keyPairInfo, err
+}
+
+func (handler *remoteKeyPairHandler) DeleteKey(keyPairID string) (bool, error) {
+	var result bool
+	err := handler.conn.invoke(keyPairHandler, "DeleteKey", []interface{}{keyPairID}, &result)
+	return result, err
+}
+
+type remoteVNicHandler struct {
+	conn *remoteConnection
+}
+
+const vNicHandler = "VNicHandler"
+
+func (handler *remoteVNicHandler) CreateVNic(vNicReqInfo irs.VNicReqInfo) (irs.VNicInfo, error) {
+	var vNicInfo irs.VNicInfo
+	err := handler.conn.invoke(vNicHandler, "CreateVNic", []interface{}{vNicReqInfo}, &vNicInfo)
+	return vNicInfo, err
+}
+
+func (handler *remoteVNicHandler) ListVNic() ([]*irs.VNicInfo, error) {
+	var vNicList []*irs.VNicInfo
+	err := handler.conn.invoke(vNicHandler, "ListVNic", nil, &vNicList)
+	return vNicList, err
+}
+
+func (handler *remoteVNicHandler) ListVNicPage(listReqInfo irs.ListReqInfo) (irs.VNicPageInfo, error) {
+	var vNicPageInfo irs.VNicPageInfo
+	err := handler.conn.invoke(vNicHandler, "ListVNicPage", []interface{}{listReqInfo}, &vNicPageInfo)
+	return vNicPageInfo, err
+}
+
+func (handler *remoteVNicHandler) GetVNic(vNicID string) (irs.VNicInfo, error) {
+	var vNicInfo irs.VNicInfo
+	err := handler.conn.invoke(vNicHandler, "GetVNic", []interface{}{vNicID}, &vNicInfo)
+	return vNicInfo, err
+}
+
+func (handler *remoteVNicHandler) DeleteVNic(vNicID string) (bool, error) {
+	var result bool
+	err := handler.conn.invoke(vNicHandler, "DeleteVNic", []interface{}{vNicID}, &result)
+	return result, err
+}
+
+type remotePublicIPHandler struct {
+	conn *remoteConnection
+}
+
+const publicIPHandler = "PublicIPHandler"
+
+func (handler *remotePublicIPHandler) CreatePublicIP(publicIPReqInfo irs.PublicIPReqInfo) (irs.PublicIPInfo, error) {
+	var publicIPInfo irs.PublicIPInfo
+	err := handler.conn.invoke(publicIPHandler, "CreatePublicIP", []interface{}{publicIPReqInfo}, &publicIPInfo)
+	return publicIPInfo, err
+}
+
+func (handler *remotePublicIPHandler) ListPublicIP() ([]*irs.PublicIPInfo, error) {
+	var publicIPList []*irs.PublicIPInfo
+	err := handler.conn.invoke(publicIPHandler, "ListPublicIP", nil, &publicIPList)
+	return publicIPList, err
+}
+
+func (handler *remotePublicIPHandler) ListPublicIPPage(listReqInfo irs.ListReqInfo) (irs.PublicIPPageInfo, error) {
+	var publicIPPageInfo irs.PublicIPPageInfo
+	err := handler.conn.invoke(publicIPHandler, "ListPublicIPPage", []interface{}{listReqInfo}, &publicIPPageInfo)
+	return publicIPPageInfo, err
+}
+
+func (handler *remotePublicIPHandler) GetPublicIP(publicIPID string) (irs.PublicIPInfo, error) {
+	var publicIPInfo irs.PublicIPInfo
+	err := handler.conn.invoke(publicIPHandler, "GetPublicIP", []interface{}{publicIPID}, &publicIPInfo)
+	return publicIPInfo, err
+}
+
+func (handler *remotePublicIPHandler) DeletePublicIP(publicIPID string) (bool, error) {
+	var result bool
+	err := handler.conn.invoke(publicIPHandler, "DeletePublicIP", []interface{}{publicIPID}, &result)
+	return result, err
+}
+
+func (handler *remotePublicIPHandler) AssociatePublicIP(publicIPID string, vmID string) (bool, error) {
+	var result bool
+	err := handler.conn.invoke(publicIPHandler, "AssociatePublicIP", []interface{}{publicIPID, vmID}, &result)
+	return result, err
+}
+
+func (handler *remotePublicIPHandler) DisassociatePublicIP(publicIPID string) (bool, error) {
+	var result bool
+	err := handler.conn.invoke(publicIPHandler, "DisassociatePublicIP", []interface{}{publicIPID}, &result)
+	return result, err
+}
+
+// VMHandler의 error를 반환하지 않는 메소드는 실패 시 로그를 남기고 zero value를 반환 함.
+type remoteVMHandler struct {
+	conn *remoteConnection
+}
+
+const vmHandler = "VMHandler"
+
+func (handler *remoteVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, error) {
+	var vmInfo irs.VMInfo
+	err := handler.conn.invoke(vmHandler, "StartVM", []interface{}{vmReqInfo}, &vmInfo)
+	return vmInfo, err
+}
+
+func (handler *remoteVMHandler) StartVMs(vmReqInfo irs.VMReqInfo, count int, minCount int) ([]*irs.VMBatchResult, error) {
+	var results []*irs.VMBatchResult
+	err := handler.conn.invoke(vmHandler, "StartVMs", []interface{}{vmReqInfo, count, minCount}, &results)
+	return results, err
+}
+
+func (handler *remoteVMHandler) SuspendVM(vmID string) {
+	handler.logError(handler.conn.invoke(vmHandler, "SuspendVM", []interface{}{vmID}))
+}
+
+func (handler *remoteVMHandler) ResumeVM(vmID string) {
+	handler.logError(handler.conn.invoke(vmHandler, "ResumeVM", []interface{}{vmID}))
+}
+
+func (handler *remoteVMHandler) RebootVM(vmID string) {
+	handler.logError(handler.conn.invoke(vmHandler, "RebootVM", []interface{}{vmID}))
+}
+
+func (handler *remoteVMHandler) TerminateVM(vmID string) {
+	handler.logError(handler.conn.invoke(vmHandler, "TerminateVM", []interface{}{vmID}))
+}
+
+func (handler *remoteVMHandler) ChangeVMSpec(vmID string, specID string) (irs.VMInfo, error) {
+	var vmInfo irs.VMInfo
+	err := handler.conn.invoke(vmHandler, "ChangeVMSpec", []interface{}{vmID, specID}, &vmInfo)
+	return vmInfo, err
+}
+
+func (handler *remoteVMHandler) ListVMStatus() []*irs.VMStatusInfo {
+	var vmStatusList []*irs.VMStatusInfo
+	handler.logError(handler.conn.invoke(vmHandler, "ListVMStatus", nil, &vmStatusList))
+	return vmStatusList
+}
+
+func (handler *remoteVMHandler) GetVMStatus(vmID string) irs.VMStatus {
+	var vmStatus irs.VMStatus
+	handler.logError(handler.conn.invoke(vmHandler, "GetVMStatus", []interface{}{vmID}, &vmStatus))
+	return vmStatus
+}
+
+func (handler *remoteVMHandler) ListVM() []*irs.VMInfo {
+	var vmList []*irs.VMInfo
+	handler.logError(handler.conn.invoke(vmHandler, "ListVM", nil, &vmList))
+	return vmList
+}
+
+func (handler *remoteVMHandler) ListVMPage(listReqInfo irs.ListReqInfo) (irs.VMPageInfo, error) {
+	var vmPageInfo irs.VMPageInfo
+	err := handler.conn.invoke(vmHandler, "ListVMPage", []interface{}{listReqInfo}, &vmPageInfo)
+	return vmPageInfo, err
+}
+
+func (handler *remoteVMHandler) GetVM(vmID string) irs.VMInfo {
+	var vmInfo irs.VMInfo
+	handler.logError(handler.conn.invoke(vmHandler, "GetVM", []interface{}{vmID}, &vmInfo))
+	return vmInfo
+}
+
+func (handler *remoteVMHandler) logError(err error) {
+	if err != nil {
+		cblogger.Error(err)
+	}
+}
+
+// WithContext implements idrv.ContextHandler, the calls of the handlers are cancelled with ctx.
+
+func (handler *remoteImageHandler) WithContext(ctx context.Context) interface{} {
+	return &remoteImageHandler{handler.conn.withContext(ctx)}
+}
+
+func (handler *remoteVNetworkHandler) WithContext(ctx context.Context) interface{} {
+	return &remoteVNetworkHandler{handler.conn.withContext(ctx)}
+}
+
+func (handler *remoteSecurityHandler) WithContext(ctx context.Context) interface{} {
+	return &remoteSecurityHandler{handler.conn.withContext(ctx)}
+}
+
+func (handler *remoteKeyPairHandler) WithContext(ctx context.Context) interface{} {
+	return &remoteKeyPairHandler{handler.conn.withContext(ctx)}
+}
+
+func (handler *remoteVNicHandler) WithContext(ctx context.Context) interface{} {
+	return &remoteVNicHandler{handler.conn.withContext(ctx)}
+}
+
+func (handler *remotePublicIPHandler) WithContext(ctx context.Context) interface{} {
+	return &remotePublicIPHandler{handler.conn.withContext(ctx)}
+}
+
+func (handler *remoteVMHandler) WithContext(ctx context.Context) interface{} {
+	return &remoteVMHandler{handler.conn.withContext(ctx)}
+}
@@ -0,0 +1,217 @@
+// Cloud Driver gRPC transport of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the driver side of the gRPC transport.
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package grpcdriver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"reflect"
+	"strconv"
+	"sync"
+	"syscall"
+
+	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
+	icon "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/connect"
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+
+	"google.golang.org/grpc"
+)
+
+// DriverServer serves a CloudDriver to the host.
+// Panics of the driver are returned as errors, so the driver process keeps running.
+type DriverServer struct {
+	CloudDriver idrv.CloudDriver
+	Manifest    idrv.DriverManifest
+
+	mutex       sync.Mutex
+	seq         int
+	connections map[string]icon.CloudConnection
+}
+
+// Serve runs cloudDriver on the unix socket given by SocketEnv until SIGTERM or SIGINT.
+// It is called by main() of the driver server, ex) drivers/aws/grpc-server
+func Serve(cloudDriver idrv.CloudDriver, manifest idrv.DriverManifest) error {
+	socketPath := os.Getenv(SocketEnv)
+	if socketPath == "" {
+		return fmt.Errorf("%s is not set: the driver server must be started by the driver manager", SocketEnv)
+	}
+
+	os.Remove(socketPath)
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(socketPath)
+
+	grpcServer := grpc.NewServer()
+	grpcServer.RegisterService(&serviceDesc, &DriverServer{
+		CloudDriver: cloudDriver,
+		Manifest:    manifest,
+		connections: map[string]icon.CloudConnection{},
+	})
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGTERM, syscall.SIGINT)
+	go func() {
+		<-sigCh
+		grpcServer.GracefulStop()
+	}()
+
+	return grpcServer.Serve(listener)
+}
+
+func (server *DriverServer) GetDriverInfo(ctx context.Context, in *Empty) (*DriverInfoReply, error) {
+	return &DriverInfoReply{
+		DriverVersion: server.CloudDriver.GetDriverVersion(),
+		Capability:    server.CloudDriver.GetDriverCapability(),
+		Manifest:      server.Manifest,
+	}, nil
+}
+
+func (server *DriverServer) ConnectCloud(ctx context.Context, in *ConnectRequest) (reply *ConnectReply, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("driver panic in ConnectCloud: %v", r)
+		}
+	}()
+
+	cloudConnection, err := server.CloudDriver.ConnectCloud(in.ConnectionInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.seq++
+	connectionID := strconv.Itoa(server.seq)
+	server.connections[connectionID] = cloudConnection
+
+	return &ConnectReply{ConnectionID: connectionID}, nil
+}
+
+func (server *DriverServer) CloseConnection(ctx context.Context, in *ConnectReply) (*Empty, error) {
+	server.mutex.Lock()
+	cloudConnection, ok := server.connections[in.ConnectionID]
+	delete(server.connections, in.ConnectionID)
+	server.mutex.Unlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unknown connection: %s", in.ConnectionID)
+	}
+	_, err := invokeMethod(cloudConnection, "Close", nil)
+	return &Empty{}, err
+}
+
+func (server *DriverServer) Invoke(ctx context.Context, in *InvokeRequest) (*InvokeReply, error) {
+	server.mutex.Lock()
+	cloudConnection, ok := server.connections[in.ConnectionID]
+	server.mutex.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown connection: %s", in.ConnectionID)
+	}
+
+	reply := &InvokeReply{}
+	handler, err := createHandler(cloudConnection, in.Handler)
+	if err == nil {
+		// 호스트의 호출이 취소되거나 CallTimeout이 지나면 CSP 요청도 취소 함.
+		if contextHandler, ok := handler.(idrv.ContextHandler); ok {
+			handler = contextHandler.WithContext(ctx)
+		}
+		reply.Results, err = invokeMethod(handler, in.Method, in.Args)
+	}
+	if err != nil {
+		reply.Error = err.Error()
+		reply.ErrorCode = string(irs.ErrorCodeOf(err))
+	}
+	return reply, nil
+}
+
+// CloudConnection의 Create{Handler}() 로 Handler를 생성 함.
+func createHandler(cloudConnection icon.CloudConnection, handlerName string) (handler interface{}, err error) {
+	if handlerName == connectionHandler {
+		return cloudConnection, nil
+	}
+
+	results, err := callMethod(cloudConnection, "Create"+handlerName, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) != 2 {
+		return nil, fmt.Errorf("unknown handler: %s", handlerName)
+	}
+	if errValue := results[1].Interface(); errValue != nil {
+		return nil, errValue.(error)
+	}
+	if results[0].IsNil() {
+		return nil, irs.NotSupported("the driver does not support %s", handlerName)
+	}
+	return results[0].Interface(), nil
+}
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// invokeMethod calls method of target with JSON encoded args and returns JSON encoded results.
+// A non-nil error result is returned as err.
+func invokeMethod(target interface{}, method string, args []json.RawMessage) ([]json.RawMessage, error) {
+	results, err := callMethod(target, method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	var encodedResults []json.RawMessage
+	for _, result := range results {
+		if result.Type() == errorType {
+			if !result.IsNil() {
+				err = result.Interface().(error)
+			}
+			continue
+		}
+		data, encErr := json.Marshal(result.Interface())
+		if encErr != nil {
+			return nil, encErr
+		}
+		encodedResults = append(encodedResults, data)
+	}
+	return encodedResults, err
+}
+
+func callMethod(target interface{}, method string, args []json.RawMessage) (results []reflect.Value, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("driver panic in %s: %v", method, r)
+		}
+	}()
+
+	methodValue := reflect.ValueOf(target).MethodByName(method)
+	if !methodValue.IsValid() {
+		return nil, fmt.Errorf("%T does not have method %s", target, method)
+	}
+	methodType := methodValue.Type()
+	if methodType.NumIn() != len(args) {
+		return nil, fmt.Errorf("%s: %d arguments are required, but %d are given", method, methodType.NumIn(), len(args))
+	}
+
+	in := make([]reflect.Value, len(args))
+	for i, arg := range args {
+		argValue := reflect.New(methodType.In(i))
+		if err := json.Unmarshal(arg, argValue.Interface()); err != nil {
+			return nil, errors.New(method + ": " + err.Error())
+		}
+		in[i] = argValue.Elem()
+	}
+
+	return methodValue.Call(in), nil
+}
@@ -0,0 +1,149 @@
+// Cloud Driver gRPC transport of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the gRPC service between the host and an out-of-process driver.
+// Messages are JSON encoded(content-subtype "json"), so no generated code is required.
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package grpcdriver
+
+import (
+	"context"
+	"encoding/json"
+
+	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+)
+
+// Unix socket path, passed from the host to the driver process.
+const SocketEnv = "CBSPIDER_DRIVER_SOCKET"
+
+const serviceName = "cbspider.driver.CloudDriver"
+
+// Handler name of InvokeRequest for the methods of icon.CloudConnection itself.
+const connectionHandler = "CloudConnection"
+
+type Empty struct{}
+
+type DriverInfoReply struct {
+	DriverVersion string
+	Capability    idrv.DriverCapabilityInfo
+	Manifest      idrv.DriverManifest
+}
+
+type ConnectRequest struct {
+	ConnectionInfo idrv.ConnectionInfo
+}
+
+type ConnectReply struct {
+	ConnectionID string
+}
+
+// InvokeRequest calls Method of Handler(ex: VMHandler) created from the connection.
+// Args are JSON encoded arguments of the method.
+type InvokeRequest struct {
+	ConnectionID string
+	Handler      string
+	Method       string
+	Args         []json.RawMessage
+}
+
+// Results are JSON encoded results of the method without the trailing error.
+// Error is the error or panic of the driver, transport errors are returned as gRPC errors.
+type InvokeReply struct {
+	Results   []json.RawMessage
+	Error     string
+	ErrorCode string // irs.ErrorCode of Error
+}
+
+type driverService interface {
+	GetDriverInfo(context.Context, *Empty) (*DriverInfoReply, error)
+	ConnectCloud(context.Context, *ConnectRequest) (*ConnectReply, error)
+	Invoke(context.Context, *InvokeRequest) (*InvokeReply, error)
+	CloseConnection(context.Context, *ConnectReply) (*Empty, error)
+}
+
+var serviceDesc = grpc.ServiceDesc{
+	ServiceName: serviceName,
+	HandlerType: (*driverService)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDriverInfo",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				in := new(Empty)
+				return unaryHandler(ctx, dec, interceptor, in, "GetDriverInfo", func(ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(driverService).GetDriverInfo(ctx, req.(*Empty))
+				})
+			},
+		},
+		{
+			MethodName: "ConnectCloud",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				in := new(ConnectRequest)
+				return unaryHandler(ctx, dec, interceptor, in, "ConnectCloud", func(ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(driverService).ConnectCloud(ctx, req.(*ConnectRequest))
+				})
+			},
+		},
+		{
+			MethodName: "Invoke",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				in := new(InvokeRequest)
+				return unaryHandler(ctx, dec, interceptor, in, "Invoke", func(ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(driverService).Invoke(ctx, req.(*InvokeRequest))
+				})
+			},
+		},
+		{
+			MethodName: "CloseConnection",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				in := new(ConnectReply)
+				return unaryHandler(ctx, dec, interceptor, in, "CloseConnection", func(ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(driverService).CloseConnection(ctx, req.(*ConnectReply))
+				})
+			},
+		},
+	},
+	Streams: []grpc.StreamDesc{},
+}
+
+func unaryHandler(ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor,
+	in interface{}, method string, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return handler(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: fullMethod(method)}
+	return interceptor(ctx, in, info, handler)
+}
+
+func fullMethod(method string) string {
+	return "/" + serviceName + "/" + method
+}
+
+// JSON codec for the messages above.
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+func (jsonCodec) Name() string {
+	return "json"
+}
+
+func init() {
+	encoding.RegisterCodec(jsonCodec{})
+}
@@ -0,0 +1,32 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the client token of idempotent create calls.
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package interfaces
+
+import "context"
+
+type clientTokenKey struct{}
+
+// WithClientToken returns ctx of a create call with the client token of the call.
+// The middleware derives the token from the idempotency key of the API request, so a retried request has the same token.
+func WithClientToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, clientTokenKey{}, token)
+}
+
+// ClientTokenFrom returns the client token of the ctx of a ContextHandler, "" if none.
+// Drivers of CSPs with idempotent create APIs pass it to the CSP, ex) EC2 RunInstances ClientToken(max 64 ASCII characters),
+// so the CSP returns the resource of the first call instead of creating another one.
+func ClientTokenFrom(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	token, _ := ctx.Value(clientTokenKey{}).(string)
+	return token
+}
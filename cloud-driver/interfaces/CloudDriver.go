@@ -14,14 +14,56 @@ import (
 	icon "../interfaces/connect"
 )
 
+// Capability of a handler, declared by the driver.
+// An empty HandlerCapabilityInfo means the driver does not support the handler.
+type HandlerCapabilityInfo struct {
+	Operations []string // implemented methods of the handler interface, ex) StartVM, ListVMPage. Stubs are not listed.
+	ReqFields  []string // supported optional request fields, ex) ListReqInfo.TagFilter
+}
+
 type DriverCapabilityInfo struct {
-	ImageHandler    bool // support: true, do not support: false
-	VNetworkHandler bool // support: true, do not support: false
-	SecurityHandler bool // support: true, do not support: false
-	KeyPairHandler  bool // support: true, do not support: false
-	VNicHandler     bool // support: true, do not support: false
-	PublicIPHandler bool // support: true, do not support: false
-	VMHandler       bool // support: true, do not support: false
+	ImageHandler    HandlerCapabilityInfo
+	VNetworkHandler HandlerCapabilityInfo
+	SecurityHandler HandlerCapabilityInfo
+	KeyPairHandler  HandlerCapabilityInfo
+	VNicHandler     HandlerCapabilityInfo
+	PublicIPHandler HandlerCapabilityInfo
+	VMHandler       HandlerCapabilityInfo
+}
+
+// Optional request fields.
+const (
+	ListPageSize   = "ListReqInfo.PageSize"
+	ListNextToken  = "ListReqInfo.NextToken"
+	ListNameFilter = "ListReqInfo.NameFilter"
+	ListTagFilter  = "ListReqInfo.TagFilter"
+
+	ImageFilterOwner        = "ImageFilterInfo.Owner"
+	ImageFilterGuestOS      = "ImageFilterInfo.GuestOS"
+	ImageFilterArchitecture = "ImageFilterInfo.Architecture"
+	ImageFilterNamePattern  = "ImageFilterInfo.NamePattern"
+	ImageFilterVisibility   = "ImageFilterInfo.Visibility"
+)
+
+func (capabilityInfo HandlerCapabilityInfo) Supported() bool {
+	return len(capabilityInfo.Operations) > 0
+}
+
+func (capabilityInfo HandlerCapabilityInfo) SupportsOperation(operation string) bool {
+	return contains(capabilityInfo.Operations, operation)
+}
+
+func (capabilityInfo HandlerCapabilityInfo) SupportsReqField(reqField string) bool {
+	return contains(capabilityInfo.ReqFields, reqField)
+}
+
+func contains(list []string, str string) bool {
+	for _, s := range list {
+		if s == str {
+			return true
+		}
+	}
+	return false
 }
 
 type CredentialInfo struct {
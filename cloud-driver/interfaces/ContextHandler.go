@@ -0,0 +1,21 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the optional context interface of resource handlers.
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package interfaces
+
+import "context"
+
+// ContextHandler is an optional interface of resource handlers.
+// Handler methods have no context.Context, so the middleware calls the handler returned by WithContext(ctx)
+// to let the driver pass ctx(ex. the trace span of the call) to its CSP requests.
+type ContextHandler interface {
+	// WithContext returns a copy of the handler whose CSP requests use ctx.
+	WithContext(ctx context.Context) interface{}
+}
@@ -0,0 +1,133 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the manifest of Cloud Driver.
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package interfaces
+
+import (
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"strings"
+)
+
+// Version of the driver interfaces(CloudDriver, CloudConnection, handlers), "major.minor".
+// Increase minor when adding types or fields, major when changing or adding interface methods.
+// 1.1: VNetworkReqInfo.SubnetReqInfoList, client tokens(WithClientToken), ContextHandler, DryRunHandler
+const InterfaceVersion = "1.1"
+
+// Oldest driver interface version the host can load.
+// Go interfaces can not be satisfied partially, so only the same major is compatible.
+const MinInterfaceVersion = "1.0"
+
+// Manifest exported by each driver as "DriverManifest" symbol.
+// The host checks it before type-asserting the "TestDriver" symbol.
+type DriverManifest struct {
+	DriverName       string            `json:"driverName"`
+	DriverVersion    string            `json:"driverVersion"`
+	InterfaceVersion string            `json:"interfaceVersion"` // InterfaceVersion the driver was built with
+	GoVersion        string            `json:"goVersion"`        // ex) go1.12.7
+	ModuleVersions   map[string]string `json:"moduleVersions"`   // module path: version, empty when built without modules
+	Handlers         []string          `json:"handlers"`         // capability summary, ex) VMHandler, ImageHandler
+}
+
+// NewDriverManifest generates the manifest of cloudDriver with the build information of the caller.
+// It must be called in the driver package, ex) var DriverManifest = idrv.NewDriverManifest("AWS", &TestDriver)
+func NewDriverManifest(driverName string, cloudDriver CloudDriver) DriverManifest {
+	manifest := DriverManifest{
+		DriverName:       driverName,
+		DriverVersion:    cloudDriver.GetDriverVersion(),
+		InterfaceVersion: InterfaceVersion,
+		GoVersion:        runtime.Version(),
+		ModuleVersions:   ModuleVersions(),
+	}
+
+	drvCapabilityInfo := cloudDriver.GetDriverCapability()
+	handlers := []struct {
+		name           string
+		capabilityInfo HandlerCapabilityInfo
+	}{
+		{"ImageHandler", drvCapabilityInfo.ImageHandler},
+		{"VNetworkHandler", drvCapabilityInfo.VNetworkHandler},
+		{"SecurityHandler", drvCapabilityInfo.SecurityHandler},
+		{"KeyPairHandler", drvCapabilityInfo.KeyPairHandler},
+		{"VNicHandler", drvCapabilityInfo.VNicHandler},
+		{"PublicIPHandler", drvCapabilityInfo.PublicIPHandler},
+		{"VMHandler", drvCapabilityInfo.VMHandler},
+	}
+	for _, handler := range handlers {
+		if handler.capabilityInfo.Supported() {
+			manifest.Handlers = append(manifest.Handlers, handler.name)
+		}
+	}
+
+	return manifest
+}
+
+// ModuleVersions returns the dependency versions of the running binary.
+func ModuleVersions() map[string]string {
+	moduleVersions := map[string]string{}
+	if buildInfo, ok := debug.ReadBuildInfo(); ok {
+		for _, dep := range buildInfo.Deps {
+			if dep.Replace != nil {
+				dep = dep.Replace
+			}
+			moduleVersions[dep.Path] = dep.Version
+		}
+	}
+	return moduleVersions
+}
+
+// CheckInterfaceVersion checks the driver interface version against the host range [MinInterfaceVersion, InterfaceVersion].
+func (manifest DriverManifest) CheckInterfaceVersion() error {
+	driverMajor, driverMinor, err := parseVersion(manifest.InterfaceVersion)
+	if err != nil {
+		return fmt.Errorf("driver %s: invalid interface version %q in manifest", manifest.DriverName, manifest.InterfaceVersion)
+	}
+	minMajor, minMinor, _ := parseVersion(MinInterfaceVersion)
+	maxMajor, maxMinor, _ := parseVersion(InterfaceVersion)
+
+	switch {
+	case driverMajor < minMajor || (driverMajor == minMajor && driverMinor < minMinor):
+		return fmt.Errorf("driver %s is built with interface version %s, but this host supports %s ~ %s: rebuild the driver with the current cloud-driver/interfaces",
+			manifest.DriverName, manifest.InterfaceVersion, MinInterfaceVersion, InterfaceVersion)
+	case driverMajor > maxMajor || (driverMajor == maxMajor && driverMinor > maxMinor):
+		return fmt.Errorf("driver %s is built with interface version %s, but this host supports %s ~ %s: upgrade the host or build the driver with an older cloud-driver/interfaces",
+			manifest.DriverName, manifest.InterfaceVersion, MinInterfaceVersion, InterfaceVersion)
+	}
+	return nil
+}
+
+// AtLeast returns true if the driver is built with interface version or later,
+// ex) AtLeast("1.1"): the driver reads VNetworkReqInfo.SubnetReqInfoList.
+func (manifest DriverManifest) AtLeast(version string) bool {
+	driverMajor, driverMinor, err := parseVersion(manifest.InterfaceVersion)
+	if err != nil {
+		return false
+	}
+	major, minor, _ := parseVersion(version)
+	return driverMajor > major || (driverMajor == major && driverMinor >= minor)
+}
+
+func parseVersion(version string) (int, int, error) {
+	versionArr := strings.Split(version, ".")
+	if len(versionArr) != 2 {
+		return 0, 0, fmt.Errorf("invalid version: %s", version)
+	}
+	major, err := strconv.Atoi(versionArr[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	minor, err := strconv.Atoi(versionArr[1])
+	if err != nil {
+		return 0, 0, err
+	}
+	return major, minor, nil
+}
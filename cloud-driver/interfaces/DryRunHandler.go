@@ -0,0 +1,20 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the optional dry run interface of resource handlers.
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package interfaces
+
+// DryRunHandler is an optional interface of resource handlers whose CSP has a native dry run, ex) EC2 DryRun.
+// In a dry run, the middleware validates the arguments and calls DryRun instead of the handler method,
+// so nothing is created or changed. Handlers without it are validated by the middleware only.
+type DryRunHandler interface {
+	// DryRun checks the permissions and the parameters of method(args...) on the CSP, ex) ("StartVM", [VMReqInfo])
+	// It returns nil if the call would succeed, or irs.NotSupported if the CSP has no dry run of the method.
+	DryRun(method string, args []interface{}) error
+}
@@ -0,0 +1,34 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the HTTP transport of CloudConnection.
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package interfaces
+
+import (
+	"net"
+	"net/http"
+	"time"
+)
+
+// NewHTTPTransport returns a transport for the SDK clients of one CloudConnection.
+// Settings are those of http.DefaultTransport, but the idle connections are owned by the CloudConnection,
+// so CloudConnection.Close() can release them with CloseIdleConnections().
+func NewHTTPTransport() *http.Transport {
+	return &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+}
@@ -15,11 +15,12 @@ import (
 )
 
 type CloudConnection interface {
-	// CreateImageHandler() (irs.ImageHandler, error)
-	// CreateVNetworkHandler() (irs.VNetworkHandler, error)
-	// CreateSecurityHandler() (irs.SecurityHandler, error)
-	// CreateKeyPairHandler() (irs.KeyPairHandler, error)
-	// CreateVNicHandler() (irs.VNicHandler, error)
+	// A driver returns an irs.NotSupportedError for handlers it does not support.
+	CreateImageHandler() (irs.ImageHandler, error)
+	CreateVNetworkHandler() (irs.VNetworkHandler, error)
+	CreateSecurityHandler() (irs.SecurityHandler, error)
+	CreateKeyPairHandler() (irs.KeyPairHandler, error)
+	CreateVNicHandler() (irs.VNicHandler, error)
 	CreatePublicIPHandler() (irs.PublicIPHandler, error)
 
 	CreateVMHandler() (irs.VMHandler, error)
@@ -0,0 +1,190 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is common error types of Cloud Driver.
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package resources
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// Common error codes. API servers map them to their status codes, ex) NotFoundError: HTTP 404
+type ErrorCode string
+
+const (
+	UnknownError          ErrorCode = "Unknown"
+	InvalidArgumentError  ErrorCode = "InvalidArgument"
+	NotFoundError         ErrorCode = "NotFound"
+	AlreadyExistsError    ErrorCode = "AlreadyExists"
+	UnauthenticatedError  ErrorCode = "Unauthenticated"  // invalid or expired credential
+	PermissionDeniedError ErrorCode = "PermissionDenied" // valid credential without permission
+	NotSupportedError     ErrorCode = "NotSupported"     // the driver or CSP does not support the operation
+	ThrottledError        ErrorCode = "Throttled"        // CSP rate limit
+	UnavailableError      ErrorCode = "Unavailable"      // endpoint unreachable or failing
+	TimeoutError          ErrorCode = "Timeout"
+)
+
+// CloudError is an error with a common error code.
+// Drivers return it where they know the cause, other errors are classified by ErrorCodeOf().
+type CloudError struct {
+	Code    ErrorCode
+	Message string
+	Cause   error // original error of CSP SDK, can be nil
+}
+
+func (cloudError *CloudError) Error() string {
+	if cloudError.Cause != nil && cloudError.Message == "" {
+		return cloudError.Cause.Error()
+	}
+	if cloudError.Cause != nil {
+		return cloudError.Message + ": " + cloudError.Cause.Error()
+	}
+	return cloudError.Message
+}
+
+func (cloudError *CloudError) Unwrap() error {
+	return cloudError.Cause
+}
+
+func NewCloudError(code ErrorCode, format string, a ...interface{}) error {
+	return &CloudError{Code: code, Message: fmt.Sprintf(format, a...)}
+}
+
+// WrapError attaches code to err, nil if err is nil.
+func WrapError(code ErrorCode, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &CloudError{Code: code, Cause: err}
+}
+
+func NotSupported(format string, a ...interface{}) error {
+	return NewCloudError(NotSupportedError, format, a...)
+}
+
+func NotFound(format string, a ...interface{}) error {
+	return NewCloudError(NotFoundError, format, a...)
+}
+
+func InvalidArgument(format string, a ...interface{}) error {
+	return NewCloudError(InvalidArgumentError, format, a...)
+}
+
+func Unauthenticated(format string, a ...interface{}) error {
+	return NewCloudError(UnauthenticatedError, format, a...)
+}
+
+func Unavailable(format string, a ...interface{}) error {
+	return NewCloudError(UnavailableError, format, a...)
+}
+
+// ErrorCodeOf returns the code of a CloudError, or classifies err by the well-known messages of CSP SDKs.
+func ErrorCodeOf(err error) ErrorCode {
+	if err == nil {
+		return ""
+	}
+	for e := err; e != nil; {
+		if cloudError, ok := e.(*CloudError); ok {
+			return cloudError.Code
+		}
+		wrapper, ok := e.(interface{ Unwrap() error })
+		if !ok {
+			break
+		}
+		e = wrapper.Unwrap()
+	}
+	return classifyErrorMessage(err.Error())
+}
+
+// AWS: error code, Azure/GCP/OpenStack/Cloudit: HTTP status in the message.
+// The patterns are checked in order, so a pattern must come before the shorter patterns it contains,
+// ex) PermissionDenied "UnauthorizedOperation"(AWS) before Unauthenticated "Unauthorized"(HTTP 401).
+var errorMessagePatterns = []struct {
+	code     ErrorCode
+	patterns []string
+}{
+	{ThrottledError, []string{"RequestLimitExceeded", "Throttling", "TooManyRequests", "429", "413", "rateLimitExceeded"}},
+	{PermissionDeniedError, []string{"UnauthorizedOperation", "AccessDenied", "AuthorizationFailed", "403", "Forbidden"}},
+	{UnauthenticatedError, []string{"AuthFailure", "ExpiredToken", "InvalidClientTokenId", "SignatureDoesNotMatch", "NoCredentialProviders", "401", "Unauthorized", "invalid_client", "invalid_grant", "token expired"}},
+	{NotFoundError, []string{"NotFound", "not found", "404", "does not exist"}},
+	{AlreadyExistsError, []string{"AlreadyExists", "already exist", "Duplicate", "409"}},
+	{TimeoutError, []string{"timeout", "Timeout", "deadline exceeded"}},
+	{UnavailableError, []string{"connection refused", "no such host", "network is unreachable", "x509", "503", "502", "Unavailable"}},
+	{NotSupportedError, []string{"not support", "NotSupported"}},
+	{InvalidArgumentError, []string{"Invalid", "invalid", "400", "Malformed"}},
+}
+
+// ConnectionError classifies an error of a connection check(CloudConnection.IsConnected()), ex)
+// UnauthenticatedError: invalid or expired credential, PermissionDeniedError: the credential can not call the check API,
+// UnavailableError, TimeoutError: the endpoint is unreachable.
+func ConnectionError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(*CloudError); ok {
+		return err
+	}
+
+	code := ErrorCodeOf(err)
+	if netErr := networkError(err); netErr != nil {
+		code = UnavailableError
+		if netErr.Timeout() {
+			code = TimeoutError
+		}
+	}
+	return &CloudError{Code: code, Message: "connection check failed", Cause: err}
+}
+
+// DNS, dial, TLS errors of net/http are net.Error.
+func networkError(err error) net.Error {
+	for e := err; e != nil; {
+		if netErr, ok := e.(net.Error); ok {
+			return netErr
+		}
+		wrapper, ok := e.(interface{ Unwrap() error })
+		if !ok {
+			break
+		}
+		e = wrapper.Unwrap()
+	}
+	return nil
+}
+
+func classifyErrorMessage(msg string) ErrorCode {
+	for _, errorMessagePattern := range errorMessagePatterns {
+		for _, pattern := range errorMessagePattern.patterns {
+			if containsPattern(msg, pattern) {
+				return errorMessagePattern.code
+			}
+		}
+	}
+	return UnknownError
+}
+
+// HTTP status is matched only as a separate number, ex) "404" matches "Error 404:", not "i-0404ab".
+func containsPattern(msg string, pattern string) bool {
+	if strings.Trim(pattern, "0123456789") != "" {
+		return strings.Contains(msg, pattern)
+	}
+	isDigit := func(b byte) bool { return '0' <= b && b <= '9' }
+	for idx := strings.Index(msg, pattern); idx >= 0; {
+		end := idx + len(pattern)
+		if (idx == 0 || !isDigit(msg[idx-1])) && (end == len(msg) || !isDigit(msg[end])) {
+			return true
+		}
+		next := strings.Index(msg[idx+1:], pattern)
+		if next < 0 {
+			break
+		}
+		idx += 1 + next
+	}
+	return false
+}
@@ -0,0 +1,79 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the test of the error classification with the error messages of CSP SDKs.
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestErrorCodeOf(t *testing.T) {
+	tests := []struct {
+		msg  string
+		code ErrorCode
+	}{
+		// AWS
+		{"UnauthorizedOperation: You are not authorized to perform this operation. Encoded authorization failure message: 4GIOHlT\n\tstatus code: 403, request id: 7a1b", PermissionDeniedError},
+		{"AuthFailure: AWS was not able to validate the provided access credentials\n\tstatus code: 401, request id: 7a1b", UnauthenticatedError},
+		{"ExpiredToken: The security token included in the request is expired\n\tstatus code: 400, request id: 7a1b", UnauthenticatedError},
+		{"RequestLimitExceeded: Request limit exceeded.\n\tstatus code: 503, request id: 7a1b", ThrottledError},
+		{"InvalidInstanceID.NotFound: The instance ID 'i-0404ab' does not exist\n\tstatus code: 400, request id: 7a1b", NotFoundError},
+		{"InvalidInstanceID.Malformed: Invalid id: \"i-0404ab\"\n\tstatus code: 400, request id: 7a1b", InvalidArgumentError},
+		{"InvalidKeyPair.Duplicate: The keypair 'mcloud-barista-keypair' already exists.\n\tstatus code: 400, request id: 7a1b", AlreadyExistsError},
+		{"InvalidParameterValue: Value (sg) for parameter groupId is invalid.\n\tstatus code: 400, request id: 7a1b", InvalidArgumentError},
+		{"NoCredentialProviders: no valid providers in chain. Deprecated.", UnauthenticatedError},
+		// Azure
+		{"compute.VirtualMachinesClient#Get: Failure responding to request: StatusCode=404 -- Original Error: autorest/azure: Service returned an error. Status=404 Code=\"ResourceNotFound\" Message=\"The Resource 'Microsoft.Compute/virtualMachines/vm1' under resource group 'rg' was not found.\"", NotFoundError},
+		{"compute.VirtualMachinesClient#Get: Failure responding to request: StatusCode=403 -- Original Error: autorest/azure: Service returned an error. Status=403 Code=\"AuthorizationFailed\" Message=\"The client 'c' with object id 'o' does not have authorization to perform action 'Microsoft.Compute/virtualMachines/read'\"", PermissionDeniedError},
+		{"azure.BearerAuthorizer#WithAuthorization: Failed to refresh the Token for request to https://management.azure.com: StatusCode=401 -- Original Error: adal: Refresh request failed. Status Code = '401'. Response body: {\"error\":\"invalid_client\"}", UnauthenticatedError},
+		// GCP
+		{"googleapi: Error 403: Required 'compute.instances.get' permission for 'projects/p/zones/z/instances/i', forbidden", PermissionDeniedError},
+		{"googleapi: Error 404: The resource 'projects/p/zones/asia-northeast1-b/instances/vm1' was not found, notFound", NotFoundError},
+		{"googleapi: Error 429: Quota exceeded for quota metric 'Queries', rateLimitExceeded", ThrottledError},
+		{"oauth2: cannot fetch token: 400 Bad Request\nResponse: {\"error\": \"invalid_grant\"}", UnauthenticatedError},
+		{"googleapi: Error 409: The resource 'projects/p/global/firewalls/fw' already exists, alreadyExists", AlreadyExistsError},
+		// OpenStack, Cloudit
+		{"Expected HTTP response code [200] when accessing [GET https://nova/v2.1/servers/abc], but got 404 instead\n{\"itemNotFound\": {\"message\": \"Instance abc could not be found.\", \"code\": 404}}", NotFoundError},
+		{"Expected HTTP response code [201 202] when accessing [POST https://keystone/v3/auth/tokens], but got 401 instead\n{\"error\": {\"code\": 401, \"title\": \"Unauthorized\"}}", UnauthenticatedError},
+		{"Expected HTTP response code [201 202] when accessing [POST https://cloudit/server/vm], but got 409 instead\n", AlreadyExistsError},
+		// network
+		{"dial tcp: lookup ec2.ap-northeast-2.amazonaws.com: no such host", UnavailableError},
+		{"context deadline exceeded", TimeoutError},
+		{"something went wrong", UnknownError},
+	}
+	for _, test := range tests {
+		if code := ErrorCodeOf(errors.New(test.msg)); code != test.code {
+			t.Errorf("ErrorCodeOf(%q) = %s, want %s", test.msg, code, test.code)
+		}
+	}
+}
+
+func TestErrorCodeOfCloudError(t *testing.T) {
+	// the code of a CloudError is not classified again, even if wrapped
+	err := fmt.Errorf("StartVM: %w", NotFound("image %s: 403", "img-1"))
+	if code := ErrorCodeOf(err); code != NotFoundError {
+		t.Errorf("ErrorCodeOf(%v) = %s, want %s", err, code, NotFoundError)
+	}
+	if code := ErrorCodeOf(nil); code != "" {
+		t.Errorf("ErrorCodeOf(nil) = %s, want \"\"", code)
+	}
+}
+
+func TestConnectionError(t *testing.T) {
+	if err := ConnectionError(nil); err != nil {
+		t.Errorf("ConnectionError(nil) = %v", err)
+	}
+	if code := ErrorCodeOf(ConnectionError(context.DeadlineExceeded)); code != TimeoutError {
+		t.Errorf("ConnectionError(%v) = %s, want %s", context.DeadlineExceeded, code, TimeoutError)
+	}
+}
@@ -10,6 +10,12 @@
 
 package resources
 
+import (
+	"path"
+	"strings"
+	"time"
+)
+
 //package image
 
 type ImageReqInfo struct {
@@ -22,11 +28,104 @@ type ImageInfo struct {
 	Name string
 	Id   string
 	// @todo
+
+	GuestOS      string    // normalized OS family, ex) ubuntu, centos, windows
+	Architecture string    // normalized, ex) x86_64, arm64
+	SizeGB       int64     // image(root disk) size
+	CreationTime time.Time // zero value if the CSP does not report it.
+	Visibility   ImageVisibility
+}
+
+// GO do not support Enum. So, define like this.
+type ImageVisibility string
+
+const (
+	PublicImage  ImageVisibility = "PUBLIC"  // provided by CSP or marketplace
+	PrivateImage ImageVisibility = "PRIVATE" // owned by this account(project, tenant)
+)
+
+// Every field is optional, empty field means "do not filter".
+// Drivers apply filters on server side if the CSP supports it, and the rest with Match().
+type ImageFilterInfo struct {
+	Owner        string          // AWS: self, amazon, aws-marketplace, account ID / GCP: image project, ex) debian-cloud
+	GuestOS      string          // normalized OS family, ex) ubuntu
+	Architecture string          // normalized, ex) x86_64
+	NamePattern  string          // '*', '?' wildcard, ex) ubuntu-18.04*
+	Visibility   ImageVisibility // PUBLIC, PRIVATE or "" (all)
+}
+
+type ImagePageInfo struct {
+	ImageInfoList []*ImageInfo
+	NextToken     string // "": last page
 }
 
 type ImageHandler interface {
 	CreateImage(imageReqInfo ImageReqInfo) (ImageInfo, error)
-	ListImage() ([]*ImageInfo, error)
+	ListImage(imageFilterInfo ImageFilterInfo) ([]*ImageInfo, error)
+	ListImagePage(imageFilterInfo ImageFilterInfo, listReqInfo ListReqInfo) (ImagePageInfo, error)
 	GetImage(imageID string) (ImageInfo, error)
 	DeleteImage(imageID string) (bool, error)
 }
+
+// Match reports whether imageInfo satisfies the filter, except Owner which only CSPs can check.
+func (filter ImageFilterInfo) Match(imageInfo ImageInfo) bool {
+	if filter.GuestOS != "" && NormalizeGuestOS(imageInfo.GuestOS) != NormalizeGuestOS(filter.GuestOS) {
+		return false
+	}
+	if filter.Architecture != "" && NormalizeArchitecture(imageInfo.Architecture) != NormalizeArchitecture(filter.Architecture) {
+		return false
+	}
+	if filter.Visibility != "" && imageInfo.Visibility != "" && imageInfo.Visibility != filter.Visibility {
+		return false
+	}
+	if filter.NamePattern != "" {
+		matched, err := path.Match(strings.ToLower(filter.NamePattern), strings.ToLower(imageInfo.Name))
+		if err != nil || !matched {
+			return false
+		}
+	}
+	return true
+}
+
+var guestOSFamilies = []string{
+	"ubuntu", "debian", "centos", "rhel", "sles", "fedora", "amazon-linux", "coreos", "cos", "windows",
+}
+
+// NormalizeGuestOS maps CSP specific OS names(or image names) to an OS family,
+// ex) "Canonical:UbuntuServer", "ubuntu-1804-bionic-v20190813" => "ubuntu".
+// It returns "linux" for unknown linux images and "" if nothing matches.
+func NormalizeGuestOS(osName string) string {
+	name := strings.ToLower(osName)
+	switch {
+	case name == "":
+		return ""
+	case strings.Contains(name, "red hat"), strings.Contains(name, "redhat"):
+		return "rhel"
+	case strings.Contains(name, "suse"):
+		return "sles"
+	case strings.HasPrefix(name, "amzn"), strings.Contains(name, "amazon linux"):
+		return "amazon-linux"
+	}
+	for _, family := range guestOSFamilies {
+		if strings.Contains(name, family) {
+			return family
+		}
+	}
+	if strings.Contains(name, "linux") {
+		return "linux"
+	}
+	return ""
+}
+
+// NormalizeArchitecture maps CSP specific architecture names to x86_64, i386 or arm64.
+func NormalizeArchitecture(arch string) string {
+	switch strings.ToLower(arch) {
+	case "x86_64", "x86-64", "amd64", "x64":
+		return "x86_64"
+	case "i386", "x86", "i686":
+		return "i386"
+	case "arm64", "aarch64":
+		return "arm64"
+	}
+	return strings.ToLower(arch)
+}
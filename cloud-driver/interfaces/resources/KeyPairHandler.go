@@ -24,9 +24,15 @@ type KeyPairInfo struct {
 	KeyMaterial string // 추가 - AWS(PEM파일-RSA PRIVATE KEY)
 }
 
+type KeyPairPageInfo struct {
+	KeyPairInfoList []*KeyPairInfo
+	NextToken       string // "": last page
+}
+
 type KeyPairHandler interface {
 	CreateKey(keyPairReqInfo KeyPairReqInfo) (KeyPairInfo, error)
 	ListKey() ([]*KeyPairInfo, error)
+	ListKeyPage(listReqInfo ListReqInfo) (KeyPairPageInfo, error)
 	GetKey(keyPairID string) (KeyPairInfo, error) // AWS는 keyPairName
 	DeleteKey(keyPairID string) (bool, error)     // AWS는 keyPairName
 }
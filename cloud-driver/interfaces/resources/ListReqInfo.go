@@ -0,0 +1,73 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package resources
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// Options of List*Page() calls. Every field is optional.
+// NextToken is opaque: pass the NextToken of the previous page as is.
+type ListReqInfo struct {
+	PageSize   int               // 0: CSP default(or all, if the CSP does not page)
+	NextToken  string            // "": first page
+	NameFilter string            // '*', '?' wildcard, ex) web-*
+	TagFilter  map[string]string // key: value, AWS Tag, GCP Label, Azure Tag
+}
+
+// MatchName reports whether name satisfies NameFilter, for CSPs without server-side name filter.
+func (listReqInfo ListReqInfo) MatchName(name string) bool {
+	if listReqInfo.NameFilter == "" {
+		return true
+	}
+	matched, err := path.Match(strings.ToLower(listReqInfo.NameFilter), strings.ToLower(name))
+	return err == nil && matched
+}
+
+// MatchTags reports whether tags contain every key-value pair of TagFilter.
+func (listReqInfo ListReqInfo) MatchTags(tags map[string]string) bool {
+	for k, v := range listReqInfo.TagFilter {
+		if tagValue, ok := tags[k]; !ok || tagValue != v {
+			return false
+		}
+	}
+	return true
+}
+
+// LocalPage pages a fully fetched list of count items, for CSPs without native paging.
+// It returns the [start, end) range of this page and the NextToken of the next page.
+func (listReqInfo ListReqInfo) LocalPage(count int) (int, int, string, error) {
+	start := 0
+	if listReqInfo.NextToken != "" {
+		offset, err := strconv.Atoi(listReqInfo.NextToken)
+		if err != nil || offset < 0 {
+			return 0, 0, "", fmt.Errorf("invalid NextToken: %s", listReqInfo.NextToken)
+		}
+		start = offset
+	}
+	if start > count {
+		start = count
+	}
+
+	end := count
+	if listReqInfo.PageSize > 0 && start+listReqInfo.PageSize < count {
+		end = start + listReqInfo.PageSize
+	}
+
+	nextToken := ""
+	if end < count {
+		nextToken = strconv.Itoa(end)
+	}
+	return start, end, nextToken, nil
+}
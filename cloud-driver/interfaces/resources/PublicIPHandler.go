@@ -40,9 +40,19 @@ type PublicIPInfo struct {
 
 }
 
+type PublicIPPageInfo struct {
+	PublicIPInfoList []*PublicIPInfo
+	NextToken        string // "": last page
+}
+
 type PublicIPHandler interface {
 	CreatePublicIP(publicIPReqInfo PublicIPReqInfo) (PublicIPInfo, error)
 	ListPublicIP() ([]*PublicIPInfo, error)
+	ListPublicIPPage(listReqInfo ListReqInfo) (PublicIPPageInfo, error)
 	GetPublicIP(publicIPID string) (PublicIPInfo, error)
 	DeletePublicIP(publicIPID string) (bool, error)
+
+	// vmID: VM ID, or NIC ID where the CSP binds public IPs to NICs (AWS: eni-xxx, Azure: NIC ID)
+	AssociatePublicIP(publicIPID string, vmID string) (bool, error)
+	DisassociatePublicIP(publicIPID string) (bool, error)
 }
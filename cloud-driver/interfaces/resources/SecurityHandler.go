@@ -44,9 +44,15 @@ type SecurityRuleInfo struct {
 	Cidr       string
 }
 
+type SecurityPageInfo struct {
+	SecurityInfoList []*SecurityInfo
+	NextToken        string // "": last page
+}
+
 type SecurityHandler interface {
 	CreateSecurity(securityReqInfo SecurityReqInfo) (SecurityInfo, error)
 	ListSecurity() ([]*SecurityInfo, error)
+	ListSecurityPage(listReqInfo ListReqInfo) (SecurityPageInfo, error)
 	GetSecurity(securityID string) (SecurityInfo, error)
 	DeleteSecurity(securityID string) (bool, error)
 }
@@ -0,0 +1,122 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package resources
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+)
+
+// Max number of concurrent StartVM() calls of StartVMsConcurrently().
+const MaxStartVMsConcurrency = 10
+
+// StartVMsConcurrently implements StartVMs() with StartVM() calls for CSPs without a batch API.
+// Panics of StartVM() are reported as errors of the VM.
+func StartVMsConcurrently(vmHandler VMHandler, vmReqInfo VMReqInfo, count int, minCount int) ([]*VMBatchResult, error) {
+	if count < 1 || minCount > count {
+		return nil, fmt.Errorf("invalid count: %d, minCount: %d", count, minCount)
+	}
+
+	results := make([]*VMBatchResult, count)
+	sem := make(chan struct{}, MaxStartVMsConcurrency)
+	var wg sync.WaitGroup
+
+	for i := 0; i < count; i++ {
+		reqInfo := vmReqInfo
+		reqInfo.Name = BatchVMName(vmReqInfo.Name, i)
+		results[i] = &VMBatchResult{Name: reqInfo.Name}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(result *VMBatchResult, reqInfo VMReqInfo) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			defer func() {
+				if r := recover(); r != nil {
+					result.Error = fmt.Errorf("%v", r)
+				}
+			}()
+			result.VMInfo, result.Error = vmHandler.StartVM(reqInfo)
+		}(results[i], reqInfo)
+	}
+	wg.Wait()
+
+	return results, RollbackVMBatch(vmHandler, results, minCount)
+}
+
+// RollbackVMBatch terminates created VMs of results if fewer than minCount VMs were created.
+func RollbackVMBatch(vmHandler VMHandler, results []*VMBatchResult, minCount int) error {
+	created := 0
+	for _, result := range results {
+		if result.Error == nil {
+			created++
+		}
+	}
+	if created >= minCount {
+		return nil
+	}
+
+	// 생성 후 실패한 VM(ex. 태그 설정 실패)도 삭제 함.
+	for _, result := range results {
+		if result.VMInfo.Id != "" && !result.RolledBack {
+			terminateVM(vmHandler, result)
+		}
+	}
+	return fmt.Errorf("only %d of %d VMs were created, less than minCount %d", created, len(results), minCount)
+}
+
+func terminateVM(vmHandler VMHandler, result *VMBatchResult) {
+	defer func() {
+		if r := recover(); r != nil {
+			result.Error = fmt.Errorf("rollback failed: %v", r)
+		}
+	}()
+	vmHandler.TerminateVM(result.VMInfo.Id)
+	result.RolledBack = true
+}
+
+// BatchVMName returns the name of the index(0-based)-th VM of a batch.
+func BatchVMName(baseName string, index int) string {
+	return baseName + "-" + strconv.Itoa(index+1)
+}
+
+// Error is an interface, so it is encoded as its message in JSON, ex) out-of-process drivers.
+func (result VMBatchResult) MarshalJSON() ([]byte, error) {
+	type alias VMBatchResult
+	errMsg := ""
+	if result.Error != nil {
+		errMsg = result.Error.Error()
+	}
+	return json.Marshal(struct {
+		alias
+		Error string
+	}{alias(result), errMsg})
+}
+
+func (result *VMBatchResult) UnmarshalJSON(data []byte) error {
+	type alias VMBatchResult
+	aux := struct {
+		*alias
+		Error string
+	}{alias: (*alias)(result)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	result.Error = nil
+	if aux.Error != "" {
+		result.Error = errors.New(aux.Error)
+	}
+	return nil
+}
@@ -83,6 +83,19 @@ type VMInfo struct {
 	AdditionalInfo string // Any information to be good for users and developers.
 }
 
+type VMPageInfo struct {
+	VMInfoList []*VMInfo
+	NextToken  string // "": last page
+}
+
+// Result of one VM of StartVMs(). VMs are named "{VMReqInfo.Name}-{1..count}".
+type VMBatchResult struct {
+	Name       string
+	VMInfo     VMInfo
+	Error      error // nil: created
+	RolledBack bool  // created, but terminated because it failed after the create or fewer than minCount VMs were created
+}
+
 type LoginInfo struct {
 	AdminUsername string
 	AdminPassword string
@@ -90,14 +103,22 @@ type LoginInfo struct {
 
 type VMHandler interface {
 	StartVM(vmReqInfo VMReqInfo) (VMInfo, error)
+	// StartVMs creates count VMs and returns a result per VM.
+	// If fewer than minCount VMs are created, created VMs are terminated and an error is returned. minCount 0: no rollback
+	StartVMs(vmReqInfo VMReqInfo, count int, minCount int) ([]*VMBatchResult, error)
 	SuspendVM(vmID string)
 	ResumeVM(vmID string)
 	RebootVM(vmID string)
 	TerminateVM(vmID string)
 
+	// ChangeVMSpec stops the VM if the CSP requires it, changes its spec and restores the previous state.
+	// Progress is observable through GetVMStatus. specID: instance type or flavour, ex) t2.micro
+	ChangeVMSpec(vmID string, specID string) (VMInfo, error)
+
 	ListVMStatus() []*VMStatusInfo
 	GetVMStatus(vmID string) VMStatus
 
 	ListVM() []*VMInfo
+	ListVMPage(listReqInfo ListReqInfo) (VMPageInfo, error)
 	GetVM(vmID string) VMInfo
 }
@@ -14,6 +14,13 @@ type VNetworkReqInfo struct {
 	Name string
 	Id   string
 	// @todo
+
+	SubnetReqInfoList []SubnetReqInfo // drivers create their default subnet if empty
+}
+
+type SubnetReqInfo struct {
+	Name string
+	CIDR string // ex) 10.0.1.0/24
 }
 
 type VNetworkInfo struct {
@@ -23,9 +30,15 @@ type VNetworkInfo struct {
 	// @todo
 }
 
+type VNetworkPageInfo struct {
+	VNetworkInfoList []*VNetworkInfo
+	NextToken        string // "": last page
+}
+
 type VNetworkHandler interface {
 	CreateVNetwork(vNetworkReqInfo VNetworkReqInfo) (VNetworkInfo, error)
 	ListVNetwork() ([]*VNetworkInfo, error)
+	ListVNetworkPage(listReqInfo ListReqInfo) (VNetworkPageInfo, error)
 	GetVNetwork(vNetworkID string) (VNetworkInfo, error)
 	DeleteVNetwork(vNetworkID string) (bool, error)
 }
@@ -22,9 +22,15 @@ type VNicInfo struct {
 	// @todo
 }
 
+type VNicPageInfo struct {
+	VNicInfoList []*VNicInfo
+	NextToken    string // "": last page
+}
+
 type VNicHandler interface {
 	CreateVNic(vNicReqInfo VNicReqInfo) (VNicInfo, error)
 	ListVNic() ([]*VNicInfo, error)
+	ListVNicPage(listReqInfo ListReqInfo) (VNicPageInfo, error)
 	GetVNic(vNicID string) (VNicInfo, error)
 	DeleteVNic(vNicID string) (bool, error)
 }
@@ -0,0 +1,290 @@
+// Cloud Driver Logger of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the logging facade of drivers.
+// Every line has the fields of the driver and the call(connection, operation, request_id),
+// structs in a line are redacted(ex. LoginInfo.AdminPassword), and the level can be set per driver.
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+package logger
+
+import (
+	cblog "github.com/cloud-barista/cb-log"
+	"github.com/sirupsen/logrus"
+
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Field names of log lines.
+const (
+	DriverField     = "driver"
+	ComponentField  = "component"
+	ConnectionField = "connection"
+	OperationField  = "operation"
+	RequestIDField  = "request_id"
+)
+
+// LevelEnv is the environment variable of the levels, ex) "info,AWS=debug"
+// It is inherited by the processes of gRPC drivers, so they log at the levels of the server.
+const LevelEnv = "CBSPIDER_LOG_LEVEL"
+
+// Fields are the structured fields of log lines.
+type Fields map[string]interface{}
+
+// base writes the lines of all Loggers with the output and the format of cb-log.
+// Its level is the lowest, the level of a line is checked by the Logger.
+var base *logrus.Logger
+
+var (
+	levelMutex   sync.RWMutex
+	defaultLevel = logrus.InfoLevel
+	driverLevels = map[string]logrus.Level{} // key: upper case driver name
+)
+
+func init() {
+	cbLogger := cblog.GetLogger("CB-SPIDER DRIVER")
+	base = logrus.New()
+	base.Out = cbLogger.Out
+	base.Formatter = cbLogger.Formatter
+	base.Hooks = cbLogger.Hooks
+	base.ReportCaller = cbLogger.ReportCaller
+	base.SetLevel(logrus.TraceLevel)
+	defaultLevel = cbLogger.GetLevel()
+
+	if spec := os.Getenv(LevelEnv); spec != "" {
+		if err := SetLevels(spec); err != nil {
+			base.Error(err)
+		}
+	}
+}
+
+// SetLevel sets the level of drivers without their own level, ex) "info"
+func SetLevel(level string) error {
+	lvl, err := logrus.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+	levelMutex.Lock()
+	defer levelMutex.Unlock()
+	defaultLevel = lvl
+	return nil
+}
+
+// SetDriverLevel sets the level of a driver, ex) ("AWS", "debug"). An empty level resets it to the default.
+func SetDriverLevel(driver string, level string) error {
+	levelMutex.Lock()
+	defer levelMutex.Unlock()
+	if level == "" {
+		delete(driverLevels, strings.ToUpper(driver))
+		return nil
+	}
+	lvl, err := logrus.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+	driverLevels[strings.ToUpper(driver)] = lvl
+	return nil
+}
+
+// SetLevels sets levels of a spec, ex) "info,AWS=debug,GCP=warn"
+// An item without a driver is the default level.
+func SetLevels(spec string) error {
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		var err error
+		if idx := strings.Index(item, "="); idx < 0 {
+			err = SetLevel(item)
+		} else if driver := strings.TrimSpace(item[:idx]); driver == "" || strings.EqualFold(driver, "default") {
+			err = SetLevel(strings.TrimSpace(item[idx+1:]))
+		} else {
+			err = SetDriverLevel(driver, strings.TrimSpace(item[idx+1:]))
+		}
+		if err != nil {
+			return fmt.Errorf("invalid log level %q: %v", item, err)
+		}
+	}
+	return nil
+}
+
+func levelOf(driver string) logrus.Level {
+	levelMutex.RLock()
+	defer levelMutex.RUnlock()
+	if lvl, ok := driverLevels[strings.ToUpper(driver)]; ok {
+		return lvl
+	}
+	return defaultLevel
+}
+
+type fieldsKey struct{}
+
+// WithFields returns ctx with fields added to the fields of ctx, ex) request_id of an API request.
+func WithFields(ctx context.Context, fields Fields) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	merged := Fields{}
+	for key, value := range FieldsFrom(ctx) {
+		merged[key] = value
+	}
+	for key, value := range fields {
+		merged[key] = value
+	}
+	return context.WithValue(ctx, fieldsKey{}, merged)
+}
+
+// FieldsFrom returns the fields of ctx, nil if none. The returned map must not be modified.
+func FieldsFrom(ctx context.Context) Fields {
+	if ctx == nil {
+		return nil
+	}
+	fields, _ := ctx.Value(fieldsKey{}).(Fields)
+	return fields
+}
+
+// NewRequestID returns a random ID of an API request without one, ex) "5f2b8c0e9a1d4e7b"
+func NewRequestID() string {
+	var id [8]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(id[:])
+}
+
+// Logger is the logger of a driver component, ex) New("AWS", "VMHandler")
+type Logger struct {
+	driver string
+	fields Fields
+}
+
+func New(driver string, component string) *Logger {
+	return &Logger{driver: driver, fields: Fields{DriverField: driver, ComponentField: component}}
+}
+
+// With returns the logger with fields added.
+func (logger *Logger) With(fields Fields) *Logger {
+	merged := make(Fields, len(logger.fields)+len(fields))
+	for key, value := range logger.fields {
+		merged[key] = value
+	}
+	for key, value := range fields {
+		merged[key] = value
+	}
+	return &Logger{driver: logger.driver, fields: merged}
+}
+
+// WithContext returns the logger with the fields of ctx, ex) connection, operation and request_id of a handler call.
+func (logger *Logger) WithContext(ctx context.Context) *Logger {
+	fields := FieldsFrom(ctx)
+	if len(fields) == 0 {
+		return logger
+	}
+	return logger.With(fields)
+}
+
+func (logger *Logger) Debug(args ...interface{}) { logger.log(logrus.DebugLevel, args) }
+func (logger *Logger) Info(args ...interface{})  { logger.log(logrus.InfoLevel, args) }
+func (logger *Logger) Warn(args ...interface{})  { logger.log(logrus.WarnLevel, args) }
+func (logger *Logger) Error(args ...interface{}) { logger.log(logrus.ErrorLevel, args) }
+
+func (logger *Logger) Debugf(format string, args ...interface{}) {
+	logger.logf(logrus.DebugLevel, format, args)
+}
+
+func (logger *Logger) Infof(format string, args ...interface{}) {
+	logger.logf(logrus.InfoLevel, format, args)
+}
+
+func (logger *Logger) Warnf(format string, args ...interface{}) {
+	logger.logf(logrus.WarnLevel, format, args)
+}
+
+func (logger *Logger) Errorf(format string, args ...interface{}) {
+	logger.logf(logrus.ErrorLevel, format, args)
+}
+
+// Dump logs v as redacted JSON at debug level, instead of spew.Dump(v).
+func (logger *Logger) Dump(msg string, v interface{}) {
+	if !logger.enabled(logrus.DebugLevel) {
+		return
+	}
+	logger.entry().Debug(msg + ": " + dumpString(v))
+}
+
+func (logger *Logger) enabled(level logrus.Level) bool {
+	return levelOf(logger.driver) >= level
+}
+
+func (logger *Logger) entry() *logrus.Entry {
+	return base.WithFields(logrus.Fields(logger.fields))
+}
+
+func (logger *Logger) log(level logrus.Level, args []interface{}) {
+	if !logger.enabled(level) {
+		return
+	}
+	args = redactArgs(args, func(arg interface{}) interface{} { return dumpString(arg) })
+	logger.entry().Log(level, args...)
+}
+
+func (logger *Logger) logf(level logrus.Level, format string, args []interface{}) {
+	if !logger.enabled(level) {
+		return
+	}
+	args = redactArgs(args, func(arg interface{}) interface{} { return Redact(arg) })
+	logger.entry().Logf(level, format, args...)
+}
+
+// redactArgs returns a copy of args with the structs replaced by redact(arg), args of the caller are not modified.
+func redactArgs(args []interface{}, redact func(arg interface{}) interface{}) []interface{} {
+	redacted := make([]interface{}, len(args))
+	for i, arg := range args {
+		if isDumped(arg) {
+			arg = redact(arg)
+		}
+		redacted[i] = arg
+	}
+	return redacted
+}
+
+// isDumped reports whether arg is a struct or a collection to be redacted. errors and Stringers print themselves.
+func isDumped(arg interface{}) bool {
+	switch arg.(type) {
+	case nil, error, fmt.Stringer, []byte:
+		return false
+	}
+	value := reflect.ValueOf(arg)
+	for value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return false
+		}
+		value = value.Elem()
+	}
+	switch value.Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array:
+		return true
+	}
+	return false
+}
+
+func dumpString(v interface{}) string {
+	data, err := json.Marshal(Redact(v))
+	if err != nil {
+		return fmt.Sprintf("<%T>", v)
+	}
+	return string(data)
+}
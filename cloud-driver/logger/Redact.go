@@ -0,0 +1,78 @@
+// Cloud Driver Logger of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the redaction of secrets in logged values, also used by the audit of the middleware.
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+package logger
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Keys of secrets in logs and audit records, compared in lower case without '_' and '-'.
+var redactedKeys = []string{"password", "passwd", "secret", "token", "privatekey", "keymaterial", "credential", "accesskey", "apikey", "userdata"}
+
+const redactedValue = "********"
+
+// Redact returns v as JSON values(map, slice, ...) with the values of secret keys replaced, ex) LoginInfo.AdminPassword
+// Empty strings, nulls and empty objects are removed, ReqInfo has many fields not used by a call.
+func Redact(v interface{}) interface{} {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil
+	}
+	return redactValue(value)
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			item = redactValue(item)
+			if isEmptyValue(item) {
+				delete(v, key)
+			} else if isSecretKey(key) {
+				v[key] = redactedValue
+			} else {
+				v[key] = item
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	}
+	return value
+}
+
+func isEmptyValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+func isSecretKey(key string) bool {
+	key = strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key))
+	for _, secret := range redactedKeys {
+		if strings.Contains(key, secret) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+// Cloud Driver Logger of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the test of the redaction of secrets in logged values.
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+package logger
+
+import (
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRedact(t *testing.T) {
+	vmReqInfo := irs.VMReqInfo{
+		Name:      "mcloud-barista-vm",
+		SpecID:    "t2.micro",
+		LoginInfo: irs.LoginInfo{AdminUsername: "cb-user", AdminPassword: "cb-password!"},
+	}
+	redacted, ok := Redact(vmReqInfo).(map[string]interface{})
+	if !ok {
+		t.Fatalf("Redact(VMReqInfo): %T", Redact(vmReqInfo))
+	}
+	loginInfo := redacted["LoginInfo"].(map[string]interface{})
+	if loginInfo["AdminPassword"] != redactedValue || loginInfo["AdminUsername"] != "cb-user" {
+		t.Errorf("LoginInfo: %v", loginInfo)
+	}
+	if redacted["Name"] != "mcloud-barista-vm" || redacted["SpecID"] != "t2.micro" {
+		t.Errorf("VMReqInfo: %v", redacted)
+	}
+	// 빈 문자열, 빈 객체는 제거 함.
+	if _, ok := redacted["SecurityInfo"]; ok {
+		t.Errorf("empty SecurityInfo is not removed: %v", redacted)
+	}
+	if vmReqInfo.LoginInfo.AdminPassword != "cb-password!" {
+		t.Errorf("the value of the caller is modified")
+	}
+}
+
+func TestRedactKeys(t *testing.T) {
+	keys := map[string]bool{
+		"AdminPassword":    true,
+		"client_secret":    true,
+		"ClientSecret":     true,
+		"IdentityEndpoint": false,
+		"access-key":       true,
+		"AccessKeyId":      true,
+		"SecretAccessKey":  true,
+		"PrivateKey":       true,
+		"KeyMaterial":      true,
+		"Token":            true,
+		"UserData":         true,
+		"ApiKey":           true,
+		"Username":         false,
+		"KeyPairName":      false,
+		"PublicKey":        false,
+		"Fingerprint":      false,
+	}
+	for key, secret := range keys {
+		if isSecretKey(key) != secret {
+			t.Errorf("isSecretKey(%s): %v, want %v", key, !secret, secret)
+		}
+	}
+}
+
+func TestRedactNested(t *testing.T) {
+	v := map[string]interface{}{
+		"Credentials": []interface{}{
+			map[string]interface{}{"Key": "ClientId", "Value": "id"},
+		},
+		"KeyValueInfoList": []map[string]string{{"Key": "region", "Value": "ap-northeast-2"}},
+		"Args":             []interface{}{map[string]interface{}{"KeyPairInfo": map[string]interface{}{"PrivateKey": "-----BEGIN RSA"}}},
+		"Empty":            map[string]interface{}{"Inner": ""},
+	}
+	data, _ := json.Marshal(Redact(v))
+	out := string(data)
+	if strings.Contains(out, "id") && strings.Contains(out, "ClientId") {
+		t.Errorf("the values under a secret key are not redacted: %s", out)
+	}
+	if strings.Contains(out, "BEGIN RSA") {
+		t.Errorf("PrivateKey in a slice is not redacted: %s", out)
+	}
+	if !strings.Contains(out, "ap-northeast-2") {
+		t.Errorf("a value of a non-secret key is redacted: %s", out)
+	}
+	if strings.Contains(out, "Empty") {
+		t.Errorf("an object with only empty values is not removed: %s", out)
+	}
+}
+
+func TestRedactArgs(t *testing.T) {
+	loginInfo := irs.LoginInfo{AdminUsername: "cb-user", AdminPassword: "cb-password!"}
+	args := []interface{}{"vm", 3, errors.New("failed"), loginInfo, &loginInfo, nil}
+	redacted := redactArgs(args, func(arg interface{}) interface{} { return dumpString(arg) })
+
+	if redacted[0] != "vm" || redacted[1] != 3 || redacted[2] != args[2] || redacted[5] != nil {
+		t.Errorf("scalars, errors and nil are changed: %v", redacted)
+	}
+	for _, i := range []int{3, 4} {
+		if s, _ := redacted[i].(string); strings.Contains(s, "cb-password!") || !strings.Contains(s, "cb-user") {
+			t.Errorf("arg %d: %v", i, redacted[i])
+		}
+	}
+	if args[3].(irs.LoginInfo).AdminPassword != "cb-password!" {
+		t.Errorf("args of the caller are modified")
+	}
+}
@@ -0,0 +1,182 @@
+// Cloud Driver Middleware of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the audit of handler calls that change CSP resources.
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package middleware
+
+import (
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+	"github.com/cloud-barista/poc-cb-spider/cloud-driver/logger"
+	"go.opentelemetry.io/otel/trace"
+
+	"context"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// Caller is the client of an API request, recorded in audit records.
+// The APIs have no authentication yet, so User is asserted by the client, ex) X-Spider-User header of the REST API.
+type Caller struct {
+	User    string `json:",omitempty"`
+	Address string `json:",omitempty"` // remote address of the client
+	API     string `json:",omitempty"` // "REST", "gRPC"
+}
+
+type callerKey struct{}
+
+// WithCaller returns ctx with caller, the caller of the calls of a connection got with ctx.
+func WithCaller(ctx context.Context, caller Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// CallerFrom returns the caller of ctx, empty if none.
+func CallerFrom(ctx context.Context) Caller {
+	if ctx == nil {
+		return Caller{}
+	}
+	caller, _ := ctx.Value(callerKey{}).(Caller)
+	return caller
+}
+
+// AuditRecord is a call that changes CSP resources, a line of the audit log.
+type AuditRecord struct {
+	Time           time.Time
+	Caller         Caller
+	DriverName     string
+	ConnectionName string
+	Region         string `json:",omitempty"`
+	Handler        string
+	Method         string
+	Request        interface{} // arguments of the call, secrets are redacted
+	Result         string      // "success", or the irs.ErrorCode of the error
+	Error          string      `json:",omitempty"`
+	ResourceIDs    []string    `json:",omitempty"` // target of the call, or created resources
+	ElapsedMs      int64
+	TraceID        string `json:",omitempty"`
+}
+
+// AuditSink stores audit records, ex) FileAuditSink.
+// Write is called by one goroutine at a time.
+type AuditSink interface {
+	Write(record *AuditRecord) error
+	Close() error
+}
+
+// Auditor records every call that is not ReadOnly() to its sinks.
+// A call is recorded after it returned, once for all its retries. A failing sink does not fail the call.
+type Auditor struct {
+	mutex sync.Mutex
+	sinks []AuditSink
+}
+
+func NewAuditor(sinks ...AuditSink) *Auditor {
+	return &Auditor{sinks: sinks}
+}
+
+// SetSinks replaces the sinks, and returns the old ones to be closed by the caller.
+func (auditor *Auditor) SetSinks(sinks ...AuditSink) []AuditSink {
+	auditor.mutex.Lock()
+	defer auditor.mutex.Unlock()
+
+	old := auditor.sinks
+	auditor.sinks = sinks
+	return old
+}
+
+// AddSink adds a sink, ex) to send records to a SIEM in addition to the file.
+func (auditor *Auditor) AddSink(sink AuditSink) {
+	auditor.mutex.Lock()
+	defer auditor.mutex.Unlock()
+
+	auditor.sinks = append(auditor.sinks, sink)
+}
+
+// Intercept is the Interceptor of the Auditor. It should be outside of Retry, to record a call once.
+func (auditor *Auditor) Intercept(call *Call, next func() error) error {
+	// dry run은 자원을 변경 하지 않으므로 감사 대상이 아님.
+	if call.ReadOnly() || DryRunPlanFrom(call.Context) != nil {
+		return next()
+	}
+
+	start := time.Now()
+	err := next()
+
+	record := &AuditRecord{
+		Time:           start.UTC(),
+		Caller:         CallerFrom(call.Context),
+		DriverName:     call.DriverName,
+		ConnectionName: call.ConnectionName,
+		Region:         call.Region,
+		Handler:        call.Handler,
+		Method:         call.Method,
+		Request:        logger.Redact(call.Args),
+		Result:         resultSuccess,
+		ResourceIDs:    auditResourceIDs(call),
+		ElapsedMs:      int64(time.Since(start) / time.Millisecond),
+	}
+	if err != nil {
+		record.Result = string(irs.ErrorCodeOf(err))
+		record.Error = err.Error()
+	}
+	if spanContext := trace.SpanContextFromContext(call.Context); spanContext.HasTraceID() {
+		record.TraceID = spanContext.TraceID().String()
+	}
+	auditor.write(record)
+	return err
+}
+
+func (auditor *Auditor) write(record *AuditRecord) {
+	auditor.mutex.Lock()
+	defer auditor.mutex.Unlock()
+
+	for _, sink := range auditor.sinks {
+		if err := sink.Write(record); err != nil {
+			cblogger.Errorf("failed to write the audit record of %s.%s(%s): %v", record.Handler, record.Method, record.ConnectionName, err)
+		}
+	}
+}
+
+// Close closes the sinks.
+func (auditor *Auditor) Close() error {
+	var lastErr error
+	for _, sink := range auditor.SetSinks() {
+		if err := sink.Close(); err != nil {
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+// 생성된 리소스는 결과의 Id, 그 외(Delete*, TerminateVM, ...)는 첫 인자가 대상 리소스 ID 임.
+func auditResourceIDs(call *Call) []string {
+	var ids []string
+	switch result := call.Result.(type) {
+	case []*irs.VMBatchResult:
+		for _, batchResult := range result {
+			if batchResult != nil && batchResult.VMInfo.Id != "" {
+				ids = append(ids, batchResult.VMInfo.Id)
+			}
+		}
+	case nil, bool:
+	default:
+		value := reflect.ValueOf(result)
+		if value.Kind() == reflect.Struct {
+			if id := value.FieldByName("Id"); id.IsValid() && id.Kind() == reflect.String && id.String() != "" {
+				ids = append(ids, id.String())
+			}
+		}
+	}
+	if len(ids) == 0 && len(call.Args) > 0 {
+		if id, ok := call.Args[0].(string); ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,155 @@
+// Cloud Driver Middleware of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the audit log file, JSON Lines with size based rotation.
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package middleware
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FileAuditSink appends a JSON line per record to a file.
+// The file is only appended, when it exceeds MaxSize it is renamed to {name}-{time}{ext}, ex) audit-20190701T120000.000.jsonl
+type FileAuditSink struct {
+	mutex      sync.Mutex
+	path       string
+	maxSize    int64    // bytes, <= 0: no rotation
+	maxBackups int      // rotated files to keep, <= 0: all
+	file       *os.File // nil: closed, or failed to reopen after a rotation
+	size       int64
+	closed     bool // by Close()
+}
+
+const rotatedTimeFormat = "20060102T150405.000"
+
+// NewFileAuditSink opens path to append records. The file and its directory are created for the owner only.
+func NewFileAuditSink(path string, maxSize int64, maxBackups int) (*FileAuditSink, error) {
+	sink := &FileAuditSink{path: path, maxSize: maxSize, maxBackups: maxBackups}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+	if err := sink.open(); err != nil {
+		return nil, err
+	}
+	return sink, nil
+}
+
+func (sink *FileAuditSink) open() error {
+	file, err := os.OpenFile(sink.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	sink.file, sink.size = file, info.Size()
+	return nil
+}
+
+func (sink *FileAuditSink) Write(record *AuditRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	sink.mutex.Lock()
+	defer sink.mutex.Unlock()
+
+	if sink.closed {
+		return os.ErrClosed
+	}
+	if sink.file == nil {
+		if err := sink.open(); err != nil {
+			return err
+		}
+	}
+	var rotateErr error
+	if sink.maxSize > 0 && sink.size > 0 && sink.size+int64(len(line)) > sink.maxSize {
+		// rotate 실패 시에도 기존 파일에 기록 함.
+		if rotateErr = sink.rotate(); rotateErr != nil && sink.file == nil {
+			return rotateErr
+		}
+	}
+	// 한 번의 Write로 한 줄을 씀. O_APPEND이므로 다른 프로세스가 같은 파일에 써도 줄이 섞이지 않음.
+	n, err := sink.file.Write(line)
+	sink.size += int64(n)
+	if err == nil && rotateErr != nil {
+		return fmt.Errorf("failed to rotate, the record is appended to %s: %v", sink.path, rotateErr)
+	}
+	return err
+}
+
+// sink.mutex must be held.
+// On any error, the current file is open again.
+func (sink *FileAuditSink) rotate() error {
+	if err := sink.file.Close(); err != nil {
+		sink.file = nil
+		return sink.reopen(err)
+	}
+	sink.file = nil
+
+	ext := filepath.Ext(sink.path)
+	prefix := strings.TrimSuffix(sink.path, ext) + "-"
+	// 같은 ms에 다시 rotate 되어도 이전 파일을 덮어쓰지 않음.
+	rotated := time.Now().UTC()
+	for {
+		if _, err := os.Stat(prefix + rotated.Format(rotatedTimeFormat) + ext); os.IsNotExist(err) {
+			break
+		}
+		rotated = rotated.Add(time.Millisecond)
+	}
+	if err := os.Rename(sink.path, prefix+rotated.Format(rotatedTimeFormat)+ext); err != nil {
+		return sink.reopen(err)
+	}
+
+	if sink.maxBackups > 0 {
+		// 시간 형식이 고정 길이이므로 이름 순서가 시간 순서 임.
+		backups, _ := filepath.Glob(prefix + "*" + ext)
+		sort.Strings(backups)
+		for len(backups) > sink.maxBackups {
+			if err := os.Remove(backups[0]); err != nil {
+				cblogger.Errorf("failed to remove the old audit log: %v", err)
+			}
+			backups = backups[1:]
+		}
+	}
+	return sink.open()
+}
+
+// reopen opens the current file after a failed rotation, and returns err.
+func (sink *FileAuditSink) reopen(err error) error {
+	if openErr := sink.open(); openErr != nil {
+		return fmt.Errorf("%v, reopen: %v", err, openErr)
+	}
+	return err
+}
+
+func (sink *FileAuditSink) Close() error {
+	sink.mutex.Lock()
+	defer sink.mutex.Unlock()
+
+	sink.closed = true
+
+	if sink.file == nil {
+		return nil
+	}
+	err := sink.file.Close()
+	sink.file = nil
+	return err
+}
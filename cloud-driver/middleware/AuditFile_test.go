@@ -0,0 +1,159 @@
+// Cloud Driver Middleware of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the test of the audit log file and its rotation.
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package middleware
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testAuditRecord(method string) *AuditRecord {
+	return &AuditRecord{Time: time.Now().UTC(), ConnectionName: "aws-seoul", Handler: vmHandler, Method: method, Result: "success"}
+}
+
+// auditLines returns the methods of the records of path.
+func auditLines(t *testing.T, path string) []string {
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	var methods []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		record := AuditRecord{}
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		methods = append(methods, record.Method)
+	}
+	return methods
+}
+
+func auditDir(t *testing.T) string {
+	dir, err := os.MkdirTemp("", "audit-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestFileAuditSink(t *testing.T) {
+	dir := auditDir(t)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "log", "audit.jsonl")
+
+	sink, err := NewFileAuditSink(path, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, method := range []string{"StartVM", "TerminateVM"} {
+		if err := sink.Write(testAuditRecord(method)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := sink.Write(testAuditRecord("StartVM")); err != os.ErrClosed {
+		t.Errorf("Write after Close: %v", err)
+	}
+
+	// 다시 열면 이어서 씀.
+	sink, _ = NewFileAuditSink(path, 0, 0)
+	sink.Write(testAuditRecord("CreateKey"))
+	sink.Close()
+	if methods := auditLines(t, path); strings.Join(methods, ",") != "StartVM,TerminateVM,CreateKey" {
+		t.Errorf("records: %v", methods)
+	}
+
+	for name, mode := range map[string]os.FileMode{path: 0600, filepath.Dir(path): 0700 | os.ModeDir} {
+		if info, err := os.Stat(name); err != nil {
+			t.Error(err)
+		} else if info.Mode() != mode {
+			t.Errorf("%s: %v, want %v", name, info.Mode(), mode)
+		}
+	}
+}
+
+func TestFileAuditSinkRotate(t *testing.T) {
+	dir := auditDir(t)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.jsonl")
+
+	line, _ := json.Marshal(testAuditRecord("StartVM"))
+	// 파일 당 2개의 레코드, 백업은 2개까지.
+	sink, err := NewFileAuditSink(path, int64(2*(len(line)+1)), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sink.Close()
+
+	for i := 0; i < 7; i++ {
+		if err := sink.Write(testAuditRecord("StartVM")); err != nil {
+			t.Fatalf("record %d: %v", i, err)
+		}
+	}
+
+	backups, _ := filepath.Glob(filepath.Join(dir, "audit-*.jsonl"))
+	if len(backups) != 2 {
+		t.Fatalf("backups: %v, want 2", backups)
+	}
+	for _, backup := range backups {
+		if n := len(auditLines(t, backup)); n != 2 {
+			t.Errorf("%s: %d records, want 2", backup, n)
+		}
+	}
+	if n := len(auditLines(t, path)); n != 1 {
+		t.Errorf("%s: %d records, want 1", path, n)
+	}
+}
+
+func TestFileAuditSinkRotateFailure(t *testing.T) {
+	dir := auditDir(t)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.jsonl")
+
+	line, _ := json.Marshal(testAuditRecord("StartVM"))
+	sink, err := NewFileAuditSink(path, int64(len(line)+1), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sink.Close()
+
+	if err := sink.Write(testAuditRecord("StartVM")); err != nil {
+		t.Fatal(err)
+	}
+
+	// 파일이 삭제되어 rename이 실패해도 다시 열어 기록 함.
+	os.Remove(path)
+	if err := sink.Write(testAuditRecord("TerminateVM")); err == nil {
+		t.Error("the failed rotation is not reported")
+	}
+	if err := sink.Write(testAuditRecord("CreateKey")); err != nil {
+		t.Errorf("Write after the failed rotation: %v", err)
+	}
+
+	var methods []string
+	files, _ := filepath.Glob(filepath.Join(dir, "audit*.jsonl"))
+	for _, file := range files {
+		methods = append(methods, auditLines(t, file)...)
+	}
+	if strings.Join(methods, ",") != "TerminateVM,CreateKey" {
+		t.Errorf("records after the failed rotation: %v in %v", methods, files)
+	}
+}
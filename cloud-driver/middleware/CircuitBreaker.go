@@ -0,0 +1,282 @@
+// Cloud Driver Middleware of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the per-connection circuit breaker of handler calls.
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package middleware
+
+import (
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// States of a circuit
+const (
+	CircuitClosed   = "closed"    // calls pass
+	CircuitOpen     = "open"      // calls fail fast with irs.UnavailableError
+	CircuitHalfOpen = "half-open" // IsConnected() succeeded, one call is passed to test the CSP
+)
+
+type CircuitBreakerConfig struct {
+	FailureThreshold int           // consecutive failures to open the circuit, <= 0: disabled
+	SlowCallDuration time.Duration // a List*, Get* call slower than this is a failure, 0: no latency check
+	OpenDuration     time.Duration // time to fail fast before probing with IsConnected()
+}
+
+var DefaultCircuitBreakerConfig = CircuitBreakerConfig{FailureThreshold: 5, SlowCallDuration: 30 * time.Second, OpenDuration: 30 * time.Second}
+
+// Cause of the UnavailableError of an open circuit. Retry does not retry it.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitState is the state of the circuit of a connection, for dashboards.
+type CircuitState struct {
+	ConnectionName      string
+	State               string // CircuitClosed, CircuitOpen, CircuitHalfOpen
+	ConsecutiveFailures int
+	LastError           string    `json:",omitempty"`
+	OpenedAt            time.Time // zero if closed
+	AvgLatencyMs        int64     // moving average of List*, Get* calls
+}
+
+type circuit struct {
+	state    string
+	failures int
+	openedAt time.Time
+	probing  bool          // a half-open call is running
+	latency  time.Duration // exponential moving average
+	lastErr  string
+}
+
+// CircuitBreaker keeps a circuit per connection name, shared by all handlers of the connection.
+type CircuitBreaker struct {
+	mutex    sync.Mutex
+	config   CircuitBreakerConfig
+	circuits map[string]*circuit
+}
+
+func NewCircuitBreaker(config CircuitBreakerConfig) *CircuitBreaker {
+	return &CircuitBreaker{config: config, circuits: map[string]*circuit{}}
+}
+
+// SetConfig changes the config. Circuits are closed.
+func (breaker *CircuitBreaker) SetConfig(config CircuitBreakerConfig) {
+	breaker.mutex.Lock()
+	defer breaker.mutex.Unlock()
+
+	breaker.config = config
+	breaker.circuits = map[string]*circuit{}
+}
+
+// Intercept is the Interceptor of the CircuitBreaker.
+// It counts every attempt, so it should be after Retry and RateLimiter. Calls in a dry run are not counted.
+func (breaker *CircuitBreaker) Intercept(call *Call, next func() error) error {
+	if call.InDryRun() {
+		return next()
+	}
+	probe, err := breaker.allow(call.ConnectionName)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	// panic도 실패로 기록해야 half-open의 probing이 풀림.
+	defer func() {
+		if r := recover(); r != nil {
+			breaker.record(call, time.Since(start), irs.Unavailable("%s.%s panicked: %v", call.Handler, call.Method, r))
+			panic(r)
+		}
+	}()
+
+	if probe {
+		connected, err := call.Conn.IsConnected()
+		if err == nil && !connected {
+			err = irs.Unavailable("%s: not connected", call.ConnectionName)
+		}
+		if err != nil {
+			breaker.reopen(call.ConnectionName, err)
+			return &irs.CloudError{Code: irs.UnavailableError, Message: fmt.Sprintf("%s: connection check failed(%v)", call.ConnectionName, err), Cause: ErrCircuitOpen}
+		}
+		cblogger.Infof("%s: circuit is half-open", call.ConnectionName)
+	}
+
+	start = time.Now()
+	err = next()
+	breaker.record(call, time.Since(start), err)
+	return err
+}
+
+// allow returns an error if the circuit is open, or probe true if this call should probe the half-open circuit.
+func (breaker *CircuitBreaker) allow(connectionName string) (bool, error) {
+	breaker.mutex.Lock()
+	defer breaker.mutex.Unlock()
+
+	if breaker.config.FailureThreshold <= 0 {
+		return false, nil
+	}
+	c := breaker.circuit(connectionName)
+	switch c.state {
+	case CircuitClosed:
+		return false, nil
+	case CircuitOpen:
+		if wait := breaker.config.OpenDuration - time.Since(c.openedAt); wait > 0 {
+			return false, breaker.openError(connectionName, wait)
+		}
+		c.state = CircuitHalfOpen
+		c.probing = true
+		return true, nil
+	}
+	// half-open: 결과를 알 때까지 다른 호출은 실패 처리 함.
+	if c.probing {
+		return false, breaker.openError(connectionName, 0)
+	}
+	c.probing = true
+	return true, nil
+}
+
+func (breaker *CircuitBreaker) openError(connectionName string, wait time.Duration) error {
+	message := fmt.Sprintf("%s: the CSP is failing", connectionName)
+	if wait > 0 {
+		message += fmt.Sprintf(", retry after %v", wait.Round(time.Second))
+	}
+	return &irs.CloudError{Code: irs.UnavailableError, Message: message, Cause: ErrCircuitOpen}
+}
+
+func (breaker *CircuitBreaker) record(call *Call, latency time.Duration, err error) {
+	breaker.mutex.Lock()
+	defer breaker.mutex.Unlock()
+
+	if breaker.config.FailureThreshold <= 0 {
+		return
+	}
+	c := breaker.circuit(call.ConnectionName)
+
+	failed := isFailure(err)
+	if call.ReadOnly() && latency > 0 {
+		if c.latency == 0 {
+			c.latency = latency
+		} else {
+			c.latency = (c.latency*4 + latency) / 5
+		}
+		if breaker.config.SlowCallDuration > 0 && latency > breaker.config.SlowCallDuration {
+			failed = true
+			if err == nil {
+				c.lastErr = fmt.Sprintf("%s.%s took %v", call.Handler, call.Method, latency.Round(time.Millisecond))
+			}
+		}
+	}
+
+	c.probing = false
+	if !failed {
+		if c.state != CircuitClosed {
+			cblogger.Infof("%s: circuit is closed", call.ConnectionName)
+		}
+		c.state = CircuitClosed
+		c.failures = 0
+		return
+	}
+
+	c.failures++
+	if err != nil {
+		c.lastErr = err.Error()
+	}
+	if c.state == CircuitHalfOpen || c.failures >= breaker.config.FailureThreshold {
+		if c.state != CircuitOpen {
+			cblogger.Errorf("%s: circuit is open after %d failures, last: %s", call.ConnectionName, c.failures, c.lastErr)
+		}
+		c.state = CircuitOpen
+		c.openedAt = time.Now()
+	}
+}
+
+// 연결 확인이 실패하면 오류 종류와 관계 없이 다시 open 함.
+func (breaker *CircuitBreaker) reopen(connectionName string, err error) {
+	breaker.mutex.Lock()
+	defer breaker.mutex.Unlock()
+
+	c := breaker.circuit(connectionName)
+	c.state = CircuitOpen
+	c.openedAt = time.Now()
+	c.probing = false
+	c.lastErr = err.Error()
+}
+
+// breaker.mutex must be held.
+func (breaker *CircuitBreaker) circuit(connectionName string) *circuit {
+	c, ok := breaker.circuits[connectionName]
+	if !ok {
+		c = &circuit{state: CircuitClosed}
+		breaker.circuits[connectionName] = c
+	}
+	return c
+}
+
+// 요청 오류(NotFound, InvalidArgument, ...)는 CSP 장애가 아니므로 실패로 세지 않음.
+func isFailure(err error) bool {
+	switch irs.ErrorCodeOf(err) {
+	case irs.UnavailableError, irs.TimeoutError:
+		return true
+	}
+	return false
+}
+
+func isCircuitOpen(err error) bool {
+	for e := err; e != nil; {
+		if e == ErrCircuitOpen {
+			return true
+		}
+		wrapper, ok := e.(interface{ Unwrap() error })
+		if !ok {
+			break
+		}
+		e = wrapper.Unwrap()
+	}
+	return false
+}
+
+// States returns the circuits of connections called since the last SetConfig(), sorted by connection name.
+func (breaker *CircuitBreaker) States() []*CircuitState {
+	breaker.mutex.Lock()
+	defer breaker.mutex.Unlock()
+
+	var states []*CircuitState
+	for connectionName, c := range breaker.circuits {
+		states = append(states, c.circuitState(connectionName))
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i].ConnectionName < states[j].ConnectionName })
+	return states
+}
+
+// State returns the circuit of a connection, CircuitClosed if it was not called.
+func (breaker *CircuitBreaker) State(connectionName string) CircuitState {
+	breaker.mutex.Lock()
+	defer breaker.mutex.Unlock()
+
+	if c, ok := breaker.circuits[connectionName]; ok {
+		return *c.circuitState(connectionName)
+	}
+	return CircuitState{ConnectionName: connectionName, State: CircuitClosed}
+}
+
+func (c *circuit) circuitState(connectionName string) *CircuitState {
+	state := &CircuitState{
+		ConnectionName:      connectionName,
+		State:               c.state,
+		ConsecutiveFailures: c.failures,
+		LastError:           c.lastErr,
+		AvgLatencyMs:        int64(c.latency / time.Millisecond),
+	}
+	if c.state != CircuitClosed {
+		state.OpenedAt = c.openedAt
+	}
+	return state
+}
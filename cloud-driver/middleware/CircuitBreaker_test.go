@@ -0,0 +1,232 @@
+// Cloud Driver Middleware of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the test of the circuit breaker of handler calls.
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package middleware
+
+import (
+	icon "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/connect"
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+
+	"context"
+	"testing"
+	"time"
+)
+
+// IsConnected()만 사용 함.
+type probeConnection struct {
+	icon.CloudConnection
+	connected bool
+	probes    int
+}
+
+func (conn *probeConnection) IsConnected() (bool, error) {
+	conn.probes++
+	return conn.connected, nil
+}
+
+func breakerCall(breaker *CircuitBreaker, conn *probeConnection, method string, err error) (bool, error) {
+	call := testCall(method)
+	call.Conn = conn
+	called := false
+	result := breaker.Intercept(call, func() error {
+		called = true
+		return err
+	})
+	return called, result
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	breaker := NewCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 3, OpenDuration: 50 * time.Millisecond})
+	conn := &probeConnection{}
+	unavailable := irs.Unavailable("503 Service Unavailable")
+
+	// 요청 오류는 실패로 세지 않음.
+	for i := 0; i < 5; i++ {
+		breakerCall(breaker, conn, "GetVM", irs.NotFound("vm not found"))
+	}
+	if state := breaker.State("aws-seoul"); state.State != CircuitClosed || state.ConsecutiveFailures != 0 {
+		t.Fatalf("after NotFound errors: %+v", state)
+	}
+
+	// 성공하면 연속 실패 수가 초기화 됨.
+	breakerCall(breaker, conn, "GetVM", unavailable)
+	breakerCall(breaker, conn, "GetVM", unavailable)
+	breakerCall(breaker, conn, "GetVM", nil)
+	if state := breaker.State("aws-seoul"); state.State != CircuitClosed || state.ConsecutiveFailures != 0 {
+		t.Fatalf("after a success: %+v", state)
+	}
+
+	for i := 0; i < 3; i++ {
+		breakerCall(breaker, conn, "StartVM", unavailable)
+	}
+	if state := breaker.State("aws-seoul"); state.State != CircuitOpen || state.LastError == "" {
+		t.Fatalf("after 3 failures: %+v", state)
+	}
+	called, err := breakerCall(breaker, conn, "GetVM", nil)
+	if called || !isCircuitOpen(err) || irs.ErrorCodeOf(err) != irs.UnavailableError {
+		t.Fatalf("open circuit: called %v, err %v", called, err)
+	}
+	if state := breaker.State("gcp-seoul"); state.State != CircuitClosed {
+		t.Fatalf("other connection: %+v", state)
+	}
+
+	// OpenDuration 후 연결 확인이 실패하면 다시 open.
+	time.Sleep(60 * time.Millisecond)
+	called, err = breakerCall(breaker, conn, "GetVM", nil)
+	if called || !isCircuitOpen(err) || conn.probes != 1 {
+		t.Fatalf("failed probe: called %v, err %v, probes %d", called, err, conn.probes)
+	}
+	if state := breaker.State("aws-seoul"); state.State != CircuitOpen {
+		t.Fatalf("after a failed probe: %+v", state)
+	}
+
+	// 연결 확인이 성공하면 half-open, 시험 호출이 성공하면 closed.
+	time.Sleep(60 * time.Millisecond)
+	conn.connected = true
+	called, err = breakerCall(breaker, conn, "GetVM", nil)
+	if !called || err != nil || conn.probes != 2 {
+		t.Fatalf("half-open call: called %v, err %v, probes %d", called, err, conn.probes)
+	}
+	if state := breaker.State("aws-seoul"); state.State != CircuitClosed {
+		t.Fatalf("after the half-open call: %+v", state)
+	}
+}
+
+func TestCircuitBreakerHalfOpenFailure(t *testing.T) {
+	breaker := NewCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 1, OpenDuration: 10 * time.Millisecond})
+	conn := &probeConnection{connected: true}
+
+	breakerCall(breaker, conn, "GetVM", irs.NewCloudError(irs.TimeoutError, "timeout"))
+	time.Sleep(20 * time.Millisecond)
+	called, _ := breakerCall(breaker, conn, "GetVM", irs.NewCloudError(irs.TimeoutError, "timeout"))
+	if !called {
+		t.Fatal("the half-open call is not passed")
+	}
+	if state := breaker.State("aws-seoul"); state.State != CircuitOpen {
+		t.Fatalf("after the failed half-open call: %+v", state)
+	}
+}
+
+func TestCircuitBreakerHalfOpenProbing(t *testing.T) {
+	breaker := NewCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 1, OpenDuration: 10 * time.Millisecond})
+	conn := &probeConnection{connected: true}
+
+	breakerCall(breaker, conn, "GetVM", irs.Unavailable("503"))
+	time.Sleep(20 * time.Millisecond)
+
+	// 시험 호출 중 다른 호출은 실패 함.
+	call := testCall("GetVM")
+	call.Conn = conn
+	breaker.Intercept(call, func() error {
+		if called, err := breakerCall(breaker, conn, "ListVM", nil); called || !isCircuitOpen(err) {
+			t.Errorf("a call during the half-open call: called %v, err %v", called, err)
+		}
+		return nil
+	})
+}
+
+func TestCircuitBreakerHalfOpenPanic(t *testing.T) {
+	breaker := NewCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 1, OpenDuration: 10 * time.Millisecond})
+	conn := &probeConnection{connected: true}
+
+	breakerCall(breaker, conn, "GetVM", irs.Unavailable("503"))
+	time.Sleep(20 * time.Millisecond)
+
+	// 시험 호출의 panic은 그대로 전달되고 실패로 기록 됨.
+	func() {
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Errorf("recovered %v, want boom", r)
+			}
+		}()
+		call := testCall("GetVM")
+		call.Conn = conn
+		breaker.Intercept(call, func() error { panic("boom") })
+	}()
+	if state := breaker.State("aws-seoul"); state.State != CircuitOpen {
+		t.Fatalf("after the panicked half-open call: %+v", state)
+	}
+
+	// probing이 풀려 다음 시험 호출로 closed가 됨.
+	time.Sleep(20 * time.Millisecond)
+	if called, err := breakerCall(breaker, conn, "GetVM", nil); !called || err != nil {
+		t.Fatalf("half-open call after the panic: called %v, err %v", called, err)
+	}
+	if state := breaker.State("aws-seoul"); state.State != CircuitClosed {
+		t.Fatalf("after the half-open call: %+v", state)
+	}
+}
+
+func TestCircuitBreakerSlowCall(t *testing.T) {
+	breaker := NewCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 2, SlowCallDuration: 5 * time.Millisecond, OpenDuration: time.Minute})
+	conn := &probeConnection{}
+	slow := func() error {
+		time.Sleep(10 * time.Millisecond)
+		return nil
+	}
+
+	// 생성 호출은 오래 걸려도 실패가 아님.
+	for i := 0; i < 2; i++ {
+		call := testCall("StartVM")
+		call.Conn = conn
+		breaker.Intercept(call, slow)
+	}
+	if state := breaker.State("aws-seoul"); state.State != CircuitClosed {
+		t.Fatalf("after slow creates: %+v", state)
+	}
+
+	for i := 0; i < 2; i++ {
+		call := testCall("ListVM")
+		call.Conn = conn
+		if err := breaker.Intercept(call, slow); err != nil {
+			t.Fatalf("slow call %d: %v", i, err)
+		}
+	}
+	if state := breaker.State("aws-seoul"); state.State != CircuitOpen || state.AvgLatencyMs < 10 {
+		t.Fatalf("after slow reads: %+v", state)
+	}
+}
+
+func TestCircuitBreakerDisabled(t *testing.T) {
+	breaker := NewCircuitBreaker(CircuitBreakerConfig{})
+	conn := &probeConnection{}
+	for i := 0; i < 10; i++ {
+		if called, _ := breakerCall(breaker, conn, "GetVM", irs.Unavailable("503")); !called {
+			t.Fatalf("call %d is not passed", i)
+		}
+	}
+}
+
+func TestCircuitBreakerDryRun(t *testing.T) {
+	breaker := NewCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 1, OpenDuration: time.Minute})
+	conn := &probeConnection{}
+	ctx, _ := WithDryRun(context.Background())
+
+	// dry run의 실패는 circuit을 열지 않음.
+	call := testCall("TerminateVM")
+	call.Conn, call.Context = conn, ctx
+	breaker.Intercept(call, func() error { return irs.Unavailable("503") })
+	if state := breaker.State("aws-seoul"); state.State != CircuitClosed || state.ConsecutiveFailures != 0 {
+		t.Fatalf("after a failed dry run: %+v", state)
+	}
+
+	// 열린 circuit에서도 dry run은 실행 됨, dry run 중의 조회는 세어짐.
+	breakerCall(breaker, conn, "TerminateVM", irs.Unavailable("503"))
+	called := false
+	breaker.Intercept(call, func() error { called = true; return nil })
+	if !called {
+		t.Error("the dry run is rejected by the open circuit")
+	}
+	read := testCall("GetVM")
+	read.Conn, read.Context = conn, ctx
+	if err := breaker.Intercept(read, func() error { return nil }); !isCircuitOpen(err) {
+		t.Errorf("a read in the dry run: %v, want the open circuit", err)
+	}
+}
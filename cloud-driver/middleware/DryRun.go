@@ -0,0 +1,245 @@
+// Cloud Driver Middleware of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the dry run of handler calls that change CSP resources.
+// A call in a dry run is validated and recorded in the DryRunPlan of its context, the driver handler is not called.
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package middleware
+
+import (
+	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+	"github.com/cloud-barista/poc-cb-spider/cloud-driver/logger"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"context"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Actions of dry run results.
+const (
+	DryRunCreate = "Create"
+	DryRunUpdate = "Update" // Suspend, Resume, Reboot, ChangeVMSpec, Associate, Disassociate
+	DryRunDelete = "Delete"
+)
+
+// Validations of dry run results.
+const (
+	ValidatedByCSP   = "CSP"   // permissions and parameters are checked by the dry run of the CSP, ex) EC2 DryRun
+	ValidatedLocally = "Local" // only the arguments and the target are checked, the CSP has no dry run
+)
+
+// DryRunKey is the span attribute of calls in a dry run.
+const DryRunKey = attribute.Key("cbspider.dry_run")
+
+// DryRunResult is what a call would create or change.
+type DryRunResult struct {
+	Handler    string
+	Method     string
+	Action     string
+	Target     string      `json:",omitempty"` // ID of the resource to be changed or deleted
+	Current    interface{} `json:",omitempty"` // the target resource now, secrets are redacted
+	Request    interface{} `json:",omitempty"` // arguments of the call, secrets are redacted
+	Validation string
+	Error      string `json:",omitempty"` // the call would fail
+	err        error
+}
+
+// DryRunPlan collects the results of the calls in a dry run, ex) of an API request.
+type DryRunPlan struct {
+	mutex   sync.Mutex
+	results []*DryRunResult
+}
+
+type dryRunKey struct{}
+
+// WithDryRun returns ctx whose calls are dry runs, and the plan which collects their results.
+func WithDryRun(ctx context.Context) (context.Context, *DryRunPlan) {
+	plan := &DryRunPlan{}
+	return context.WithValue(ctx, dryRunKey{}, plan), plan
+}
+
+// DryRunPlanFrom returns the plan of ctx, nil if ctx is not a dry run.
+func DryRunPlanFrom(ctx context.Context) *DryRunPlan {
+	if ctx == nil {
+		return nil
+	}
+	plan, _ := ctx.Value(dryRunKey{}).(*DryRunPlan)
+	return plan
+}
+
+// InDryRun reports whether the call is a dry run, a call that changes resources with the DryRunPlan in its context.
+// The driver handler is not called, so CircuitBreaker and Metrics do not count it.
+func (call *Call) InDryRun() bool {
+	return DryRunPlanFrom(call.Context) != nil && !call.ReadOnly()
+}
+
+func (plan *DryRunPlan) add(result *DryRunResult) {
+	plan.mutex.Lock()
+	defer plan.mutex.Unlock()
+	plan.results = append(plan.results, result)
+}
+
+// Results returns the results in the order of the calls.
+func (plan *DryRunPlan) Results() []*DryRunResult {
+	plan.mutex.Lock()
+	defer plan.mutex.Unlock()
+	return append([]*DryRunResult(nil), plan.results...)
+}
+
+// Err returns the error of the first call that would fail, nil if all would succeed.
+// Methods without an error result(ex. VMHandler.SuspendVM) report their errors only here.
+func (plan *DryRunPlan) Err() error {
+	plan.mutex.Lock()
+	defer plan.mutex.Unlock()
+	for _, result := range plan.results {
+		if result.err != nil {
+			return result.err
+		}
+	}
+	return nil
+}
+
+// DryRun returns an Interceptor which does not call the driver handler for the calls of a dry run that change resources.
+// It validates the arguments, gets the target(ex. GetVM of TerminateVM), calls the idrv.DryRunHandler of the driver if implemented,
+// and records the result in the plan of Call.Context. It should be the innermost one, so a dry run on the CSP is throttled like other calls.
+func DryRun() Interceptor {
+	return func(call *Call, next func() error) error {
+		if !call.InDryRun() {
+			return next()
+		}
+		plan := DryRunPlanFrom(call.Context)
+		trace.SpanFromContext(call.Context).SetAttributes(DryRunKey.Bool(true))
+
+		result := &DryRunResult{Handler: call.Handler, Method: call.Method, Action: dryRunAction(call), Validation: ValidatedLocally}
+		if result.Action == DryRunCreate {
+			result.Request = logger.Redact(call.Args)
+		} else {
+			result.Target, _ = call.Args[0].(string)
+			if len(call.Args) > 1 {
+				result.Request = logger.Redact(call.Args[1:])
+			}
+		}
+
+		result.err = dryRun(call, result)
+		if result.err != nil {
+			result.Error = result.err.Error()
+		}
+		plan.add(result)
+		return result.err
+	}
+}
+
+func dryRun(call *Call, result *DryRunResult) error {
+	if err := validateArgs(call); err != nil {
+		return err
+	}
+
+	driverHandler := driverHandlerOf(call)
+	if result.Target != "" {
+		current, err := getTarget(driverHandler, call.Handler, result.Target)
+		if err != nil {
+			return err
+		}
+		result.Current = logger.Redact(current)
+	}
+
+	dryRunHandler, ok := driverHandler.(idrv.DryRunHandler)
+	if !ok {
+		return nil
+	}
+	err := dryRunHandler.DryRun(call.Method, call.Args)
+	if irs.ErrorCodeOf(err) == irs.NotSupportedError {
+		return nil
+	}
+	if err == nil {
+		result.Validation = ValidatedByCSP
+	}
+	return err
+}
+
+func dryRunAction(call *Call) string {
+	switch {
+	case call.Creates():
+		return DryRunCreate
+	case strings.HasPrefix(call.Method, "Delete"), call.Method == "TerminateVM":
+		return DryRunDelete
+	}
+	return DryRunUpdate
+}
+
+// Get method of the target of each handler, ex) DeleteImage(id) -> GetImage(id)
+var getTargetMethods = map[string]string{
+	imageHandler:    "GetImage",
+	vNetworkHandler: "GetVNetwork",
+	securityHandler: "GetSecurity",
+	keyPairHandler:  "GetKey",
+	vNicHandler:     "GetVNic",
+	publicIPHandler: "GetPublicIP",
+	vmHandler:       "GetVM",
+}
+
+// getTarget returns the resource of id by the Get method of the handler.
+// VMHandler.GetVM() has no error result, so a VM without Id is not found.
+func getTarget(driverHandler interface{}, handler string, id string) (interface{}, error) {
+	method := reflect.ValueOf(driverHandler).MethodByName(getTargetMethods[handler])
+	if !method.IsValid() {
+		return nil, nil
+	}
+	results := method.Call([]reflect.Value{reflect.ValueOf(id)})
+	if len(results) > 1 && !results[1].IsNil() {
+		return nil, results[1].Interface().(error)
+	}
+	current := results[0].Interface()
+	if vmInfo, ok := current.(irs.VMInfo); ok && vmInfo.Id == "" {
+		return nil, irs.NotFound("%s: VM not found", id)
+	}
+	return current, nil
+}
+
+// validateArgs checks the arguments that every driver requires.
+func validateArgs(call *Call) error {
+	for i, arg := range call.Args {
+		// ID 인자(Delete*의 ID, ChangeVMSpec의 specID, ...)는 비어 있으면 안 됨.
+		if id, ok := arg.(string); ok && id == "" {
+			return irs.InvalidArgument("%s.%s: argument %d is empty", call.Handler, call.Method, i+1)
+		}
+	}
+
+	switch call.Method {
+	case "StartVM", "StartVMs":
+		vmReqInfo := call.Args[0].(irs.VMReqInfo)
+		switch {
+		case vmReqInfo.Name == "":
+			return irs.InvalidArgument("VMReqInfo.Name is required")
+		case vmReqInfo.ImageInfo.Id == "":
+			return irs.InvalidArgument("VMReqInfo.ImageInfo.Id is required")
+		case vmReqInfo.SpecID == "":
+			return irs.InvalidArgument("VMReqInfo.SpecID is required")
+		}
+		if call.Method == "StartVMs" {
+			count, minCount := call.Args[1].(int), call.Args[2].(int)
+			if count < 1 || minCount < 0 || minCount > count {
+				return irs.InvalidArgument("invalid count: %d, minCount: %d", count, minCount)
+			}
+		}
+	case "CreateKey":
+		if call.Args[0].(irs.KeyPairReqInfo).Name == "" {
+			return irs.InvalidArgument("KeyPairReqInfo.Name is required")
+		}
+	case "CreateSecurity":
+		securityReqInfo := call.Args[0].(irs.SecurityReqInfo)
+		if securityReqInfo.Name == "" && securityReqInfo.GroupName == "" {
+			return irs.InvalidArgument("SecurityReqInfo.Name or GroupName is required")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,497 @@
+// Cloud Driver Middleware of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the handlers of the middleware, they call the driver handlers through the interceptors.
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package middleware
+
+import (
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+
+	"context"
+)
+
+type imageHandlerWrapper struct {
+	conn    *connection
+	handler irs.ImageHandler
+}
+
+func (handler *imageHandlerWrapper) driverHandler(ctx context.Context) irs.ImageHandler {
+	if driverHandler, ok := withContext(handler.handler, ctx).(irs.ImageHandler); ok {
+		return driverHandler
+	}
+	return handler.handler
+}
+
+func (handler *imageHandlerWrapper) CreateImage(imageReqInfo irs.ImageReqInfo) (irs.ImageInfo, error) {
+	callResult, err := handler.conn.invoke(handler.handler, imageHandler, "CreateImage", []interface{}{imageReqInfo}, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).CreateImage(imageReqInfo)
+		return err
+	})
+	imageInfo, _ := callResult.(irs.ImageInfo)
+	return imageInfo, err
+}
+
+func (handler *imageHandlerWrapper) ListImage(imageFilterInfo irs.ImageFilterInfo) ([]*irs.ImageInfo, error) {
+	callResult, err := handler.conn.invoke(handler.handler, imageHandler, "ListImage", []interface{}{imageFilterInfo}, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).ListImage(imageFilterInfo)
+		return err
+	})
+	imageList, _ := callResult.([]*irs.ImageInfo)
+	return imageList, err
+}
+
+func (handler *imageHandlerWrapper) ListImagePage(imageFilterInfo irs.ImageFilterInfo, listReqInfo irs.ListReqInfo) (irs.ImagePageInfo, error) {
+	callResult, err := handler.conn.invoke(handler.handler, imageHandler, "ListImagePage", []interface{}{imageFilterInfo, listReqInfo}, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).ListImagePage(imageFilterInfo, listReqInfo)
+		return err
+	})
+	imagePageInfo, _ := callResult.(irs.ImagePageInfo)
+	return imagePageInfo, err
+}
+
+func (handler *imageHandlerWrapper) GetImage(imageID string) (irs.ImageInfo, error) {
+	callResult, err := handler.conn.invoke(handler.handler, imageHandler, "GetImage", []interface{}{imageID}, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).GetImage(imageID)
+		return err
+	})
+	imageInfo, _ := callResult.(irs.ImageInfo)
+	return imageInfo, err
+}
+
+func (handler *imageHandlerWrapper) DeleteImage(imageID string) (bool, error) {
+	callResult, err := handler.conn.invoke(handler.handler, imageHandler, "DeleteImage", []interface{}{imageID}, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).DeleteImage(imageID)
+		return err
+	})
+	result, _ := callResult.(bool)
+	return result, err
+}
+
+type vNetworkHandlerWrapper struct {
+	conn    *connection
+	handler irs.VNetworkHandler
+}
+
+func (handler *vNetworkHandlerWrapper) driverHandler(ctx context.Context) irs.VNetworkHandler {
+	if driverHandler, ok := withContext(handler.handler, ctx).(irs.VNetworkHandler); ok {
+		return driverHandler
+	}
+	return handler.handler
+}
+
+func (handler *vNetworkHandlerWrapper) CreateVNetwork(vNetworkReqInfo irs.VNetworkReqInfo) (irs.VNetworkInfo, error) {
+	callResult, err := handler.conn.invoke(handler.handler, vNetworkHandler, "CreateVNetwork", []interface{}{vNetworkReqInfo}, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).CreateVNetwork(vNetworkReqInfo)
+		return err
+	})
+	vNetworkInfo, _ := callResult.(irs.VNetworkInfo)
+	return vNetworkInfo, err
+}
+
+func (handler *vNetworkHandlerWrapper) ListVNetwork() ([]*irs.VNetworkInfo, error) {
+	callResult, err := handler.conn.invoke(handler.handler, vNetworkHandler, "ListVNetwork", nil, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).ListVNetwork()
+		return err
+	})
+	vNetworkList, _ := callResult.([]*irs.VNetworkInfo)
+	return vNetworkList, err
+}
+
+func (handler *vNetworkHandlerWrapper) ListVNetworkPage(listReqInfo irs.ListReqInfo) (irs.VNetworkPageInfo, error) {
+	callResult, err := handler.conn.invoke(handler.handler, vNetworkHandler, "ListVNetworkPage", []interface{}{listReqInfo}, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).ListVNetworkPage(listReqInfo)
+		return err
+	})
+	vNetworkPageInfo, _ := callResult.(irs.VNetworkPageInfo)
+	return vNetworkPageInfo, err
+}
+
+func (handler *vNetworkHandlerWrapper) GetVNetwork(vNetworkID string) (irs.VNetworkInfo, error) {
+	callResult, err := handler.conn.invoke(handler.handler, vNetworkHandler, "GetVNetwork", []interface{}{vNetworkID}, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).GetVNetwork(vNetworkID)
+		return err
+	})
+	vNetworkInfo, _ := callResult.(irs.VNetworkInfo)
+	return vNetworkInfo, err
+}
+
+func (handler *vNetworkHandlerWrapper) DeleteVNetwork(vNetworkID string) (bool, error) {
+	callResult, err := handler.conn.invoke(handler.handler, vNetworkHandler, "DeleteVNetwork", []interface{}{vNetworkID}, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).DeleteVNetwork(vNetworkID)
+		return err
+	})
+	result, _ := callResult.(bool)
+	return result, err
+}
+
+type securityHandlerWrapper struct {
+	conn    *connection
+	handler irs.SecurityHandler
+}
+
+func (handler *securityHandlerWrapper) driverHandler(ctx context.Context) irs.SecurityHandler {
+	if driverHandler, ok := withContext(handler.handler, ctx).(irs.SecurityHandler); ok {
+		return driverHandler
+	}
+	return handler.handler
+}
+
+func (handler *securityHandlerWrapper) CreateSecurity(securityReqInfo irs.SecurityReqInfo) (irs.SecurityInfo, error) {
+	callResult, err := handler.conn.invoke(handler.handler, securityHandler, "CreateSecurity", []interface{}{securityReqInfo}, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).CreateSecurity(securityReqInfo)
+		return err
+	})
+	securityInfo, _ := callResult.(irs.SecurityInfo)
+	return securityInfo, err
+}
+
+func (handler *securityHandlerWrapper) ListSecurity() ([]*irs.SecurityInfo, error) {
+	callResult, err := handler.conn.invoke(handler.handler, securityHandler, "ListSecurity", nil, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).ListSecurity()
+		return err
+	})
+	securityList, _ := callResult.([]*irs.SecurityInfo)
+	return securityList, err
+}
+
+func (handler *securityHandlerWrapper) ListSecurityPage(listReqInfo irs.ListReqInfo) (irs.SecurityPageInfo, error) {
+	callResult, err := handler.conn.invoke(handler.handler, securityHandler, "ListSecurityPage", []interface{}{listReqInfo}, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).ListSecurityPage(listReqInfo)
+		return err
+	})
+	securityPageInfo, _ := callResult.(irs.SecurityPageInfo)
+	return securityPageInfo, err
+}
+
+func (handler *securityHandlerWrapper) GetSecurity(securityID string) (irs.SecurityInfo, error) {
+	callResult, err := handler.conn.invoke(handler.handler, securityHandler, "GetSecurity", []interface{}{securityID}, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).GetSecurity(securityID)
+		return err
+	})
+	securityInfo, _ := callResult.(irs.SecurityInfo)
+	return securityInfo, err
+}
+
+func (handler *securityHandlerWrapper) DeleteSecurity(securityID string) (bool, error) {
+	callResult, err := handler.conn.invoke(handler.handler, securityHandler, "DeleteSecurity", []interface{}{securityID}, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).DeleteSecurity(securityID)
+		return err
+	})
+	result, _ := callResult.(bool)
+	return result, err
+}
+
+type keyPairHandlerWrapper struct {
+	conn    *connection
+	handler irs.KeyPairHandler
+}
+
+func (handler *keyPairHandlerWrapper) driverHandler(ctx context.Context) irs.KeyPairHandler {
+	if driverHandler, ok := withContext(handler.handler, ctx).(irs.KeyPairHandler); ok {
+		return driverHandler
+	}
+	return handler.handler
+}
+
+func (handler *keyPairHandlerWrapper) CreateKey(keyPairReqInfo irs.KeyPairReqInfo) (irs.KeyPairInfo, error) {
+	callResult, err := handler.conn.invoke(handler.handler, keyPairHandler, "CreateKey", []interface{}{keyPairReqInfo}, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).CreateKey(keyPairReqInfo)
+		return err
+	})
+	keyPairInfo, _ := callResult.(irs.KeyPairInfo)
+	return keyPairInfo, err
+}
+
+func (handler *keyPairHandlerWrapper) ListKey() ([]*irs.KeyPairInfo, error) {
+	callResult, err := handler.conn.invoke(handler.handler, keyPairHandler, "ListKey", nil, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).ListKey()
+		return err
+	})
+	keyPairList, _ := callResult.([]*irs.KeyPairInfo)
+	return keyPairList, err
+}
+
+func (handler *keyPairHandlerWrapper) ListKeyPage(listReqInfo irs.ListReqInfo) (irs.KeyPairPageInfo, error) {
+	callResult, err := handler.conn.invoke(handler.handler, keyPairHandler, "ListKeyPage", []interface{}{listReqInfo}, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).ListKeyPage(listReqInfo)
+		return err
+	})
+	keyPairPageInfo, _ := callResult.(irs.KeyPairPageInfo)
+	return keyPairPageInfo, err
+}
+
+func (handler *keyPairHandlerWrapper) GetKey(keyPairID string) (irs.KeyPairInfo, error) {
+	callResult, err := handler.conn.invoke(handler.handler, keyPairHandler, "GetKey", []interface{}{keyPairID}, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).GetKey(keyPairID)
+		return err
+	})
+	keyPairInfo, _ := callResult.(irs.KeyPairInfo)
+	return keyPairInfo, err
+}
+
+func (handler *keyPairHandlerWrapper) DeleteKey(keyPairID string) (bool, error) {
+	callResult, err := handler.conn.invoke(handler.handler, keyPairHandler, "DeleteKey", []interface{}{keyPairID}, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).DeleteKey(keyPairID)
+		return err
+	})
+	result, _ := callResult.(bool)
+	return result, err
+}
+
+type vNicHandlerWrapper struct {
+	conn    *connection
+	handler irs.VNicHandler
+}
+
+func (handler *vNicHandlerWrapper) driverHandler(ctx context.Context) irs.VNicHandler {
+	if driverHandler, ok := withContext(handler.handler, ctx).(irs.VNicHandler); ok {
+		return driverHandler
+	}
+	return handler.handler
+}
+
+func (handler *vNicHandlerWrapper) CreateVNic(vNicReqInfo irs.VNicReqInfo) (irs.VNicInfo, error) {
+	callResult, err := handler.conn.invoke(handler.handler, vNicHandler, "CreateVNic", []interface{}{vNicReqInfo}, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).CreateVNic(vNicReqInfo)
+		return err
+	})
+	vNicInfo, _ := callResult.(irs.VNicInfo)
+	return vNicInfo, err
+}
+
+func (handler *vNicHandlerWrapper) ListVNic() ([]*irs.VNicInfo, error) {
+	callResult, err := handler.conn.invoke(handler.handler, vNicHandler, "ListVNic", nil, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).ListVNic()
+		return err
+	})
+	vNicList, _ := callResult.([]*irs.VNicInfo)
+	return vNicList, err
+}
+
+func (handler *vNicHandlerWrapper) ListVNicPage(listReqInfo irs.ListReqInfo) (irs.VNicPageInfo, error) {
+	callResult, err := handler.conn.invoke(handler.handler, vNicHandler, "ListVNicPage", []interface{}{listReqInfo}, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).ListVNicPage(listReqInfo)
+		return err
+	})
+	vNicPageInfo, _ := callResult.(irs.VNicPageInfo)
+	return vNicPageInfo, err
+}
+
+func (handler *vNicHandlerWrapper) GetVNic(vNicID string) (irs.VNicInfo, error) {
+	callResult, err := handler.conn.invoke(handler.handler, vNicHandler, "GetVNic", []interface{}{vNicID}, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).GetVNic(vNicID)
+		return err
+	})
+	vNicInfo, _ := callResult.(irs.VNicInfo)
+	return vNicInfo, err
+}
+
+func (handler *vNicHandlerWrapper) DeleteVNic(vNicID string) (bool, error) {
+	callResult, err := handler.conn.invoke(handler.handler, vNicHandler, "DeleteVNic", []interface{}{vNicID}, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).DeleteVNic(vNicID)
+		return err
+	})
+	result, _ := callResult.(bool)
+	return result, err
+}
+
+type publicIPHandlerWrapper struct {
+	conn    *connection
+	handler irs.PublicIPHandler
+}
+
+func (handler *publicIPHandlerWrapper) driverHandler(ctx context.Context) irs.PublicIPHandler {
+	if driverHandler, ok := withContext(handler.handler, ctx).(irs.PublicIPHandler); ok {
+		return driverHandler
+	}
+	return handler.handler
+}
+
+func (handler *publicIPHandlerWrapper) CreatePublicIP(publicIPReqInfo irs.PublicIPReqInfo) (irs.PublicIPInfo, error) {
+	callResult, err := handler.conn.invoke(handler.handler, publicIPHandler, "CreatePublicIP", []interface{}{publicIPReqInfo}, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).CreatePublicIP(publicIPReqInfo)
+		return err
+	})
+	publicIPInfo, _ := callResult.(irs.PublicIPInfo)
+	return publicIPInfo, err
+}
+
+func (handler *publicIPHandlerWrapper) ListPublicIP() ([]*irs.PublicIPInfo, error) {
+	callResult, err := handler.conn.invoke(handler.handler, publicIPHandler, "ListPublicIP", nil, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).ListPublicIP()
+		return err
+	})
+	publicIPList, _ := callResult.([]*irs.PublicIPInfo)
+	return publicIPList, err
+}
+
+func (handler *publicIPHandlerWrapper) ListPublicIPPage(listReqInfo irs.ListReqInfo) (irs.PublicIPPageInfo, error) {
+	callResult, err := handler.conn.invoke(handler.handler, publicIPHandler, "ListPublicIPPage", []interface{}{listReqInfo}, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).ListPublicIPPage(listReqInfo)
+		return err
+	})
+	publicIPPageInfo, _ := callResult.(irs.PublicIPPageInfo)
+	return publicIPPageInfo, err
+}
+
+func (handler *publicIPHandlerWrapper) GetPublicIP(publicIPID string) (irs.PublicIPInfo, error) {
+	callResult, err := handler.conn.invoke(handler.handler, publicIPHandler, "GetPublicIP", []interface{}{publicIPID}, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).GetPublicIP(publicIPID)
+		return err
+	})
+	publicIPInfo, _ := callResult.(irs.PublicIPInfo)
+	return publicIPInfo, err
+}
+
+func (handler *publicIPHandlerWrapper) DeletePublicIP(publicIPID string) (bool, error) {
+	callResult, err := handler.conn.invoke(handler.handler, publicIPHandler, "DeletePublicIP", []interface{}{publicIPID}, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).DeletePublicIP(publicIPID)
+		return err
+	})
+	result, _ := callResult.(bool)
+	return result, err
+}
+
+func (handler *publicIPHandlerWrapper) AssociatePublicIP(publicIPID string, vmID string) (bool, error) {
+	callResult, err := handler.conn.invoke(handler.handler, publicIPHandler, "AssociatePublicIP", []interface{}{publicIPID, vmID}, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).AssociatePublicIP(publicIPID, vmID)
+		return err
+	})
+	result, _ := callResult.(bool)
+	return result, err
+}
+
+func (handler *publicIPHandlerWrapper) DisassociatePublicIP(publicIPID string) (bool, error) {
+	callResult, err := handler.conn.invoke(handler.handler, publicIPHandler, "DisassociatePublicIP", []interface{}{publicIPID}, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).DisassociatePublicIP(publicIPID)
+		return err
+	})
+	result, _ := callResult.(bool)
+	return result, err
+}
+
+type vmHandlerWrapper struct {
+	conn    *connection
+	handler irs.VMHandler
+}
+
+func (handler *vmHandlerWrapper) driverHandler(ctx context.Context) irs.VMHandler {
+	if driverHandler, ok := withContext(handler.handler, ctx).(irs.VMHandler); ok {
+		return driverHandler
+	}
+	return handler.handler
+}
+
+func (handler *vmHandlerWrapper) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, error) {
+	callResult, err := handler.conn.invoke(handler.handler, vmHandler, "StartVM", []interface{}{vmReqInfo}, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).StartVM(vmReqInfo)
+		return err
+	})
+	vmInfo, _ := callResult.(irs.VMInfo)
+	return vmInfo, err
+}
+
+func (handler *vmHandlerWrapper) StartVMs(vmReqInfo irs.VMReqInfo, count int, minCount int) ([]*irs.VMBatchResult, error) {
+	callResult, err := handler.conn.invoke(handler.handler, vmHandler, "StartVMs", []interface{}{vmReqInfo, count, minCount}, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).StartVMs(vmReqInfo, count, minCount)
+		return err
+	})
+	batchResults, _ := callResult.([]*irs.VMBatchResult)
+	return batchResults, err
+}
+
+func (handler *vmHandlerWrapper) SuspendVM(vmID string) {
+	_, err := handler.conn.invoke(handler.handler, vmHandler, "SuspendVM", []interface{}{vmID}, func(call *Call) error {
+		handler.driverHandler(call.Context).SuspendVM(vmID)
+		return nil
+	})
+	logDropped(vmHandler, "SuspendVM", err)
+}
+
+func (handler *vmHandlerWrapper) ResumeVM(vmID string) {
+	_, err := handler.conn.invoke(handler.handler, vmHandler, "ResumeVM", []interface{}{vmID}, func(call *Call) error {
+		handler.driverHandler(call.Context).ResumeVM(vmID)
+		return nil
+	})
+	logDropped(vmHandler, "ResumeVM", err)
+}
+
+func (handler *vmHandlerWrapper) RebootVM(vmID string) {
+	_, err := handler.conn.invoke(handler.handler, vmHandler, "RebootVM", []interface{}{vmID}, func(call *Call) error {
+		handler.driverHandler(call.Context).RebootVM(vmID)
+		return nil
+	})
+	logDropped(vmHandler, "RebootVM", err)
+}
+
+func (handler *vmHandlerWrapper) TerminateVM(vmID string) {
+	_, err := handler.conn.invoke(handler.handler, vmHandler, "TerminateVM", []interface{}{vmID}, func(call *Call) error {
+		handler.driverHandler(call.Context).TerminateVM(vmID)
+		return nil
+	})
+	logDropped(vmHandler, "TerminateVM", err)
+}
+
+func (handler *vmHandlerWrapper) ChangeVMSpec(vmID string, specID string) (irs.VMInfo, error) {
+	callResult, err := handler.conn.invoke(handler.handler, vmHandler, "ChangeVMSpec", []interface{}{vmID, specID}, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).ChangeVMSpec(vmID, specID)
+		return err
+	})
+	vmInfo, _ := callResult.(irs.VMInfo)
+	return vmInfo, err
+}
+
+func (handler *vmHandlerWrapper) ListVMStatus() []*irs.VMStatusInfo {
+	callResult, err := handler.conn.invoke(handler.handler, vmHandler, "ListVMStatus", nil, func(call *Call) error {
+		call.Result = handler.driverHandler(call.Context).ListVMStatus()
+		return nil
+	})
+	logDropped(vmHandler, "ListVMStatus", err)
+	vmStatusList, _ := callResult.([]*irs.VMStatusInfo)
+	return vmStatusList
+}
+
+func (handler *vmHandlerWrapper) GetVMStatus(vmID string) irs.VMStatus {
+	callResult, err := handler.conn.invoke(handler.handler, vmHandler, "GetVMStatus", []interface{}{vmID}, func(call *Call) error {
+		call.Result = handler.driverHandler(call.Context).GetVMStatus(vmID)
+		return nil
+	})
+	logDropped(vmHandler, "GetVMStatus", err)
+	vmStatus, _ := callResult.(irs.VMStatus)
+	return vmStatus
+}
+
+func (handler *vmHandlerWrapper) ListVM() []*irs.VMInfo {
+	callResult, err := handler.conn.invoke(handler.handler, vmHandler, "ListVM", nil, func(call *Call) error {
+		call.Result = handler.driverHandler(call.Context).ListVM()
+		return nil
+	})
+	logDropped(vmHandler, "ListVM", err)
+	vmList, _ := callResult.([]*irs.VMInfo)
+	return vmList
+}
+
+func (handler *vmHandlerWrapper) ListVMPage(listReqInfo irs.ListReqInfo) (irs.VMPageInfo, error) {
+	callResult, err := handler.conn.invoke(handler.handler, vmHandler, "ListVMPage", []interface{}{listReqInfo}, func(call *Call) (err error) {
+		call.Result, err = handler.driverHandler(call.Context).ListVMPage(listReqInfo)
+		return err
+	})
+	vmPageInfo, _ := callResult.(irs.VMPageInfo)
+	return vmPageInfo, err
+}
+
+func (handler *vmHandlerWrapper) GetVM(vmID string) irs.VMInfo {
+	callResult, err := handler.conn.invoke(handler.handler, vmHandler, "GetVM", []interface{}{vmID}, func(call *Call) error {
+		call.Result = handler.driverHandler(call.Context).GetVM(vmID)
+		return nil
+	})
+	logDropped(vmHandler, "GetVM", err)
+	vmInfo, _ := callResult.(irs.VMInfo)
+	return vmInfo
+}
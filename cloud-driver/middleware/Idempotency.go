@@ -0,0 +1,304 @@
+// Cloud Driver Middleware of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the idempotency of create calls.
+// A create call retried with the idempotency key of the first call returns the resource of the first call, instead of creating another one.
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package middleware
+
+import (
+	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// MaxIdempotencyKeyLength is the max length of idempotency keys.
+const MaxIdempotencyKeyLength = 255
+
+type idempotencyKeyKey struct{}
+
+// WithIdempotencyKey returns ctx whose create calls are idempotent by key, ex) the Idempotency-Key header of the REST API.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyKey{}, key)
+}
+
+// IdempotencyKeyFrom returns the idempotency key of ctx, "" if none.
+func IdempotencyKeyFrom(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	key, _ := ctx.Value(idempotencyKeyKey{}).(string)
+	return key
+}
+
+// Idempotency makes create calls(Create*, StartVM, StartVMs) with an idempotency key idempotent.
+//   - The call gets a client token derived from the key, the connection, the method and the name of the resource.
+//     Drivers of CSPs with client tokens pass it to the CSP(idrv.ClientTokenFrom), ex) EC2 RunInstances ClientToken.
+//   - The token and the created resources are recorded in the store. A retried call returns the resources by the Get method
+//     of the handler. Secrets returned only by the first call(ex. the private key of CreateKey) are not returned again.
+//   - If the outcome of the first call is unknown(ex. timeout), the retried call looks up the resource by name before creating it.
+//
+// It should be outside of the Auditor, so a returned resource is not recorded as created again.
+type Idempotency struct {
+	mutex    sync.Mutex
+	store    IdempotencyStore
+	inFlight map[string]bool // tokens of the calls in progress
+}
+
+// NewIdempotency returns an Idempotency with store, nil: a memory store.
+func NewIdempotency(store IdempotencyStore) *Idempotency {
+	if store == nil {
+		store, _ = NewFileIdempotencyStore("", DefaultIdempotencyTTL)
+	}
+	return &Idempotency{store: store, inFlight: map[string]bool{}}
+}
+
+// SetStore replaces the store, ex) with a FileIdempotencyStore at start.
+func (idempotency *Idempotency) SetStore(store IdempotencyStore) {
+	idempotency.mutex.Lock()
+	defer idempotency.mutex.Unlock()
+	idempotency.store = store
+}
+
+// Intercept is the Interceptor of the Idempotency.
+func (idempotency *Idempotency) Intercept(call *Call, next func() error) error {
+	key := IdempotencyKeyFrom(call.Context)
+	if key == "" || !call.Creates() || DryRunPlanFrom(call.Context) != nil {
+		return next()
+	}
+	if len(key) > MaxIdempotencyKeyLength {
+		return irs.InvalidArgument("idempotency key is longer than %d", MaxIdempotencyKeyLength)
+	}
+
+	record := &IdempotencyRecord{Key: key, ConnectionName: call.ConnectionName, Handler: call.Handler, Method: call.Method,
+		Name: requestName(call.Args[0]), State: IdempotencyPending, Time: time.Now().UTC()}
+	record.Token = clientToken(record)
+	record.RequestHash = requestHash(call.Args)
+
+	store, err := idempotency.begin(record.Token)
+	if err != nil {
+		return err
+	}
+	defer idempotency.end(record.Token)
+
+	stored, err := store.Get(record.Token)
+	if err != nil {
+		return err
+	}
+	if stored != nil {
+		if stored.RequestHash != record.RequestHash {
+			return irs.InvalidArgument("idempotency key %s is already used with other arguments", key)
+		}
+		if stored.State == IdempotencyDone {
+			cblogger.Infof("%s.%s: returns the resources of idempotency key %s", call.Handler, call.Method, key)
+			return replay(call, stored.ResourceIDs)
+		}
+		// 이전 호출의 결과를 알 수 없으므로(ex. timeout) 이름으로 생성된 자원을 찾음.
+		if ids := lookupByName(call, record.Name); len(ids) > 0 {
+			cblogger.Infof("%s.%s: found %v created by idempotency key %s", call.Handler, call.Method, ids, key)
+			record.State, record.ResourceIDs = IdempotencyDone, ids
+			if err := store.Put(record); err != nil {
+				return err
+			}
+			return replay(call, ids)
+		}
+	}
+
+	if err := store.Put(record); err != nil {
+		return err
+	}
+	call.Context = idrv.WithClientToken(call.Context, record.Token)
+	err = next()
+	if err != nil {
+		// 생성 여부를 알 수 없는 에러는 Pending으로 남겨, 재시도 시 이름으로 찾음.
+		if !outcomeUnknown(err) {
+			if deleteErr := store.Delete(record.Token); deleteErr != nil {
+				cblogger.Error(deleteErr)
+			}
+		}
+		return err
+	}
+
+	record.State, record.ResourceIDs = IdempotencyDone, createdIDs(call.Result)
+	if err := store.Put(record); err != nil {
+		// 자원은 생성 되었으므로 호출은 성공, 재시도 시에는 이름으로 찾음.
+		cblogger.Error(err)
+	}
+	return nil
+}
+
+// begin marks token in progress. A concurrent call with the same token is Unavailable, to be retried later.
+func (idempotency *Idempotency) begin(token string) (IdempotencyStore, error) {
+	idempotency.mutex.Lock()
+	defer idempotency.mutex.Unlock()
+	if idempotency.inFlight[token] {
+		return nil, irs.NewCloudError(irs.UnavailableError, "a call with the same idempotency key is in progress")
+	}
+	idempotency.inFlight[token] = true
+	return idempotency.store, nil
+}
+
+func (idempotency *Idempotency) end(token string) {
+	idempotency.mutex.Lock()
+	defer idempotency.mutex.Unlock()
+	delete(idempotency.inFlight, token)
+}
+
+// clientToken is the hex SHA-256 of the key and the call, 64 characters for the CSP limits, ex) EC2 ClientToken.
+func clientToken(record *IdempotencyRecord) string {
+	hash := sha256.Sum256([]byte(record.Key + "\n" + record.ConnectionName + "\n" + record.Handler + "." + record.Method + "\n" + record.Name))
+	return hex.EncodeToString(hash[:])
+}
+
+func requestHash(args []interface{}) string {
+	data, err := json.Marshal(args)
+	if err != nil {
+		return ""
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+// requestName returns the Name of a {Resource}ReqInfo, or the GroupName of a SecurityReqInfo(AWS).
+func requestName(reqInfo interface{}) string {
+	value := reflect.ValueOf(reqInfo)
+	if value.Kind() != reflect.Struct {
+		return ""
+	}
+	for _, field := range []string{"Name", "GroupName"} {
+		if name := value.FieldByName(field); name.IsValid() && name.Kind() == reflect.String && name.String() != "" {
+			return name.String()
+		}
+	}
+	return ""
+}
+
+func outcomeUnknown(err error) bool {
+	switch irs.ErrorCodeOf(err) {
+	case irs.TimeoutError, irs.UnavailableError, irs.UnknownError:
+		return true
+	}
+	return false
+}
+
+// createdIDs returns the IDs of the created resources, without VMs rolled back by StartVMs.
+func createdIDs(result interface{}) []string {
+	if batchResults, ok := result.([]*irs.VMBatchResult); ok {
+		var ids []string
+		for _, batchResult := range batchResults {
+			if batchResult != nil && batchResult.Error == nil && !batchResult.RolledBack && batchResult.VMInfo.Id != "" {
+				ids = append(ids, batchResult.VMInfo.Id)
+			}
+		}
+		return ids
+	}
+	value := reflect.ValueOf(result)
+	if value.Kind() == reflect.Struct {
+		if id := value.FieldByName("Id"); id.IsValid() && id.Kind() == reflect.String && id.String() != "" {
+			return []string{id.String()}
+		}
+	}
+	return nil
+}
+
+// replay sets call.Result to the resources of ids by the Get method of the handler.
+func replay(call *Call, ids []string) error {
+	driverHandler := driverHandlerOf(call)
+	if call.Method == "StartVMs" {
+		var batchResults []*irs.VMBatchResult
+		for _, id := range ids {
+			current, err := getTarget(driverHandler, call.Handler, id)
+			if err != nil {
+				return err
+			}
+			vmInfo, _ := current.(irs.VMInfo)
+			batchResults = append(batchResults, &irs.VMBatchResult{Name: vmInfo.Name, VMInfo: vmInfo})
+		}
+		call.Result = batchResults
+		return nil
+	}
+
+	if len(ids) == 0 {
+		return irs.NotFound("%s.%s: no resource is recorded for the idempotency key", call.Handler, call.Method)
+	}
+	current, err := getTarget(driverHandler, call.Handler, ids[0])
+	if err != nil {
+		return err
+	}
+	call.Result = current
+	return nil
+}
+
+// List method of each handler, to look up resources by name.
+var listMethods = map[string]string{
+	imageHandler:    "ListImage",
+	vNetworkHandler: "ListVNetwork",
+	securityHandler: "ListSecurity",
+	keyPairHandler:  "ListKey",
+	vNicHandler:     "ListVNic",
+	publicIPHandler: "ListPublicIP",
+	vmHandler:       "ListVM",
+}
+
+// lookupByName returns the IDs of the resources named name, for StartVMs the IDs of all VMs of the batch or none.
+func lookupByName(call *Call, name string) []string {
+	if name == "" {
+		return nil
+	}
+	names := []string{name}
+	if call.Method == "StartVMs" {
+		names = nil
+		for i := 0; i < call.Args[1].(int); i++ {
+			names = append(names, irs.BatchVMName(name, i))
+		}
+	}
+
+	method := reflect.ValueOf(driverHandlerOf(call)).MethodByName(listMethods[call.Handler])
+	if !method.IsValid() {
+		return nil
+	}
+	// ListImage(imageFilterInfo) 등의 인자는 zero value로 호출 함.
+	var in []reflect.Value
+	for i := 0; i < method.Type().NumIn(); i++ {
+		in = append(in, reflect.Zero(method.Type().In(i)))
+	}
+	results := method.Call(in)
+	if len(results) > 1 && !results[1].IsNil() {
+		cblogger.Errorf("%s.%s: %v", call.Handler, listMethods[call.Handler], results[1].Interface())
+		return nil
+	}
+
+	idsByName := map[string]string{}
+	list := results[0]
+	for i := 0; i < list.Len(); i++ {
+		info := reflect.Indirect(list.Index(i))
+		if !info.IsValid() {
+			continue
+		}
+		if id := info.FieldByName("Id"); id.IsValid() && id.Kind() == reflect.String && id.String() != "" {
+			idsByName[requestName(info.Interface())] = id.String()
+		}
+	}
+
+	var ids []string
+	for _, name := range names {
+		id, ok := idsByName[name]
+		if !ok {
+			return nil
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
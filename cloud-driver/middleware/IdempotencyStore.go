@@ -0,0 +1,151 @@
+// Cloud Driver Middleware of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the local token store of idempotent create calls, a JSON file with expiration.
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package middleware
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// States of idempotency records.
+const (
+	IdempotencyPending = "Pending" // the call is in progress, or its outcome is unknown(ex. timeout)
+	IdempotencyDone    = "Done"
+)
+
+// IdempotencyRecord is a create call with an idempotency key, and the resources it created.
+type IdempotencyRecord struct {
+	Token          string // client token of the call, see clientToken()
+	Key            string // idempotency key of the API request
+	ConnectionName string
+	Handler        string
+	Method         string
+	Name           string // name of the resource to create
+	RequestHash    string // hash of the arguments, the key can not be reused with other arguments
+	State          string
+	ResourceIDs    []string `json:",omitempty"`
+	Time           time.Time
+}
+
+// IdempotencyStore stores the records of create calls by token, ex) FileIdempotencyStore.
+type IdempotencyStore interface {
+	Get(token string) (*IdempotencyRecord, error) // nil, nil if none
+	Put(record *IdempotencyRecord) error
+	Delete(token string) error
+}
+
+// DefaultIdempotencyTTL is how long a record is kept, retries of a request with the same key are expected within it.
+const DefaultIdempotencyTTL = 24 * time.Hour
+
+// FileIdempotencyStore keeps records in memory, and in a JSON file if it has a path, so they survive restarts of the server.
+// Records older than TTL are expired.
+type FileIdempotencyStore struct {
+	mutex   sync.Mutex
+	path    string // "": memory only
+	ttl     time.Duration
+	records map[string]*IdempotencyRecord
+}
+
+// NewFileIdempotencyStore loads the records of path. The file and its directory are created for the owner only.
+func NewFileIdempotencyStore(path string, ttl time.Duration) (*FileIdempotencyStore, error) {
+	store := &FileIdempotencyStore{path: path, ttl: ttl, records: map[string]*IdempotencyRecord{}}
+	if path == "" {
+		return store, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var records []*IdempotencyRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, err
+	}
+	for _, record := range records {
+		store.records[record.Token] = record
+	}
+	store.expire()
+	return store, nil
+}
+
+func (store *FileIdempotencyStore) Get(token string) (*IdempotencyRecord, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	record, ok := store.records[token]
+	if !ok || store.expired(record) {
+		return nil, nil
+	}
+	copied := *record
+	return &copied, nil
+}
+
+func (store *FileIdempotencyStore) Put(record *IdempotencyRecord) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	copied := *record
+	store.records[record.Token] = &copied
+	store.expire()
+	return store.save()
+}
+
+func (store *FileIdempotencyStore) Delete(token string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	if _, ok := store.records[token]; !ok {
+		return nil
+	}
+	delete(store.records, token)
+	return store.save()
+}
+
+func (store *FileIdempotencyStore) expired(record *IdempotencyRecord) bool {
+	return store.ttl > 0 && time.Since(record.Time) > store.ttl
+}
+
+func (store *FileIdempotencyStore) expire() {
+	for token, record := range store.records {
+		if store.expired(record) {
+			delete(store.records, token)
+		}
+	}
+}
+
+// save writes a temporary file and renames it, so the file is never partially written.
+func (store *FileIdempotencyStore) save() error {
+	if store.path == "" {
+		return nil
+	}
+	records := make([]*IdempotencyRecord, 0, len(store.records))
+	for _, record := range store.records {
+		records = append(records, record)
+	}
+	data, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+	tmpPath := store.path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, store.path)
+}
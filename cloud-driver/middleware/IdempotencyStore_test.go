@@ -0,0 +1,156 @@
+// Cloud Driver Middleware of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the test of the token store of idempotent create calls.
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package middleware
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func testIdempotencyRecord(key string, name string) *IdempotencyRecord {
+	record := &IdempotencyRecord{Key: key, ConnectionName: "aws-seoul", Handler: vmHandler, Method: "StartVM", Name: name,
+		State: IdempotencyPending, Time: time.Now().UTC()}
+	record.Token = clientToken(record)
+	return record
+}
+
+func TestFileIdempotencyStore(t *testing.T) {
+	store, err := NewFileIdempotencyStore("", DefaultIdempotencyTTL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	record := testIdempotencyRecord("key-1", "vm-1")
+	if got, err := store.Get(record.Token); got != nil || err != nil {
+		t.Fatalf("Get of an empty store: %v, %v", got, err)
+	}
+	if err := store.Put(record); err != nil {
+		t.Fatal(err)
+	}
+
+	// 저장된 레코드는 복사본 임.
+	record.State = IdempotencyDone
+	got, err := store.Get(record.Token)
+	if err != nil || got == nil || got.State != IdempotencyPending {
+		t.Fatalf("Get: %+v, %v", got, err)
+	}
+	got.ResourceIDs = []string{"i-1"}
+	if again, _ := store.Get(record.Token); len(again.ResourceIDs) != 0 {
+		t.Errorf("the record in the store is modified by the caller: %+v", again)
+	}
+
+	if err := store.Delete(record.Token); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := store.Get(record.Token); got != nil {
+		t.Errorf("Get after Delete: %+v", got)
+	}
+	if err := store.Delete(record.Token); err != nil {
+		t.Errorf("Delete of a deleted record: %v", err)
+	}
+}
+
+func TestFileIdempotencyStoreExpire(t *testing.T) {
+	store, _ := NewFileIdempotencyStore("", time.Hour)
+
+	old := testIdempotencyRecord("key-1", "vm-1")
+	old.Time = time.Now().Add(-2 * time.Hour)
+	store.Put(old)
+	if got, _ := store.Get(old.Token); got != nil {
+		t.Errorf("expired record: %+v", got)
+	}
+
+	recent := testIdempotencyRecord("key-2", "vm-2")
+	store.Put(recent)
+	if got, _ := store.Get(recent.Token); got == nil {
+		t.Errorf("record within TTL is expired")
+	}
+	if _, ok := store.records[old.Token]; ok {
+		t.Errorf("expired record is not removed by Put")
+	}
+}
+
+func TestFileIdempotencyStoreFile(t *testing.T) {
+	dir, err := os.MkdirTemp("", "idempotency-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "config", "idempotency.json")
+
+	store, err := NewFileIdempotencyStore(path, DefaultIdempotencyTTL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := testIdempotencyRecord("key-1", "vm-1")
+	done.State, done.ResourceIDs = IdempotencyDone, []string{"i-1"}
+	pending := testIdempotencyRecord("key-2", "vm-2")
+	expired := testIdempotencyRecord("key-3", "vm-3")
+	expired.Time = time.Now().Add(-2 * time.Hour)
+	for _, record := range []*IdempotencyRecord{done, pending, expired} {
+		if err := store.Put(record); err != nil {
+			t.Fatal(err)
+		}
+	}
+	store.Delete(pending.Token)
+
+	for name, mode := range map[string]os.FileMode{path: 0600, filepath.Dir(path): 0700 | os.ModeDir} {
+		if info, err := os.Stat(name); err != nil {
+			t.Error(err)
+		} else if info.Mode() != mode {
+			t.Errorf("%s: %v, want %v", name, info.Mode(), mode)
+		}
+	}
+	if _, err := os.Stat(path + ".tmp"); !os.IsNotExist(err) {
+		t.Errorf("temporary file is left: %v", err)
+	}
+
+	// 재시작 후에도 유지 됨, TTL이 지난 레코드는 로드 시 제거 됨.
+	reloaded, err := NewFileIdempotencyStore(path, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, _ := reloaded.Get(done.Token)
+	if got == nil || got.State != IdempotencyDone || !reflect.DeepEqual(got.ResourceIDs, done.ResourceIDs) {
+		t.Errorf("reloaded record: %+v", got)
+	}
+	if got, _ := reloaded.Get(pending.Token); got != nil {
+		t.Errorf("deleted record is reloaded: %+v", got)
+	}
+	if _, ok := reloaded.records[expired.Token]; ok {
+		t.Errorf("expired record is loaded")
+	}
+
+	if err := os.WriteFile(path, []byte("{"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewFileIdempotencyStore(path, time.Hour); err == nil {
+		t.Errorf("a broken file is loaded")
+	}
+}
+
+func TestClientToken(t *testing.T) {
+	record := testIdempotencyRecord("key-1", "vm-1")
+	if len(record.Token) != 64 {
+		t.Errorf("client token %q: %d characters, want 64", record.Token, len(record.Token))
+	}
+	if token := testIdempotencyRecord("key-1", "vm-1").Token; token != record.Token {
+		t.Errorf("client token of the same call: %s, %s", token, record.Token)
+	}
+	for _, other := range []*IdempotencyRecord{testIdempotencyRecord("key-2", "vm-1"), testIdempotencyRecord("key-1", "vm-2")} {
+		if other.Token == record.Token {
+			t.Errorf("the same client token of other calls: %+v", other)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+// Cloud Driver Middleware of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the Prometheus metrics of handler calls.
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package middleware
+
+import (
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"time"
+)
+
+const metricsNamespace = "cbspider"
+
+// result label of a successful call. A failed call has the irs.ErrorCode of its error, ex) "Throttled"
+const resultSuccess = "success"
+
+// CSP 호출은 수십 ms(조회)부터 수 분(VM 생성 대기)까지 걸림.
+var DefaultLatencyBuckets = []float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60, 120, 300}
+
+// Metrics counts handler calls by driver, connection, handler, operation and result.
+//   - cbspider_driver_requests_total{driver, connection, handler, operation, result}
+//   - cbspider_driver_request_duration_seconds{driver, connection, handler, operation}
+//   - cbspider_driver_requests_in_flight{driver, connection, handler, operation}
+type Metrics struct {
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	inFlight *prometheus.GaugeVec
+}
+
+// NewMetrics registers the metrics to registerer, ex) prometheus.DefaultRegisterer
+func NewMetrics(registerer prometheus.Registerer, buckets []float64) *Metrics {
+	labels := []string{"driver", "connection", "handler", "operation"}
+
+	metrics := &Metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "driver",
+			Name:      "requests_total",
+			Help:      "Number of CSP calls of driver handlers.",
+		}, append(labels, "result")),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "driver",
+			Name:      "request_duration_seconds",
+			Help:      "Latency of CSP calls of driver handlers.",
+			Buckets:   buckets,
+		}, labels),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "driver",
+			Name:      "requests_in_flight",
+			Help:      "Number of CSP calls of driver handlers in progress.",
+		}, labels),
+	}
+	registerer.MustRegister(metrics.requests, metrics.duration, metrics.inFlight)
+	return metrics
+}
+
+// Intercept is the Interceptor of the Metrics.
+// To measure the CSP, not the waiting of Retry and RateLimiter, it should be the innermost one.
+// A call rejected by an open circuit and calls in a dry run are not counted.
+func (metrics *Metrics) Intercept(call *Call, next func() error) error {
+	if call.InDryRun() {
+		return next()
+	}
+	inFlight := metrics.inFlight.WithLabelValues(call.DriverName, call.ConnectionName, call.Handler, call.Method)
+	inFlight.Inc()
+	defer inFlight.Dec()
+
+	start := time.Now()
+	err := next()
+	metrics.duration.WithLabelValues(call.DriverName, call.ConnectionName, call.Handler, call.Method).Observe(time.Since(start).Seconds())
+
+	result := resultSuccess
+	if err != nil {
+		result = string(irs.ErrorCodeOf(err))
+	}
+	metrics.requests.WithLabelValues(call.DriverName, call.ConnectionName, call.Handler, call.Method, result).Inc()
+	return err
+}
@@ -0,0 +1,202 @@
+// Cloud Driver Middleware of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the middleware of handler calls.
+// WrapConnection() decorates the handlers of any CloudConnection, so every driver gets
+// retry, rate limit, circuit breaker, metrics, tracing, ... without reimplementation. The driver manager applies it in GetCloudConnection().
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package middleware
+
+import (
+	cblog "github.com/cloud-barista/cb-log"
+	idrv "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces"
+	icon "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/connect"
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+	"github.com/cloud-barista/poc-cb-spider/cloud-driver/logger"
+	"github.com/sirupsen/logrus"
+
+	"context"
+	"strings"
+)
+
+var cblogger *logrus.Logger
+
+func init() {
+	cblogger = cblog.GetLogger("CB-Middleware")
+}
+
+// Target is the connection config of a wrapped connection.
+type Target struct {
+	DriverName     string
+	ConnectionName string
+	Region         string // region of the CSP, ex) "ap-northeast-2"
+}
+
+// Call is a handler method call, ex) {{"aws-driver01", "aws-seoul", "ap-northeast-2"}, "VMHandler", "StartVM", [VMReqInfo]}
+type Call struct {
+	Target
+	Handler string
+	Method  string
+	Args    []interface{}
+
+	Conn icon.CloudConnection // connection of the driver, ex) CircuitBreaker probes Conn.IsConnected()
+
+	// DriverHandler is the handler of the driver, ex) DryRun calls its idrv.DryRunHandler. Use it by driverHandlerOf(call).
+	DriverHandler interface{}
+
+	// Context is passed to driver handlers that implement idrv.ContextHandler.
+	// An Interceptor can replace it before calling next, ex) Tracing sets the span of the call.
+	Context context.Context
+
+	// Result is the first result of the driver handler after next returned, ex) irs.VMInfo of StartVM, nil: no result
+	// An Interceptor that does not call next can set it, ex) Idempotency returns the resource of the first call.
+	Result interface{}
+}
+
+// ReadOnly reports whether the method only reads CSP resources(List*, Get*).
+func (call *Call) ReadOnly() bool {
+	return strings.HasPrefix(call.Method, "List") || strings.HasPrefix(call.Method, "Get")
+}
+
+// Creates reports whether the method creates CSP resources(Create*, StartVM, StartVMs).
+func (call *Call) Creates() bool {
+	return strings.HasPrefix(call.Method, "Create") || strings.HasPrefix(call.Method, "Start")
+}
+
+// Interceptor runs around a call. It calls next to continue the call, zero or more times.
+type Interceptor func(call *Call, next func() error) error
+
+const (
+	imageHandler    = "ImageHandler"
+	vNetworkHandler = "VNetworkHandler"
+	securityHandler = "SecurityHandler"
+	keyPairHandler  = "KeyPairHandler"
+	vNicHandler     = "VNicHandler"
+	publicIPHandler = "PublicIPHandler"
+	vmHandler       = "VMHandler"
+)
+
+// Methods without an error result(ex. VMHandler.SuspendVM) can not report an error of an Interceptor,
+// so a rejected call of them is dropped and logged.
+func logDropped(handler string, method string, err error) {
+	if err != nil {
+		cblogger.Errorf("%s.%s is not called: %v", handler, method, err)
+	}
+}
+
+// withContext returns the handler of ctx if the driver handler implements idrv.ContextHandler, or nil.
+func withContext(handler interface{}, ctx context.Context) interface{} {
+	if contextHandler, ok := handler.(idrv.ContextHandler); ok {
+		return contextHandler.WithContext(ctx)
+	}
+	return nil
+}
+
+// driverHandlerOf returns the driver handler of the call with call.Context, ex) to get the target of the call.
+func driverHandlerOf(call *Call) interface{} {
+	if contextHandler := withContext(call.DriverHandler, call.Context); contextHandler != nil {
+		return contextHandler
+	}
+	return call.DriverHandler
+}
+
+type connection struct {
+	icon.CloudConnection
+	target       Target
+	ctx          context.Context
+	interceptors []Interceptor // outermost first
+}
+
+// WrapConnection returns conn whose handlers call interceptors in order, the first one outermost.
+// ctx is the Context of every Call, ex) the span of an API request. IsConnected() and Close() are not intercepted.
+func WrapConnection(ctx context.Context, conn icon.CloudConnection, target Target, interceptors ...Interceptor) icon.CloudConnection {
+	if len(interceptors) == 0 {
+		return conn
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return &connection{CloudConnection: conn, target: target, ctx: ctx, interceptors: interceptors}
+}
+
+// invoke runs the interceptors, and returns Call.Result. call calls the driver handler with call.Context, and sets call.Result.
+// call.Context has the log fields of the call, so drivers log them with logger.WithContext().
+func (conn *connection) invoke(driverHandler interface{}, handler string, method string, args []interface{}, call func(call *Call) error) (interface{}, error) {
+	ctx := logger.WithFields(conn.ctx, logger.Fields{
+		logger.ConnectionField: conn.target.ConnectionName,
+		logger.OperationField:  handler + "." + method,
+	})
+	c := &Call{Target: conn.target, Handler: handler, Method: method, Args: args, Conn: conn.CloudConnection, DriverHandler: driverHandler, Context: ctx}
+	err := conn.next(c, 0, call)
+	return c.Result, err
+}
+
+func (conn *connection) next(c *Call, idx int, call func(call *Call) error) error {
+	if idx == len(conn.interceptors) {
+		return call(c)
+	}
+	return conn.interceptors[idx](c, func() error {
+		return conn.next(c, idx+1, call)
+	})
+}
+
+func (conn *connection) CreateImageHandler() (irs.ImageHandler, error) {
+	handler, err := conn.CloudConnection.CreateImageHandler()
+	if err != nil || handler == nil {
+		return handler, err
+	}
+	return &imageHandlerWrapper{conn, handler}, nil
+}
+
+func (conn *connection) CreateVNetworkHandler() (irs.VNetworkHandler, error) {
+	handler, err := conn.CloudConnection.CreateVNetworkHandler()
+	if err != nil || handler == nil {
+		return handler, err
+	}
+	return &vNetworkHandlerWrapper{conn, handler}, nil
+}
+
+func (conn *connection) CreateSecurityHandler() (irs.SecurityHandler, error) {
+	handler, err := conn.CloudConnection.CreateSecurityHandler()
+	if err != nil || handler == nil {
+		return handler, err
+	}
+	return &securityHandlerWrapper{conn, handler}, nil
+}
+
+func (conn *connection) CreateKeyPairHandler() (irs.KeyPairHandler, error) {
+	handler, err := conn.CloudConnection.CreateKeyPairHandler()
+	if err != nil || handler == nil {
+		return handler, err
+	}
+	return &keyPairHandlerWrapper{conn, handler}, nil
+}
+
+func (conn *connection) CreateVNicHandler() (irs.VNicHandler, error) {
+	handler, err := conn.CloudConnection.CreateVNicHandler()
+	if err != nil || handler == nil {
+		return handler, err
+	}
+	return &vNicHandlerWrapper{conn, handler}, nil
+}
+
+func (conn *connection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
+	handler, err := conn.CloudConnection.CreatePublicIPHandler()
+	if err != nil || handler == nil {
+		return handler, err
+	}
+	return &publicIPHandlerWrapper{conn, handler}, nil
+}
+
+func (conn *connection) CreateVMHandler() (irs.VMHandler, error) {
+	handler, err := conn.CloudConnection.CreateVMHandler()
+	if err != nil || handler == nil {
+		return handler, err
+	}
+	return &vmHandlerWrapper{conn, handler}, nil
+}
@@ -0,0 +1,140 @@
+// Cloud Driver Middleware of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the per-connection token bucket rate limit of handler calls.
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package middleware
+
+import (
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+
+	"context"
+	"sync"
+	"time"
+)
+
+type RateLimitConfig struct {
+	Rate    float64       // calls per second of a connection, <= 0: unlimited
+	Burst   int           // calls allowed at once, < 1: 1
+	MaxWait time.Duration // max wait for a token, a call which would wait longer fails with ThrottledError, <= 0: DefaultRateLimitMaxWait
+}
+
+// DefaultRateLimitMaxWait bounds the calls waiting for tokens of a connection to MaxWait * Rate.
+const DefaultRateLimitMaxWait = time.Minute
+
+var DefaultRateLimitConfig = RateLimitConfig{}
+
+// RateLimiter keeps a token bucket per connection name, shared by all handlers of the connection.
+type RateLimiter struct {
+	mutex   sync.Mutex
+	config  RateLimitConfig
+	buckets map[string]*tokenBucket
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+func NewRateLimiter(config RateLimitConfig) *RateLimiter {
+	return &RateLimiter{config: config, buckets: map[string]*tokenBucket{}}
+}
+
+// SetConfig changes the rate limit. Buckets are refilled with the new Burst.
+func (limiter *RateLimiter) SetConfig(config RateLimitConfig) {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	limiter.config = config
+	limiter.buckets = map[string]*tokenBucket{}
+}
+
+// Intercept is the Interceptor of the RateLimiter. It waits for a token of the connection until call.Context is done.
+// Every attempt of Retry takes a token, so Retry should be before RateLimiter.
+func (limiter *RateLimiter) Intercept(call *Call, next func() error) error {
+	wait, err := limiter.reserve(call.ConnectionName)
+	if err != nil {
+		return err
+	}
+	if err := sleep(call.Context, wait); err != nil {
+		// 사용하지 않은 토큰을 반환 함.
+		limiter.cancel(call.ConnectionName)
+		return err
+	}
+	return next()
+}
+
+// reserve takes a token and returns the time to wait for it. A token can be borrowed from the future,
+// so waiting callers are served in order. A call which would wait longer than MaxWait fails without a token.
+func (limiter *RateLimiter) reserve(connectionName string) (time.Duration, error) {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	config := limiter.config
+	if config.Rate <= 0 {
+		return 0, nil
+	}
+	burst := float64(config.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+	maxWait := config.MaxWait
+	if maxWait <= 0 {
+		maxWait = DefaultRateLimitMaxWait
+	}
+
+	now := time.Now()
+	bucket, ok := limiter.buckets[connectionName]
+	if !ok {
+		bucket = &tokenBucket{tokens: burst, last: now}
+		limiter.buckets[connectionName] = bucket
+	}
+
+	bucket.tokens += now.Sub(bucket.last).Seconds() * config.Rate
+	if bucket.tokens > burst {
+		bucket.tokens = burst
+	}
+	bucket.last = now
+
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return 0, nil
+	}
+	wait := time.Duration((1 - bucket.tokens) / config.Rate * float64(time.Second))
+	if wait > maxWait {
+		return 0, irs.NewCloudError(irs.ThrottledError, "%s: rate limit %v calls/s, %v to wait for a call exceeds %v",
+			connectionName, config.Rate, wait, maxWait)
+	}
+	bucket.tokens--
+	return wait, nil
+}
+
+// cancel returns the token of a call cancelled while waiting.
+func (limiter *RateLimiter) cancel(connectionName string) {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	if bucket, ok := limiter.buckets[connectionName]; ok {
+		bucket.tokens++
+	}
+}
+
+// sleep waits for d, or returns ctx.Err() when ctx is done before.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,103 @@
+// Cloud Driver Middleware of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the test of the rate limit of handler calls.
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package middleware
+
+import (
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRateLimitReserve(t *testing.T) {
+	limiter := NewRateLimiter(RateLimitConfig{Rate: 10, Burst: 2, MaxWait: 250 * time.Millisecond})
+
+	// Burst: 바로 호출, 이후 100ms 간격으로 대기.
+	wants := []time.Duration{0, 0, 100 * time.Millisecond, 200 * time.Millisecond}
+	for i, want := range wants {
+		wait, err := limiter.reserve("aws-seoul")
+		if err != nil || wait < want-10*time.Millisecond || wait > want {
+			t.Fatalf("call %d: wait %v, err %v, want %v", i, wait, err, want)
+		}
+	}
+
+	// 300ms > MaxWait: 토큰 없이 실패 함.
+	if _, err := limiter.reserve("aws-seoul"); irs.ErrorCodeOf(err) != irs.ThrottledError {
+		t.Errorf("over MaxWait: %v, want Throttled", err)
+	}
+	if wait, err := limiter.reserve("aws-seoul"); irs.ErrorCodeOf(err) != irs.ThrottledError {
+		t.Errorf("the failed call took a token: wait %v, err %v", wait, err)
+	}
+
+	// 버킷은 connection 별 임.
+	if wait, err := limiter.reserve("gcp-seoul"); wait != 0 || err != nil {
+		t.Errorf("other connection: wait %v, err %v", wait, err)
+	}
+}
+
+func TestRateLimitDefaultMaxWait(t *testing.T) {
+	limiter := NewRateLimiter(RateLimitConfig{Rate: 1, Burst: 1})
+	for i := 0; ; i++ {
+		wait, err := limiter.reserve("aws-seoul")
+		if err != nil {
+			if irs.ErrorCodeOf(err) != irs.ThrottledError || wait != 0 {
+				t.Fatalf("call %d: wait %v, err %v", i, wait, err)
+			}
+			if i < int(DefaultRateLimitMaxWait/time.Second) {
+				t.Errorf("failed after %d calls, want %d", i, int(DefaultRateLimitMaxWait/time.Second))
+			}
+			return
+		}
+		if wait > DefaultRateLimitMaxWait {
+			t.Fatalf("call %d: wait %v over %v", i, wait, DefaultRateLimitMaxWait)
+		}
+	}
+}
+
+func TestRateLimitUnlimited(t *testing.T) {
+	limiter := NewRateLimiter(RateLimitConfig{})
+	for i := 0; i < 1000; i++ {
+		if wait, err := limiter.reserve("aws-seoul"); wait != 0 || err != nil {
+			t.Fatalf("call %d: wait %v, err %v", i, wait, err)
+		}
+	}
+}
+
+func TestRateLimitCancel(t *testing.T) {
+	limiter := NewRateLimiter(RateLimitConfig{Rate: 0.1, Burst: 1})
+	next := func() error { return nil }
+
+	if err := limiter.Intercept(testCall("GetVM"), next); err != nil {
+		t.Fatal(err)
+	}
+
+	// 다음 토큰은 10초 후, 취소되면 대기하지 않고 토큰을 반환 함.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	call := testCall("GetVM")
+	call.Context = ctx
+	called := false
+	start := time.Now()
+	err := limiter.Intercept(call, func() error { called = true; return nil })
+	if !errors.Is(err, context.DeadlineExceeded) || called {
+		t.Errorf("err %v, called %v, want context.DeadlineExceeded without the call", err, called)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("waited %v after the cancel", elapsed)
+	}
+
+	wait, err := limiter.reserve("aws-seoul")
+	if err != nil || wait > 10*time.Second {
+		t.Errorf("the token of the cancelled call is not returned: wait %v, err %v", wait, err)
+	}
+}
@@ -0,0 +1,86 @@
+// Cloud Driver Middleware of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the retry of transient errors with exponential backoff and jitter.
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package middleware
+
+import (
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"math/rand"
+	"time"
+)
+
+type RetryConfig struct {
+	MaxAttempts int           // including the first call, <= 1: no retry
+	BaseDelay   time.Duration // backoff of the first retry, doubled every retry
+	MaxDelay    time.Duration // upper bound of the backoff
+}
+
+var DefaultRetryConfig = RetryConfig{MaxAttempts: 3, BaseDelay: 500 * time.Millisecond, MaxDelay: 10 * time.Second}
+
+// StartVMs는 일부 VM이 생성된 후 실패할 수 있으므로 재시도 하지 않음.
+var noRetryMethods = map[string]bool{"StartVMs": true}
+
+// Retry returns an Interceptor which retries transient errors, until call.Context is done.
+// ThrottledError is retried for every method, because the CSP did not run the throttled request.
+// UnavailableError and TimeoutError are retried only for ReadOnly() methods,
+// because a create or delete may have been applied before the error.
+func Retry(config RetryConfig) Interceptor {
+	return func(call *Call, next func() error) error {
+		for attempt := 1; ; attempt++ {
+			err := next()
+			if err == nil || attempt >= config.MaxAttempts || !retryable(call, err) {
+				return err
+			}
+
+			delay := backoff(config, attempt)
+			cblogger.Infof("%s: %s.%s failed(%v), retry %d/%d after %v", call.ConnectionName, call.Handler, call.Method,
+				err, attempt, config.MaxAttempts-1, delay)
+			trace.SpanFromContext(call.Context).AddEvent("retry", trace.WithAttributes(
+				attribute.Int("attempt", attempt),
+				attribute.String("error", err.Error()),
+				attribute.String("delay", delay.String())))
+			if err := sleep(call.Context, delay); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func retryable(call *Call, err error) bool {
+	if noRetryMethods[call.Method] || isCircuitOpen(err) {
+		return false
+	}
+	switch irs.ErrorCodeOf(err) {
+	case irs.ThrottledError:
+		return true
+	case irs.UnavailableError, irs.TimeoutError:
+		return call.ReadOnly()
+	}
+	return false
+}
+
+// "Full Jitter": random delay in [0, min(MaxDelay, BaseDelay * 2^(attempt-1))),
+// so clients throttled together do not retry together.
+func backoff(config RetryConfig, attempt int) time.Duration {
+	delay := config.BaseDelay
+	for i := 1; i < attempt && delay < config.MaxDelay; i++ {
+		delay *= 2
+	}
+	if config.MaxDelay > 0 && delay > config.MaxDelay {
+		delay = config.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(delay)))
+}
@@ -0,0 +1,107 @@
+// Cloud Driver Middleware of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the test of the retry of transient errors.
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package middleware
+
+import (
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+var testRetryConfig = RetryConfig{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 4 * time.Millisecond}
+
+func testCall(method string) *Call {
+	return &Call{Target: Target{ConnectionName: "aws-seoul"}, Handler: vmHandler, Method: method, Context: context.Background()}
+}
+
+// retryAttempts returns the calls of next by Retry, next fails with errs in order and then succeeds.
+func retryAttempts(call *Call, errs ...error) (int, error) {
+	attempts := 0
+	err := Retry(testRetryConfig)(call, func() error {
+		attempts++
+		if attempts <= len(errs) {
+			return errs[attempts-1]
+		}
+		return nil
+	})
+	return attempts, err
+}
+
+func TestRetry(t *testing.T) {
+	throttled := irs.NewCloudError(irs.ThrottledError, "RequestLimitExceeded")
+	unavailable := irs.NewCloudError(irs.UnavailableError, "503")
+	notFound := irs.NotFound("vm not found")
+
+	tests := []struct {
+		name     string
+		method   string
+		errs     []error
+		attempts int
+		failed   bool
+	}{
+		{"success", "GetVM", nil, 1, false},
+		{"throttled create is retried", "StartVM", []error{throttled, throttled}, 3, false},
+		{"unavailable read is retried", "ListVM", []error{unavailable}, 2, false},
+		{"unavailable create is not retried", "StartVM", []error{unavailable}, 1, true},
+		{"not found is not retried", "GetVM", []error{notFound}, 1, true},
+		{"StartVMs is not retried", "StartVMs", []error{throttled}, 1, true},
+		{"max attempts", "GetVM", []error{throttled, throttled, throttled, throttled}, 3, true},
+		{"open circuit is not retried", "GetVM", []error{&irs.CloudError{Code: irs.UnavailableError, Message: "open", Cause: ErrCircuitOpen}}, 1, true},
+	}
+	for _, test := range tests {
+		attempts, err := retryAttempts(testCall(test.method), test.errs...)
+		if attempts != test.attempts || (err != nil) != test.failed {
+			t.Errorf("%s: attempts %d, err %v, want attempts %d, failed %v", test.name, attempts, err, test.attempts, test.failed)
+		}
+	}
+}
+
+func TestRetryCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	call := testCall("GetVM")
+	call.Context = ctx
+
+	config := RetryConfig{MaxAttempts: 3, BaseDelay: time.Hour, MaxDelay: time.Hour}
+	attempts := 0
+	start := time.Now()
+	err := Retry(config)(call, func() error {
+		attempts++
+		cancel()
+		return irs.NewCloudError(irs.ThrottledError, "RequestLimitExceeded")
+	})
+	if !errors.Is(err, context.Canceled) || attempts != 1 {
+		t.Errorf("attempts %d, err %v, want 1, context.Canceled", attempts, err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("the retry waited %v after the cancel", elapsed)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	config := RetryConfig{MaxAttempts: 10, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	for attempt := 1; attempt < 10; attempt++ {
+		limit := config.BaseDelay << uint(attempt-1)
+		if limit > config.MaxDelay {
+			limit = config.MaxDelay
+		}
+		for i := 0; i < 100; i++ {
+			if delay := backoff(config, attempt); delay < 0 || delay >= limit {
+				t.Fatalf("attempt %d: backoff %v, want [0, %v)", attempt, delay, limit)
+			}
+		}
+	}
+	if delay := backoff(RetryConfig{}, 1); delay != 0 {
+		t.Errorf("backoff without delay: %v", delay)
+	}
+}
@@ -0,0 +1,92 @@
+// Cloud Driver Middleware of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the OpenTelemetry tracing of handler calls.
+//
+// by powerkim@etri.re.kr, 2019.06.
+
+package middleware
+
+import (
+	irs "github.com/cloud-barista/poc-cb-spider/cloud-driver/interfaces/resources"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"reflect"
+)
+
+// TracerName is the instrumentation name of the spans of CB-Spider.
+const TracerName = "github.com/cloud-barista/poc-cb-spider"
+
+// Span attributes
+const (
+	DriverKey       = attribute.Key("cbspider.driver")
+	ConnectionKey   = attribute.Key("cbspider.connection")
+	RegionKey       = attribute.Key("cloud.region")
+	ResourceIDKey   = attribute.Key("cbspider.resource.id")
+	ResourceNameKey = attribute.Key("cbspider.resource.name")
+	ErrorCodeKey    = attribute.Key("cbspider.error.code") // irs.ErrorCode, ex) "Throttled"
+)
+
+// Tracing returns an Interceptor which starts a span of each call, ex) "VMHandler.StartVM", as a child of Call.Context.
+// The global TracerProvider is used, so the spans are exported after otel.SetTracerProvider().
+// It should be the outermost one, so the span covers the retries and the waiting of the call.
+func Tracing() Interceptor {
+	tracer := otel.Tracer(TracerName)
+	return func(call *Call, next func() error) error {
+		attrs := append(TargetAttributes(call.Target), resourceAttributes(call.Args)...)
+		ctx, span := tracer.Start(call.Context, call.Handler+"."+call.Method,
+			trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+		defer span.End()
+
+		call.Context = ctx
+		err := next()
+		SetSpanError(span, err)
+		return err
+	}
+}
+
+// TargetAttributes returns the span attributes of a connection config.
+func TargetAttributes(target Target) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{ConnectionKey.String(target.ConnectionName)}
+	if target.DriverName != "" {
+		attrs = append(attrs, DriverKey.String(target.DriverName))
+	}
+	if target.Region != "" {
+		attrs = append(attrs, RegionKey.String(target.Region))
+	}
+	return attrs
+}
+
+// SetSpanError records err and its error class on span, if err is not nil.
+func SetSpanError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+	span.SetAttributes(ErrorCodeKey.String(string(irs.ErrorCodeOf(err))))
+}
+
+// Get*, Delete*, ... 의 첫 인자는 리소스 ID, Create*의 첫 인자는 Name 필드가 있는 ReqInfo 임.
+func resourceAttributes(args []interface{}) []attribute.KeyValue {
+	if len(args) == 0 {
+		return nil
+	}
+	if id, ok := args[0].(string); ok {
+		return []attribute.KeyValue{ResourceIDKey.String(id)}
+	}
+	value := reflect.ValueOf(args[0])
+	if value.Kind() != reflect.Struct {
+		return nil
+	}
+	if name := value.FieldByName("Name"); name.IsValid() && name.Kind() == reflect.String && name.String() != "" {
+		return []attribute.KeyValue{ResourceNameKey.String(name.String())}
+	}
+	return nil
+}
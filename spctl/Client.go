@@ -0,0 +1,253 @@
+// Command Line Client of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the transport of spctl.
+// Every command is a call of the REST API, to a server(-s) or in-process.
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+package main
+
+import (
+	restruntime "github.com/cloud-barista/poc-cb-spider/api-runtime/rest-runtime"
+	dim "github.com/cloud-barista/poc-cb-spider/cloud-driver-manager"
+	ccm "github.com/cloud-barista/poc-cb-spider/cloud-driver-manager/connection-config-manager"
+	cim "github.com/cloud-barista/poc-cb-spider/cloud-driver-manager/credential-info-manager"
+	rim "github.com/cloud-barista/poc-cb-spider/cloud-driver-manager/region-info-manager"
+
+	"gopkg.in/yaml.v3"
+
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const apiBasePath = "/spider"
+
+// Error response of the API
+type apiError struct {
+	Code    string
+	Message string
+}
+
+func (e *apiError) Error() string {
+	return e.Code + ": " + e.Message
+}
+
+// spiderClient calls the REST API. server "": in-process with the driver manager.
+type spiderClient struct {
+	server         string
+	stateFile      string
+	dryRun         bool   // ?dry_run=true on calls that change resources
+	idempotencyKey string // Idempotency-Key header of create calls
+}
+
+// call sends body as JSON and decodes the response into a generic value.
+func (client *spiderClient) call(method string, path string, query url.Values, body interface{}) (interface{}, error) {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	if client.dryRun && method != "GET" {
+		if query == nil {
+			query = url.Values{}
+		}
+		query.Set("dry_run", "true")
+	}
+
+	target := apiBasePath + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	var status int
+	var respBody []byte
+	if client.server != "" {
+		req, err := http.NewRequest(method, strings.TrimSuffix(client.server, "/")+target, reqBody)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		client.setHeaders(req)
+		httpClient := &http.Client{Timeout: 30 * time.Minute} // StartVM 등은 수 분이 걸림.
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if respBody, err = ioutil.ReadAll(resp.Body); err != nil {
+			return nil, err
+		}
+		status = resp.StatusCode
+	} else {
+		if reqBody == nil {
+			reqBody = http.NoBody
+		}
+		recorder := httptest.NewRecorder()
+		req := httptest.NewRequest(method, target, reqBody)
+		client.setHeaders(req)
+		restruntime.NewHandler().ServeHTTP(recorder, req)
+		status, respBody = recorder.Code, recorder.Body.Bytes()
+	}
+
+	if status >= 300 {
+		errInfo := &apiError{}
+		if err := json.Unmarshal(respBody, errInfo); err != nil || errInfo.Code == "" {
+			return nil, fmt.Errorf("%s %s: %d %s", method, target, status, strings.TrimSpace(string(respBody)))
+		}
+		return nil, errInfo
+	}
+
+	var result interface{}
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("%s %s: invalid response: %v", method, target, err)
+	}
+	return result, nil
+}
+
+// Interval of polling the workflow of a template apply, destroy, resume or rollback.
+const workflowPollInterval = 2 * time.Second
+
+// waitWorkflow polls the workflow started by a template call until it finishes, and returns the workflow.
+// The result of a plan without changes has no WorkflowId. In-process, spctl must wait or the workflow stops with spctl.
+func (client *spiderClient) waitWorkflow(result interface{}) (interface{}, error) {
+	plan, _ := result.(map[string]interface{})
+	id, _ := plan["WorkflowId"].(string)
+	if id == "" {
+		return result, nil
+	}
+	for {
+		workflow, err := client.call("GET", "/template/workflow/"+url.PathEscape(id), nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		workflowMap, _ := workflow.(map[string]interface{})
+		switch state, _ := workflowMap["State"].(string); state {
+		case "Running":
+			time.Sleep(workflowPollInterval)
+		case "Done":
+			return workflow, nil
+		default:
+			msg, _ := workflowMap["Error"].(string)
+			return nil, &apiError{Code: state, Message: fmt.Sprintf("workflow %s: %s", id, msg)}
+		}
+	}
+}
+
+func (client *spiderClient) setHeaders(req *http.Request) {
+	req.Header.Set(restruntime.UserHeader, osUser())
+	if client.idempotencyKey != "" && req.Method == "POST" {
+		req.Header.Set(restruntime.IdempotencyKeyHeader, client.idempotencyKey)
+	}
+}
+
+// osUser is the user of spctl in audit records.
+func osUser() string {
+	if current, err := user.Current(); err == nil {
+		return current.Username
+	}
+	return os.Getenv("USER")
+}
+
+// In-process 모드의 등록 정보(driver, credential, region, connection config)는
+// 실행 간에 유지되도록 state 파일에 저장 함. Credential이 포함되므로 0600으로 저장 함.
+type spctlState struct {
+	Drivers           []*dim.CloudDriverInfo      `yaml:"drivers"`
+	Credentials       []*cim.CredentialInfo       `yaml:"credentials"`
+	Regions           []*rim.RegionInfo           `yaml:"regions"`
+	ConnectionConfigs []*ccm.ConnectionConfigInfo `yaml:"connectionconfigs"`
+}
+
+func defaultStateFile() string {
+	return filepath.Join(os.Getenv("CBSPIDER_PATH"), "config", "spctl-state.yaml")
+}
+
+// loadState registers the saved state with the driver manager. A missing file is an empty state.
+func (client *spiderClient) loadState() error {
+	if client.server != "" {
+		return nil
+	}
+	data, err := ioutil.ReadFile(client.stateFile)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var state spctlState
+	if err := yaml.Unmarshal(data, &state); err != nil {
+		return fmt.Errorf("%s: %v", client.stateFile, err)
+	}
+
+	for _, cldDrvInfo := range state.Drivers {
+		switch cldDrvInfo.DriverType {
+		case dim.GRPCDriver:
+			dim.RegisterRemoteCloudDriver(cldDrvInfo.ProviderName, cldDrvInfo.DriverName, cldDrvInfo.DriverPath)
+		default:
+			dim.RegisterCloudDriver(cldDrvInfo.ProviderName, cldDrvInfo.DriverName, cldDrvInfo.DriverPath)
+		}
+	}
+	for _, crdInfo := range state.Credentials {
+		if _, err := cim.RegisterCredential(crdInfo.CredentialName, crdInfo.ProviderName, crdInfo.CredentialInfo); err != nil {
+			return err
+		}
+	}
+	for _, rgnInfo := range state.Regions {
+		if _, err := rim.RegisterRegion(rgnInfo.RegionName, rgnInfo.ProviderName, rgnInfo.RegionInfo); err != nil {
+			return err
+		}
+	}
+	for _, connConfig := range state.ConnectionConfigs {
+		if _, err := ccm.RegisterConnectionConfig(connConfig.ConfigName, connConfig.ProviderName, connConfig.DriverName,
+			connConfig.CredentialName, connConfig.RegionName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// saveState writes the current registrations of the driver manager. Static drivers are not saved.
+func (client *spiderClient) saveState() error {
+	if client.server != "" {
+		return nil
+	}
+
+	state := spctlState{
+		Credentials:       cim.ListCredential(),
+		Regions:           rim.ListRegion(),
+		ConnectionConfigs: ccm.ListConnectionConfig(),
+	}
+	for _, cldDrvInfo := range dim.ListCloudDriver() {
+		if cldDrvInfo.DriverType != dim.StaticDriver {
+			state.Drivers = append(state.Drivers, cldDrvInfo)
+		}
+	}
+
+	data, err := yaml.Marshal(state)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(client.stateFile), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(client.stateFile, data, 0600)
+}
@@ -0,0 +1,477 @@
+// Command Line Client of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the commands(driver, credential, ..., publicip) and verbs(create, list, get, delete, ...) of spctl.
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+package main
+
+import (
+	"gopkg.in/yaml.v3"
+
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// verb defines its flags on fs and returns the runner called with the positional arguments.
+type verb struct {
+	usage string
+	setup func(fs *flag.FlagSet, cmd *command) func(client *spiderClient, args []string) (interface{}, error)
+}
+
+type command struct {
+	name          string
+	description   string
+	path          string // REST API path, ex) /vm
+	perConnection bool   // addressed by connection config(-c), ex) /connection/{-c}/vm
+	saveState     bool   // in-process registrations to save after create, delete
+	verbs         map[string]*verb
+	verbOrder     []string
+	connection    *string
+}
+
+func (cmd *command) resourcePath(id ...string) string {
+	path := cmd.path
+	if cmd.perConnection {
+		path = "/connection/" + url.PathEscape(*cmd.connection) + path
+	}
+	for _, segment := range id {
+		path += "/" + url.PathEscape(segment)
+	}
+	return path
+}
+
+func (cmd *command) addVerb(name string, v *verb) {
+	if _, ok := cmd.verbs[name]; !ok {
+		cmd.verbOrder = append(cmd.verbOrder, name)
+	}
+	cmd.verbs[name] = v
+}
+
+var commands []*command
+
+func newCommand(name string, description string, path string, perConnection bool, create *verb) *command {
+	cmd := &command{name: name, description: description, path: path, perConnection: perConnection,
+		saveState: !perConnection, verbs: map[string]*verb{}, connection: new(string)}
+	cmd.addVerb("create", create)
+	cmd.addVerb("list", listVerb)
+	cmd.addVerb("get", idVerb("GET", ""))
+	cmd.addVerb("delete", idVerb("DELETE", ""))
+	commands = append(commands, cmd)
+	return cmd
+}
+
+func init() {
+	driver := newCommand("driver", "cloud drivers", "/driver", false, createDriverVerb)
+	driver.addVerb("capability", idVerb("GET", "capability"))
+	newCommand("credential", "credentials, secrets are masked", "/credential", false, createCredentialVerb)
+	newCommand("region", "regions", "/region", false, createRegionVerb)
+	connection := newCommand("connection", "connection configs(driver, credential, region)", "/connectionconfig", false, createConnectionVerb)
+	connection.addVerb("test", idVerb("GET", "test"))
+
+	vm := newCommand("vm", "VMs", "/vm", true, createVMVerb)
+	vm.addVerb("delete", idVerb("DELETE", ""))
+	vm.addVerb("terminate", idVerb("DELETE", ""))
+	vm.addVerb("status", vmStatusVerb)
+	vm.addVerb("suspend", idVerb("PUT", "suspend"))
+	vm.addVerb("resume", idVerb("PUT", "resume"))
+	vm.addVerb("reboot", idVerb("PUT", "reboot"))
+	vm.addVerb("spec", changeVMSpecVerb)
+
+	image := newCommand("image", "images", "/image", true, createResourceVerb)
+	image.addVerb("list", listImageVerb)
+	newCommand("vnet", "virtual networks", "/vnetwork", true, createResourceVerb)
+	newCommand("sg", "security groups", "/security", true, createResourceVerb)
+	newCommand("keypair", "key pairs", "/keypair", true, createResourceVerb)
+	newCommand("vnic", "virtual NICs", "/vnic", true, createResourceVerb)
+	publicIP := newCommand("publicip", "public IPs", "/publicip", true, createResourceVerb)
+	publicIP.addVerb("associate", associatePublicIPVerb)
+	publicIP.addVerb("disassociate", idVerb("PUT", "disassociate"))
+
+	// 템플릿은 connection 별 자원을 포함하므로 create, list, ... 대신 plan, apply, destroy만 있음.
+	template := &command{name: "template", description: "infrastructure templates of connections", path: "/template",
+		verbs: map[string]*verb{}, connection: new(string)}
+	template.addVerb("plan", planTemplateVerb)
+	template.addVerb("apply", templateVerb("apply"))
+	template.addVerb("destroy", templateVerb("destroy"))
+	commands = append(commands, template)
+
+	// 실패 또는 서버 중단 후 apply, destroy를 재개하거나 롤백 함.
+	workflow := &command{name: "workflow", description: "journal of template applies and destroys", path: "/template/workflow",
+		verbs: map[string]*verb{}, connection: new(string)}
+	workflow.addVerb("list", listVerb)
+	workflow.addVerb("get", idVerb("GET", ""))
+	workflow.addVerb("resume", workflowVerb("resume"))
+	workflow.addVerb("rollback", workflowVerb("rollback"))
+	commands = append(commands, workflow)
+}
+
+func findCommand(name string) *command {
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd
+		}
+	}
+	return nil
+}
+
+// readBody reads a request body from a JSON or YAML file, "-": stdin
+func readBody(fileName string) (map[string]interface{}, error) {
+	body := map[string]interface{}{}
+	if fileName == "" {
+		return body, nil
+	}
+
+	var data []byte
+	var err error
+	if fileName == "-" {
+		data, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		data, err = ioutil.ReadFile(fileName)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// YAML은 JSON을 포함하므로 YAML로 읽음.
+	if err := yaml.Unmarshal(data, &body); err != nil {
+		return nil, fmt.Errorf("%s: %v", fileName, err)
+	}
+	return body, nil
+}
+
+func requireArg(args []string, name string) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf("%s is required", name)
+	}
+	return args[0], nil
+}
+
+// idVerb: {get|delete|...} ID, calls method on the path of the ID(/sub).
+func idVerb(method string, sub string) *verb {
+	return &verb{
+		usage: "ID",
+		setup: func(fs *flag.FlagSet, cmd *command) func(*spiderClient, []string) (interface{}, error) {
+			return func(client *spiderClient, args []string) (interface{}, error) {
+				id, err := requireArg(args, "ID")
+				if err != nil {
+					return nil, err
+				}
+				segments := []string{id}
+				if sub != "" {
+					segments = append(segments, sub)
+				}
+				return client.call(method, cmd.resourcePath(segments...), nil, nil)
+			}
+		},
+	}
+}
+
+type tagFlag map[string]string
+
+func (tags tagFlag) String() string {
+	var tagList []string
+	for k, v := range tags {
+		tagList = append(tagList, k+":"+v)
+	}
+	return strings.Join(tagList, ",")
+}
+
+func (tags tagFlag) Set(value string) error {
+	tagArr := strings.SplitN(value, ":", 2)
+	if len(tagArr) != 2 {
+		return fmt.Errorf("key:value is required")
+	}
+	tags[tagArr[0]] = tagArr[1]
+	return nil
+}
+
+// listFlags defines the paging options of List*Page(), except for config commands.
+func listFlags(fs *flag.FlagSet, cmd *command) func() url.Values {
+	if !cmd.perConnection {
+		return func() url.Values { return url.Values{} }
+	}
+	pageSize := fs.Int("page-size", 0, "page size, 0: CSP default")
+	nextToken := fs.String("next-token", "", "NextToken of the previous page")
+	nameFilter := fs.String("name-filter", "", "name filter, '*', '?' wildcard")
+	tags := tagFlag{}
+	fs.Var(tags, "tag", "tag filter key:value, repeatable")
+
+	return func() url.Values {
+		query := url.Values{}
+		if *pageSize > 0 {
+			query.Set("page_size", strconv.Itoa(*pageSize))
+		}
+		if *nextToken != "" {
+			query.Set("next_token", *nextToken)
+		}
+		if *nameFilter != "" {
+			query.Set("name_filter", *nameFilter)
+		}
+		for k, v := range tags {
+			query.Add("tag", k+":"+v)
+		}
+		return query
+	}
+}
+
+var listVerb = &verb{
+	setup: func(fs *flag.FlagSet, cmd *command) func(*spiderClient, []string) (interface{}, error) {
+		query := listFlags(fs, cmd)
+		return func(client *spiderClient, args []string) (interface{}, error) {
+			return client.call("GET", cmd.resourcePath(), query(), nil)
+		}
+	},
+}
+
+var listImageVerb = &verb{
+	setup: func(fs *flag.FlagSet, cmd *command) func(*spiderClient, []string) (interface{}, error) {
+		listQuery := listFlags(fs, cmd)
+		filters := map[string]*string{
+			"owner":        fs.String("owner", "", "image owner, ex) self, amazon, debian-cloud"),
+			"guest_os":     fs.String("guest-os", "", "OS family, ex) ubuntu"),
+			"architecture": fs.String("arch", "", "architecture, ex) x86_64"),
+			"name_pattern": fs.String("name-pattern", "", "image name, '*', '?' wildcard"),
+			"visibility":   fs.String("visibility", "", "PUBLIC or PRIVATE"),
+		}
+		return func(client *spiderClient, args []string) (interface{}, error) {
+			query := listQuery()
+			for key, value := range filters {
+				if *value != "" {
+					query.Set(key, *value)
+				}
+			}
+			return client.call("GET", cmd.resourcePath(), query, nil)
+		}
+	},
+}
+
+//================ create
+
+var createDriverVerb = &verb{
+	setup: func(fs *flag.FlagSet, cmd *command) func(*spiderClient, []string) (interface{}, error) {
+		name := fs.String("name", "", "driver name")
+		provider := fs.String("provider", "", "provider name, ex) AWS")
+		path := fs.String("path", "", "plugin(.so) file or driver server binary")
+		driverType := fs.String("type", "plugin", "plugin or grpc")
+		return func(client *spiderClient, args []string) (interface{}, error) {
+			body := map[string]interface{}{"DriverName": *name, "ProviderName": *provider, "DriverPath": *path, "DriverType": *driverType}
+			return client.call("POST", cmd.resourcePath(), nil, body)
+		}
+	},
+}
+
+var createCredentialVerb = &verb{
+	usage: "[Key=Value ...], ex) ClientId=xxx ClientSecret=yyy",
+	setup: func(fs *flag.FlagSet, cmd *command) func(*spiderClient, []string) (interface{}, error) {
+		name := fs.String("name", "", "credential name")
+		provider := fs.String("provider", "", "provider name, ex) AWS")
+		file := fs.String("f", "", "credential info file(JSON or YAML), ex) {ClientId: xxx, ClientSecret: yyy}")
+		return func(client *spiderClient, args []string) (interface{}, error) {
+			credentialInfo, err := readBody(*file)
+			if err != nil {
+				return nil, err
+			}
+			for _, arg := range args {
+				kv := strings.SplitN(arg, "=", 2)
+				if len(kv) != 2 {
+					return nil, fmt.Errorf("%s: Key=Value is required", arg)
+				}
+				credentialInfo[kv[0]] = kv[1]
+			}
+			body := map[string]interface{}{"CredentialName": *name, "ProviderName": *provider, "CredentialInfo": credentialInfo}
+			return client.call("POST", cmd.resourcePath(), nil, body)
+		}
+	},
+}
+
+var createRegionVerb = &verb{
+	setup: func(fs *flag.FlagSet, cmd *command) func(*spiderClient, []string) (interface{}, error) {
+		name := fs.String("name", "", "region name")
+		provider := fs.String("provider", "", "provider name, ex) AWS")
+		region := fs.String("region", "", "CSP region, ex) ap-northeast-2")
+		zone := fs.String("zone", "", "CSP zone, ex) ap-northeast-2a")
+		resourceGroup := fs.String("rg", "", "Azure resource group")
+		return func(client *spiderClient, args []string) (interface{}, error) {
+			body := map[string]interface{}{"RegionName": *name, "ProviderName": *provider,
+				"RegionInfo": map[string]string{"Region": *region, "Zone": *zone, "ResourceGroup": *resourceGroup}}
+			return client.call("POST", cmd.resourcePath(), nil, body)
+		}
+	},
+}
+
+var createConnectionVerb = &verb{
+	setup: func(fs *flag.FlagSet, cmd *command) func(*spiderClient, []string) (interface{}, error) {
+		name := fs.String("name", "", "connection config name")
+		provider := fs.String("provider", "", "provider name, ex) AWS")
+		driver := fs.String("driver", "", "driver name")
+		credential := fs.String("credential", "", "credential name")
+		region := fs.String("region", "", "region name")
+		return func(client *spiderClient, args []string) (interface{}, error) {
+			body := map[string]interface{}{"ConfigName": *name, "ProviderName": *provider, "DriverName": *driver,
+				"CredentialName": *credential, "RegionName": *region}
+			return client.call("POST", cmd.resourcePath(), nil, body)
+		}
+	},
+}
+
+// create of resources: -f {Name}ReqInfo file, -name overrides Name of the file.
+var createResourceVerb = &verb{
+	setup: func(fs *flag.FlagSet, cmd *command) func(*spiderClient, []string) (interface{}, error) {
+		name := fs.String("name", "", "resource name")
+		file := fs.String("f", "", "request info file(JSON or YAML), \"-\": stdin")
+		return func(client *spiderClient, args []string) (interface{}, error) {
+			body, err := readBody(*file)
+			if err != nil {
+				return nil, err
+			}
+			if *name != "" {
+				body["Name"] = *name
+			}
+			return client.call("POST", cmd.resourcePath(), nil, body)
+		}
+	},
+}
+
+var createVMVerb = &verb{
+	setup: func(fs *flag.FlagSet, cmd *command) func(*spiderClient, []string) (interface{}, error) {
+		name := fs.String("name", "", "VM name, batch VMs are named {name}-1..{name}-N")
+		file := fs.String("f", "", "VMReqInfo file(JSON or YAML), \"-\": stdin")
+		count := fs.Int("count", 1, "number of VMs")
+		minCount := fs.Int("min-count", 0, "rollback if fewer VMs are created, 0: no rollback")
+		return func(client *spiderClient, args []string) (interface{}, error) {
+			body, err := readBody(*file)
+			if err != nil {
+				return nil, err
+			}
+			if *name != "" {
+				body["Name"] = *name
+			}
+			if *count > 1 {
+				batch := map[string]interface{}{"VMReqInfo": body, "Count": *count, "MinCount": *minCount}
+				return client.call("POST", cmd.resourcePath("batch"), nil, batch)
+			}
+			return client.call("POST", cmd.resourcePath(), nil, body)
+		}
+	},
+}
+
+//================ VM, PublicIP
+
+// status [ID], without ID: status of every VM
+var vmStatusVerb = &verb{
+	usage: "[ID]",
+	setup: func(fs *flag.FlagSet, cmd *command) func(*spiderClient, []string) (interface{}, error) {
+		return func(client *spiderClient, args []string) (interface{}, error) {
+			if len(args) == 0 {
+				return client.call("GET", "/connection/"+url.PathEscape(*cmd.connection)+"/vmstatus", nil, nil)
+			}
+			id, err := requireArg(args, "ID")
+			if err != nil {
+				return nil, err
+			}
+			return client.call("GET", cmd.resourcePath(id, "status"), nil, nil)
+		}
+	},
+}
+
+var changeVMSpecVerb = &verb{
+	usage: "ID",
+	setup: func(fs *flag.FlagSet, cmd *command) func(*spiderClient, []string) (interface{}, error) {
+		spec := fs.String("spec", "", "instance type or flavour, ex) t2.small")
+		return func(client *spiderClient, args []string) (interface{}, error) {
+			id, err := requireArg(args, "ID")
+			if err != nil {
+				return nil, err
+			}
+			return client.call("PUT", cmd.resourcePath(id, "spec"), nil, map[string]string{"SpecID": *spec})
+		}
+	},
+}
+
+var associatePublicIPVerb = &verb{
+	usage: "ID",
+	setup: func(fs *flag.FlagSet, cmd *command) func(*spiderClient, []string) (interface{}, error) {
+		vmID := fs.String("vm", "", "VM ID or NIC ID, depends on the CSP")
+		return func(client *spiderClient, args []string) (interface{}, error) {
+			id, err := requireArg(args, "ID")
+			if err != nil {
+				return nil, err
+			}
+			return client.call("PUT", cmd.resourcePath(id, "associate"), nil, map[string]string{"VMID": *vmID})
+		}
+	},
+}
+
+//================ template
+
+var planTemplateVerb = &verb{
+	setup: func(fs *flag.FlagSet, cmd *command) func(*spiderClient, []string) (interface{}, error) {
+		file := fs.String("f", "", "template file(JSON or YAML), \"-\": stdin")
+		destroy := fs.Bool("destroy", false, "plan of destroy")
+		return func(client *spiderClient, args []string) (interface{}, error) {
+			body, err := readBody(*file)
+			if err != nil {
+				return nil, err
+			}
+			query := url.Values{}
+			if *destroy {
+				query.Set("destroy", "true")
+			}
+			return client.call("POST", cmd.resourcePath("plan"), query, body)
+		}
+	},
+}
+
+// templateVerb: {apply|destroy} -f file, applies the plan of the template and prints the workflow of the apply.
+func templateVerb(sub string) *verb {
+	return &verb{
+		setup: func(fs *flag.FlagSet, cmd *command) func(*spiderClient, []string) (interface{}, error) {
+			file := fs.String("f", "", "template file(JSON or YAML), \"-\": stdin")
+			idempotencyKey := fs.String("idempotency-key", "", "unique key of the apply, a retry with the same key does not create the resources again")
+			noWait := fs.Bool("no-wait", false, "print the started plan without waiting for the workflow, with -s")
+			return func(client *spiderClient, args []string) (interface{}, error) {
+				body, err := readBody(*file)
+				if err != nil {
+					return nil, err
+				}
+				client.idempotencyKey = *idempotencyKey
+				result, err := client.call("POST", cmd.resourcePath(sub), nil, body)
+				if err != nil || (*noWait && client.server != "") {
+					return result, err
+				}
+				return client.waitWorkflow(result)
+			}
+		},
+	}
+}
+
+// workflowVerb: {resume|rollback} ID, runs the workflow again and prints it.
+func workflowVerb(sub string) *verb {
+	return &verb{
+		usage: "ID",
+		setup: func(fs *flag.FlagSet, cmd *command) func(*spiderClient, []string) (interface{}, error) {
+			noWait := fs.Bool("no-wait", false, "print the started plan without waiting for the workflow, with -s")
+			return func(client *spiderClient, args []string) (interface{}, error) {
+				id, err := requireArg(args, "ID")
+				if err != nil {
+					return nil, err
+				}
+				result, err := client.call("POST", cmd.resourcePath(id, sub), nil, nil)
+				if err != nil || (*noWait && client.server != "") {
+					return result, err
+				}
+				return client.waitWorkflow(result)
+			}
+		},
+	}
+}
@@ -0,0 +1,161 @@
+// Command Line Client of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the output formats(json, yaml, table) of spctl.
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+package main
+
+import (
+	"gopkg.in/yaml.v3"
+
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// Table columns of each command. Nested fields are separated by '.', ex) RegionInfo.Region
+var tableColumns = map[string][]string{
+	"driver":     {"DriverName", "ProviderName", "DriverType", "DriverPath"},
+	"credential": {"CredentialName", "ProviderName"},
+	"region":     {"RegionName", "ProviderName", "RegionInfo.Region", "RegionInfo.Zone", "RegionInfo.ResourceGroup"},
+	"connection": {"ConfigName", "ProviderName", "DriverName", "CredentialName", "RegionName"},
+	"vm":         {"Name", "Id", "SpecID", "ImageID", "PublicIP", "PrivateIP", "StartTime"},
+	"image":      {"Name", "Id", "GuestOS", "Architecture", "Visibility", "SizeGB"},
+	"vnet":       {"Name", "Id", "SubnetId"},
+	"sg":         {"Name", "Id", "GroupName", "VpcID"},
+	"keypair":    {"Name", "Id", "Fingerprint"},
+	"vnic":       {"Name", "Id"},
+	"publicip":   {"Name", "Id", "PublicIp", "InstanceId", "Status"},
+	"template":   {"ConnectionName", "Kind", "Name", "Action", "Id", "Status", "Changes", "Warnings", "Error"},
+	"workflow":   {"TemplateName", "Id", "Destroy", "State", "StartTime", "Error"},
+}
+
+func printResult(w io.Writer, format string, command string, result interface{}) error {
+	switch format {
+	case "json":
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	case "yaml":
+		data, err := yaml.Marshal(result)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	case "table", "":
+		return printTable(w, command, result)
+	}
+	return fmt.Errorf("unknown output format: %s, json, yaml or table", format)
+}
+
+// printTable prints lists and *PageInfo(list and NextToken) as rows, and others as a row.
+func printTable(w io.Writer, command string, result interface{}) error {
+	rows, nextToken := tableRows(result)
+
+	columns := tableColumns[command]
+	if len(rows) == 0 || len(columns) == 0 || lookup(rows[0], columns[0]) == nil {
+		columns = scalarColumns(rows)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.ToUpper(strings.Join(columns, "\t")))
+	for _, row := range rows {
+		var values []string
+		for _, column := range columns {
+			values = append(values, formatValue(lookup(row, column)))
+		}
+		fmt.Fprintln(tw, strings.Join(values, "\t"))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if nextToken != "" {
+		fmt.Fprintf(w, "\nmore results: -next-token %s\n", nextToken)
+	}
+	return nil
+}
+
+func tableRows(result interface{}) ([]map[string]interface{}, string) {
+	var rows []map[string]interface{}
+	switch v := result.(type) {
+	case []interface{}:
+		for _, item := range v {
+			if row, ok := item.(map[string]interface{}); ok {
+				rows = append(rows, row)
+			}
+		}
+	case map[string]interface{}:
+		// ex) {"VMInfoList": [...], "NextToken": ""}, {"DryRun": true, "Results": [...]}, {"TemplateName": "web", "Steps": [...]},
+		// {"TemplateName": "web", "Rollback": [...]} of workflow rollback
+		for key, value := range v {
+			if list, ok := value.([]interface{}); ok && (strings.HasSuffix(key, "InfoList") || (key == "Results" && v["DryRun"] == true) ||
+				((key == "Steps" || key == "Rollback") && v["TemplateName"] != nil)) {
+				nextToken, _ := v["NextToken"].(string)
+				listRows, _ := tableRows(list)
+				return listRows, nextToken
+			}
+		}
+		rows = append(rows, v)
+	}
+	return rows, ""
+}
+
+// scalarColumns returns the sorted non-nested fields of the rows.
+func scalarColumns(rows []map[string]interface{}) []string {
+	columnSet := map[string]bool{}
+	for _, row := range rows {
+		for key, value := range row {
+			switch value.(type) {
+			case map[string]interface{}, []interface{}:
+			default:
+				columnSet[key] = true
+			}
+		}
+	}
+
+	var columns []string
+	for column := range columnSet {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+	return columns
+}
+
+func lookup(row map[string]interface{}, column string) interface{} {
+	var value interface{} = row
+	for _, key := range strings.Split(column, ".") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = m[key]
+	}
+	return value
+}
+
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	data, _ := json.Marshal(value)
+	return string(data)
+}
@@ -0,0 +1,139 @@
+// Command Line Client of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is spctl, the CLI of CB-Spider.
+// usage) spctl [-s http://localhost:1024] <command> <verb> [flags] [args]
+//   ex) spctl connection create -name aws-seoul -provider AWS -driver aws -credential aws-cred -region seoul
+//       spctl -o yaml vm list -c aws-seoul
+//       spctl vm create -c aws-seoul -f vm.yaml -dry-run
+// Without -s($SPCTL_SERVER), commands run in-process with the driver manager,
+// and registrations are kept in the state file(-state) between runs.
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	global := flag.NewFlagSet("spctl", flag.ContinueOnError)
+	server := global.String("s", os.Getenv("SPCTL_SERVER"), "spider API server, ex) http://localhost:1024, \"\": in-process")
+	stateFile := global.String("state", defaultStateFile(), "state file of in-process registrations")
+	output := global.String("o", "table", "output format: json, yaml or table")
+	connection := global.String("c", "", "connection config name of resource commands")
+	global.Usage = func() { printUsage(global) }
+	if err := global.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return nil
+		}
+		return err
+	}
+
+	args = global.Args()
+	if len(args) == 0 {
+		printUsage(global)
+		return fmt.Errorf("command is required")
+	}
+	cmd := findCommand(args[0])
+	if cmd == nil {
+		printUsage(global)
+		return fmt.Errorf("unknown command: %s", args[0])
+	}
+	if len(args) < 2 || cmd.verbs[args[1]] == nil {
+		printCommandUsage(cmd)
+		if len(args) < 2 {
+			return fmt.Errorf("verb is required")
+		}
+		return fmt.Errorf("unknown verb: %s %s", cmd.name, args[1])
+	}
+	verbName := args[1]
+
+	fs := flag.NewFlagSet("spctl "+cmd.name+" "+verbName, flag.ContinueOnError)
+	fs.StringVar(output, "o", *output, "output format: json, yaml or table")
+	dryRun := new(bool)
+	idempotencyKey := new(string)
+	if cmd.perConnection {
+		fs.StringVar(cmd.connection, "c", *connection, "connection config name")
+		fs.BoolVar(dryRun, "dry-run", false, "validate only, print what would be created or changed")
+		if verbName == "create" {
+			fs.StringVar(idempotencyKey, "idempotency-key", "", "unique key of the create, a retry with the same key returns the first resource")
+		}
+	}
+	runner := cmd.verbs[verbName].setup(fs, cmd)
+	positional, err := parseInterspersed(fs, args[2:])
+	if err != nil {
+		if err == flag.ErrHelp {
+			return nil
+		}
+		return err
+	}
+	if cmd.perConnection && *cmd.connection == "" {
+		return fmt.Errorf("connection config(-c) is required")
+	}
+
+	client := &spiderClient{server: *server, stateFile: *stateFile, dryRun: *dryRun, idempotencyKey: *idempotencyKey}
+	if err := client.loadState(); err != nil {
+		return err
+	}
+
+	result, err := runner(client, positional)
+	if err != nil {
+		return err
+	}
+
+	if cmd.saveState && (verbName == "create" || verbName == "delete") {
+		if err := client.saveState(); err != nil {
+			return err
+		}
+	}
+	return printResult(os.Stdout, *output, cmd.name, result)
+}
+
+// parseInterspersed allows flags after positional arguments, ex) vm get i-1234 -c aws-seoul
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+func printUsage(global *flag.FlagSet) {
+	fmt.Fprintln(os.Stderr, "usage: spctl [flags] <command> <verb> [flags] [args]")
+	fmt.Fprintln(os.Stderr, "\ncommands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-11s %s: %s\n", cmd.name, cmd.description, strings.Join(cmd.verbOrder, ", "))
+	}
+	fmt.Fprintln(os.Stderr, "\nflags:")
+	global.PrintDefaults()
+}
+
+func printCommandUsage(cmd *command) {
+	fmt.Fprintf(os.Stderr, "usage: spctl %s <verb> [flags] [args]\n\nverbs:\n", cmd.name)
+	for _, verbName := range cmd.verbOrder {
+		fmt.Fprintf(os.Stderr, "  %-12s %s\n", verbName, cmd.verbs[verbName].usage)
+	}
+	fmt.Fprintf(os.Stderr, "\nflags of a verb: spctl %s <verb> -h\n", cmd.name)
+}
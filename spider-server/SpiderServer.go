@@ -0,0 +1,159 @@
+// Server of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is the main of the CB-Spider server.
+// usage) go run SpiderServer.go -rest :1024 -grpc :2048
+//
+// by powerkim@etri.re.kr, 2019.07.
+
+package main
+
+import (
+	grpcruntime "github.com/cloud-barista/poc-cb-spider/api-runtime/grpc-runtime"
+	restruntime "github.com/cloud-barista/poc-cb-spider/api-runtime/rest-runtime"
+	dim "github.com/cloud-barista/poc-cb-spider/cloud-driver-manager"
+	tpm "github.com/cloud-barista/poc-cb-spider/cloud-driver-manager/template-manager"
+	grpcdriver "github.com/cloud-barista/poc-cb-spider/cloud-driver/grpc-driver"
+	"github.com/cloud-barista/poc-cb-spider/cloud-driver/logger"
+	mw "github.com/cloud-barista/poc-cb-spider/cloud-driver/middleware"
+
+	cblog "github.com/cloud-barista/cb-log"
+
+	"context"
+	"flag"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+)
+
+func main() {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+
+	restAddr := flag.String("rest", ":1024", "listen address of the REST API server")
+	grpcAddr := flag.String("grpc", ":2048", "listen address of the gRPC API server, \"\": disabled")
+	metricsAddr := flag.String("metrics", "", "listen address of a separate metrics server, \"\": only on the REST API server")
+	connIdle := flag.Duration("conn-idle", dim.ConnectionIdleTimeout, "idle timeout of cached cloud connections, 0: no cache")
+	maxAttempts := flag.Int("max-attempts", mw.DefaultRetryConfig.MaxAttempts, "attempts of a CSP call with transient errors, 1: no retry")
+	rateLimit := flag.Float64("rate-limit", mw.DefaultRateLimitConfig.Rate, "CSP calls per second of a connection, 0: unlimited")
+	rateBurst := flag.Int("rate-burst", 10, "CSP calls at once of a connection, with -rate-limit")
+	rateMaxWait := flag.Duration("rate-max-wait", mw.DefaultRateLimitMaxWait, "max wait of a CSP call for the rate limit, longer: Throttled error")
+	traceExporter := flag.String("trace", "", "trace exporter: otlp, stdout, \"\": no tracing")
+	traceEndpoint := flag.String("trace-endpoint", "", "OTLP collector address with -trace otlp, ex) localhost:4317")
+	traceInsecure := flag.Bool("trace-insecure", false, "OTLP without TLS")
+	auditLog := flag.String("audit-log", filepath.Join(os.Getenv("CBSPIDER_PATH"), "log", "audit.jsonl"), "audit log of calls that change CSP resources, \"\": no audit log")
+	auditMaxSize := flag.Int64("audit-max-size", 100, "MB of the audit log to rotate, 0: no rotation")
+	auditMaxBackups := flag.Int("audit-max-backups", 0, "rotated audit logs to keep, 0: all")
+	idempotencyStore := flag.String("idempotency-store", filepath.Join(os.Getenv("CBSPIDER_PATH"), "config", "idempotency.json"), "store of the idempotency keys of create requests, \"\": in memory")
+	idempotencyTTL := flag.Duration("idempotency-ttl", mw.DefaultIdempotencyTTL, "how long idempotency keys are kept")
+	templateJournal := flag.String("template-journal", filepath.Join(os.Getenv("CBSPIDER_PATH"), "config", "template-journal.json"), "journal of template workflows to resume or roll back after a crash, \"\": in memory")
+	templateJournalTTL := flag.Duration("template-journal-ttl", tpm.DefaultJournalTTL, "how long finished template workflows are kept")
+	templateTimeout := flag.Duration("template-timeout", tpm.WorkflowTimeout, "max time of a template apply, destroy, resume or rollback, 0: no timeout")
+	logLevel := flag.String("log-level", os.Getenv(logger.LevelEnv), "levels of driver logs, ex) info,AWS=debug,GCP=warn")
+	driverCallTimeout := flag.Duration("driver-call-timeout", grpcdriver.CallTimeout, "max time of a call to an out-of-process(gRPC) driver, 0: no timeout")
+	breakerFailures := flag.Int("breaker-failures", mw.DefaultCircuitBreakerConfig.FailureThreshold, "consecutive CSP failures to fail fast, 0: no circuit breaker")
+	flag.Parse()
+
+	if *logLevel != "" {
+		if err := logger.SetLevels(*logLevel); err != nil {
+			cblogger.Fatal(err)
+		}
+		// gRPC 드라이버 프로세스도 같은 level로 로그를 남김.
+		os.Setenv(logger.LevelEnv, *logLevel)
+	}
+
+	dim.ConnectionIdleTimeout = *connIdle
+	grpcdriver.CallTimeout = *driverCallTimeout
+	tpm.WorkflowTimeout = *templateTimeout
+	retryConfig := mw.DefaultRetryConfig
+	retryConfig.MaxAttempts = *maxAttempts
+	dim.SetRetryConfig(retryConfig)
+	dim.SetRateLimitConfig(mw.RateLimitConfig{Rate: *rateLimit, Burst: *rateBurst, MaxWait: *rateMaxWait})
+	breakerConfig := mw.DefaultCircuitBreakerConfig
+	breakerConfig.FailureThreshold = *breakerFailures
+	dim.SetCircuitBreakerConfig(breakerConfig)
+
+	if *traceExporter != "" {
+		tracingConfig := dim.TracingConfig{Exporter: *traceExporter, Endpoint: *traceEndpoint, Insecure: *traceInsecure}
+		if err := dim.StartTracing(tracingConfig); err != nil {
+			cblogger.Fatal(err)
+		}
+	}
+
+	if *auditLog != "" {
+		sink, err := mw.NewFileAuditSink(*auditLog, *auditMaxSize*1024*1024, *auditMaxBackups)
+		if err != nil {
+			cblogger.Fatal(err)
+		}
+		dim.SetAuditSinks(sink)
+	}
+
+	store, err := mw.NewFileIdempotencyStore(*idempotencyStore, *idempotencyTTL)
+	if err != nil {
+		cblogger.Fatal(err)
+	}
+	dim.SetIdempotencyStore(store)
+
+	journal, err := tpm.NewFileJournal(*templateJournal, *templateJournalTTL)
+	if err != nil {
+		cblogger.Fatal(err)
+	}
+	tpm.SetJournal(journal)
+	workflows, err := journal.List()
+	if err != nil {
+		cblogger.Fatal(err)
+	}
+	for _, workflow := range workflows {
+		if workflow.State == tpm.WorkflowInterrupted {
+			cblogger.Warnf("workflow %s of template %s is interrupted, resume or roll back it: spctl workflow resume|rollback %s",
+				workflow.Id, workflow.TemplateName, workflow.Id)
+		}
+	}
+
+	// 종료 시 남은 span, audit record를 내보내고 캐시된 connection을 닫음.
+	go func() {
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+		<-signals
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := dim.StopTracing(ctx); err != nil {
+			cblogger.Error(err)
+		}
+		if err := dim.CloseAuditSinks(); err != nil {
+			cblogger.Error(err)
+		}
+		dim.CloseCloudConnections()
+		os.Exit(0)
+	}()
+
+	// Plugin 드라이버 디렉토리가 없어도 등록된 드라이버로 서버는 동작 함.
+	if _, err := dim.StartDriverDiscovery(dim.DriverDir(), 10*time.Second); err != nil {
+		cblogger.Warnf("driver discovery is disabled: %v", err)
+	}
+
+	if *grpcAddr != "" {
+		go func() {
+			if err := grpcruntime.RunServer(*grpcAddr); err != nil {
+				cblogger.Fatal(err)
+			}
+		}()
+	}
+
+	if *metricsAddr != "" {
+		go func() {
+			if err := restruntime.RunMetricsServer(*metricsAddr); err != nil {
+				cblogger.Fatal(err)
+			}
+		}()
+	}
+
+	if err := restruntime.RunServer(*restAddr); err != nil {
+		cblogger.Fatal(err)
+	}
+}